@@ -0,0 +1,77 @@
+package http
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// AccountHTTPMapper handles HTTP layer conversions for data exports and deletion requests
+type AccountHTTPMapper struct {
+	userMapper       *UserHTTPMapper
+	playerMapper     *PlayerHTTPMapper
+	predictionMapper *PredictionHTTPMapper
+	mvpVoteMapper    *MVPVoteHTTPMapper
+	sessionMapper    *SessionHTTPMapper
+	apiKeyMapper     *APIKeyHTTPMapper
+	auditMapper      *AuditHTTPMapper
+}
+
+func NewAccountHTTPMapper() *AccountHTTPMapper {
+	return &AccountHTTPMapper{
+		userMapper:       NewUserHTTPMapper(),
+		playerMapper:     NewPlayerHTTPMapper(),
+		predictionMapper: NewPredictionHTTPMapper(),
+		mvpVoteMapper:    NewMVPVoteHTTPMapper(),
+		sessionMapper:    NewSessionHTTPMapper(),
+		apiKeyMapper:     NewAPIKeyHTTPMapper(),
+		auditMapper:      NewAuditHTTPMapper(),
+	}
+}
+
+// DeletionToDTO converts a domain.AccountDeletion to AccountDeletionResponse DTO
+func (m *AccountHTTPMapper) DeletionToDTO(entity *domain.AccountDeletion) *dto.AccountDeletionResponse {
+	if entity == nil {
+		return nil
+	}
+
+	return &dto.AccountDeletionResponse{
+		RequestedAt: entity.RequestedAt,
+		ScheduledAt: entity.ScheduledAt,
+	}
+}
+
+// ExportToDTO converts a domain.UserDataExport to UserDataExportResponse DTO
+func (m *AccountHTTPMapper) ExportToDTO(export *domain.UserDataExport) *dto.UserDataExportResponse {
+	if export == nil {
+		return nil
+	}
+
+	response := &dto.UserDataExportResponse{
+		GeneratedAt:    export.GeneratedAt,
+		Profile:        *m.userMapper.DomainToDTO(&export.User, nil),
+		Player:         m.playerMapper.DomainToDTO(export.Player),
+		Predictions:    make([]dto.PredictionResponse, 0, len(export.Predictions)),
+		MVPVotes:       make([]dto.MVPVoteResponse, 0, len(export.MVPVotes)),
+		Sessions:       m.sessionMapper.ToDTOList(export.Sessions, ""),
+		Identities:     make([]dto.UserIdentityExport, 0, len(export.Identities)),
+		APIKeys:        m.apiKeyMapper.ToDTOList(export.APIKeys),
+		ProfileChanges: m.userMapper.ProfileChangesToDTO(export.ProfileChanges),
+		AuditEntries:   m.auditMapper.DomainListToDTO(export.AuditEntries),
+		Deletion:       m.DeletionToDTO(export.Deletion),
+	}
+	for i := range export.Predictions {
+		response.Predictions = append(response.Predictions, *m.predictionMapper.DomainToDTO(&export.Predictions[i]))
+	}
+	for i := range export.MVPVotes {
+		response.MVPVotes = append(response.MVPVotes, *m.mvpVoteMapper.VoteToDTO(&export.MVPVotes[i]))
+	}
+	for _, identity := range export.Identities {
+		response.Identities = append(response.Identities, dto.UserIdentityExport{
+			Provider:    identity.Provider,
+			Email:       identity.Email,
+			CreatedAt:   identity.CreatedAt,
+			LastLoginAt: identity.LastLoginAt,
+		})
+	}
+	return response
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/pkg/security"
+)
+
+// APIKeyHTTPMapper handles HTTP layer conversions for APIKey entity
+type APIKeyHTTPMapper struct{}
+
+func NewAPIKeyHTTPMapper() *APIKeyHTTPMapper {
+	return &APIKeyHTTPMapper{}
+}
+
+// DTOToDomain converts a CreateAPIKeyRequest DTO to a domain.APIKey owned by the user
+func (m *APIKeyHTTPMapper) DTOToDomain(apiKeyDTO *dto.CreateAPIKeyRequest, userID string) *domain.APIKey {
+	if apiKeyDTO == nil {
+		return nil
+	}
+
+	return &domain.APIKey{
+		UserID:    userID,
+		Name:      apiKeyDTO.Name,
+		Scopes:    apiKeyDTO.Scopes,
+		ExpiresAt: apiKeyDTO.ExpiresAt,
+	}
+}
+
+// ToDTO maps an API key; the prefix is shown the way it appears at the start of the key.
+func (m *APIKeyHTTPMapper) ToDTO(entity *domain.APIKey) *dto.APIKeyResponse {
+	if entity == nil {
+		return nil
+	}
+
+	return &dto.APIKeyResponse{
+		ID:         entity.ID,
+		Name:       entity.Name,
+		Prefix:     security.APIKeyScheme + entity.Prefix,
+		Scopes:     entity.Scopes,
+		ExpiresAt:  entity.ExpiresAt,
+		LastUsedAt: entity.LastUsedAt,
+		CreatedAt:  entity.CreatedAt,
+	}
+}
+
+// ToCreatedDTO maps a newly minted API key together with its raw key
+func (m *APIKeyHTTPMapper) ToCreatedDTO(entity *domain.APIKey, rawKey string) *dto.CreatedAPIKeyResponse {
+	if entity == nil {
+		return nil
+	}
+
+	return &dto.CreatedAPIKeyResponse{
+		APIKeyResponse: *m.ToDTO(entity),
+		Key:            rawKey,
+	}
+}
+
+func (m *APIKeyHTTPMapper) ToDTOList(entities []domain.APIKey) []dto.APIKeyResponse {
+	responses := make([]dto.APIKeyResponse, 0, len(entities))
+	for i := range entities {
+		responses = append(responses, *m.ToDTO(&entities[i]))
+	}
+	return responses
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+type AuditHTTPMapper struct {
+	userMapper *UserHTTPMapper
+}
+
+func NewAuditHTTPMapper() *AuditHTTPMapper {
+	return &AuditHTTPMapper{
+		userMapper: NewUserHTTPMapper(),
+	}
+}
+
+// Domain to DTO Conversions (HTTP layer)
+func (m *AuditHTTPMapper) DomainToDTO(entity *domain.AuditEntry) *dto.AuditEntryResponse {
+	if entity == nil {
+		return nil
+	}
+
+	changes := make([]dto.AuditChangeResponse, len(entity.Changes))
+	for i, change := range entity.Changes {
+		changes[i] = dto.AuditChangeResponse{
+			Field: change.Field,
+			Old:   change.Old,
+			New:   change.New,
+		}
+	}
+
+	response := &dto.AuditEntryResponse{
+		ID:         entity.ID,
+		APIKeyID:   entity.APIKeyID,
+		Action:     entity.Action,
+		EntityType: entity.EntityType,
+		EntityID:   entity.EntityID,
+		Before:     entity.Before,
+		After:      entity.After,
+		Changes:    changes,
+		RequestID:  entity.RequestID,
+		IPAddress:  entity.IPAddress,
+		CreatedAt:  entity.CreatedAt,
+	}
+	if entity.Actor != nil {
+		response.Actor = m.userMapper.DomainToShortDTO(entity.Actor)
+	} else if entity.ActorID != nil {
+		response.Actor = &dto.UserShort{ID: *entity.ActorID}
+	}
+	return response
+}
+
+func (m *AuditHTTPMapper) DomainListToDTO(entities []domain.AuditEntry) []dto.AuditEntryResponse {
+	if entities == nil {
+		return nil
+	}
+
+	result := make([]dto.AuditEntryResponse, len(entities))
+	for i, entity := range entities {
+		response := m.DomainToDTO(&entity)
+		if response != nil {
+			result[i] = *response
+		}
+	}
+	return result
+}
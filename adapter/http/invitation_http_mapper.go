@@ -0,0 +1,69 @@
+package http
+
+import (
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+type InvitationHTTPMapper struct {
+	roleMapper *RoleHTTPMapper
+	teamMapper *TeamHTTPMapper
+}
+
+func NewInvitationHTTPMapper() *InvitationHTTPMapper {
+	return &InvitationHTTPMapper{
+		roleMapper: NewRoleHTTPMapper(),
+		teamMapper: NewTeamHTTPMapper(),
+	}
+}
+
+// DTO to Domain Conversions (HTTP layer)
+func (m *InvitationHTTPMapper) DTOToDomain(dto *dto.CreateInvitationRequest, invitedByID string) *domain.Invitation {
+	if dto == nil {
+		return nil
+	}
+
+	return &domain.Invitation{
+		Email:       dto.Email,
+		RoleID:      dto.RoleID,
+		TeamID:      dto.TeamID,
+		InvitedByID: invitedByID,
+	}
+}
+
+func (m *InvitationHTTPMapper) DomainToDTO(entity *domain.Invitation) *dto.InvitationResponse {
+	if entity == nil {
+		return nil
+	}
+
+	return &dto.InvitationResponse{
+		ID:           entity.ID,
+		Email:        entity.Email,
+		Role:         m.roleMapper.DomainToShortDTO(entity.Role),
+		Team:         m.teamMapper.DomainToShortDTO(entity.Team),
+		Status:       entity.Status(time.Now()),
+		InvitedByID:  entity.InvitedByID,
+		ExpiresAt:    entity.ExpiresAt,
+		AcceptedAt:   entity.AcceptedAt,
+		AcceptedByID: entity.AcceptedByID,
+		RevokedAt:    entity.RevokedAt,
+		CreatedAt:    entity.CreatedAt,
+	}
+}
+
+func (m *InvitationHTTPMapper) DomainListToDTO(entities []domain.Invitation) []dto.InvitationResponse {
+	if entities == nil {
+		return nil
+	}
+
+	result := make([]dto.InvitationResponse, len(entities))
+	for i, entity := range entities {
+		response := m.DomainToDTO(&entity)
+		if response != nil {
+			result[i] = *response
+		}
+	}
+	return result
+}
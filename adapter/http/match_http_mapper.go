@@ -20,7 +20,7 @@ func (m *MatchHTTPMapper) DTOToDomain(dto *dto.CreateMatchRequest) *domain.Match
 	return &domain.Match{
 		Status:      dto.Status,
 		Kickoff:     dto.Kickoff,
-		Location:    dto.Location,
+		VenueID:     dto.VenueID,
 		HomeGoals:   dto.HomeGoals,
 		AwayGoals:   dto.AwayGoals,
 		HomeTeamID:  dto.HomeTeamID,
@@ -43,8 +43,8 @@ func (m *MatchHTTPMapper) UpdateDTOToDomain(dto *dto.UpdateMatchRequest) *domain
 	if dto.Kickoff != nil {
 		match.Kickoff = *dto.Kickoff
 	}
-	if dto.Location != nil {
-		match.Location = *dto.Location
+	if dto.VenueID != nil {
+		match.VenueID = dto.VenueID
 	}
 	if dto.HomeGoals != nil {
 		match.HomeGoals = *dto.HomeGoals
@@ -77,7 +77,7 @@ func (m *MatchHTTPMapper) DomainToDTO(entity *domain.Match) *dto.MatchResponse {
 		ID:        entity.ID,
 		Status:    entity.Status,
 		Kickoff:   entity.Kickoff,
-		Location:  entity.Location,
+		Venue:     NewVenueHTTPMapper().DomainToShortDTO(entity.Venue),
 		HomeGoals: entity.HomeGoals,
 		AwayGoals: entity.AwayGoals,
 		CreatedAt: entity.CreatedAt,
@@ -141,12 +141,26 @@ func (m *MatchHTTPMapper) DomainToShortDTO(entity *domain.Match) *dto.MatchShort
 		ID:        entity.ID,
 		Status:    entity.Status,
 		Kickoff:   entity.Kickoff,
-		Location:  entity.Location,
+		Venue:     NewVenueHTTPMapper().DomainToShortDTO(entity.Venue),
 		HomeGoals: entity.HomeGoals,
 		AwayGoals: entity.AwayGoals,
 	}
 }
 
+// DomainToWithTeamsDTO converts a match to a summary including both teams
+func (m *MatchHTTPMapper) DomainToWithTeamsDTO(entity *domain.Match) *dto.MatchWithTeams {
+	if entity == nil {
+		return nil
+	}
+
+	teamMapper := NewTeamHTTPMapper()
+	return &dto.MatchWithTeams{
+		MatchShort: *m.DomainToShortDTO(entity),
+		HomeTeam:   teamMapper.DomainToShortDTO(entity.HomeTeam),
+		AwayTeam:   teamMapper.DomainToShortDTO(entity.AwayTeam),
+	}
+}
+
 func (m *MatchHTTPMapper) DomainToDetailDTO(entity *domain.Match) *dto.MatchDetailResponse {
 	if entity == nil {
 		return nil
@@ -157,7 +171,7 @@ func (m *MatchHTTPMapper) DomainToDetailDTO(entity *domain.Match) *dto.MatchDeta
 		ID:        entity.ID,
 		Status:    entity.Status,
 		Kickoff:   entity.Kickoff,
-		Location:  entity.Location,
+		Venue:     NewVenueHTTPMapper().DomainToShortDTO(entity.Venue),
 		HomeGoals: entity.HomeGoals,
 		AwayGoals: entity.AwayGoals,
 		CreatedAt: entity.CreatedAt,
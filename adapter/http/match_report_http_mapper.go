@@ -0,0 +1,146 @@
+package http
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+const (
+	cardColorYellow = "yellow"
+	cardColorRed    = "red"
+)
+
+type MatchReportHTTPMapper struct {
+	matchMapper  *MatchHTTPMapper
+	playerMapper *PlayerHTTPMapper
+	userMapper   *UserHTTPMapper
+}
+
+func NewMatchReportHTTPMapper() *MatchReportHTTPMapper {
+	return &MatchReportHTTPMapper{
+		matchMapper:  NewMatchHTTPMapper(),
+		playerMapper: NewPlayerHTTPMapper(),
+		userMapper:   NewUserHTTPMapper(),
+	}
+}
+
+// DTO to Domain Conversions (HTTP layer)
+func (m *MatchReportHTTPMapper) DTOToDomain(dto *dto.SubmitMatchReportRequest, matchID uint64, submittedByID string) *domain.MatchReport {
+	if dto == nil {
+		return nil
+	}
+
+	report := &domain.MatchReport{
+		MatchID:       matchID,
+		SubmittedByID: submittedByID,
+		Attendance:    dto.Attendance,
+		Incidents:     dto.Incidents,
+		Events:        make([]domain.MatchReportEvent, 0, len(dto.Scorers)+len(dto.Cards)),
+	}
+	if dto.HomeGoals != nil {
+		report.HomeGoals = *dto.HomeGoals
+	}
+	if dto.AwayGoals != nil {
+		report.AwayGoals = *dto.AwayGoals
+	}
+
+	for _, scorer := range dto.Scorers {
+		eventType := domain.MatchEventGoal
+		if scorer.OwnGoal {
+			eventType = domain.MatchEventOwnGoal
+		}
+		report.Events = append(report.Events, domain.MatchReportEvent{
+			Type:           eventType,
+			PlayerID:       scorer.PlayerID,
+			TeamID:         scorer.TeamID,
+			AssistPlayerID: scorer.AssistPlayerID,
+			Minute:         scorer.Minute,
+		})
+	}
+
+	for _, card := range dto.Cards {
+		eventType := domain.MatchEventYellowCard
+		if card.Color == cardColorRed {
+			eventType = domain.MatchEventRedCard
+		}
+		report.Events = append(report.Events, domain.MatchReportEvent{
+			Type:     eventType,
+			PlayerID: card.PlayerID,
+			TeamID:   card.TeamID,
+			Minute:   card.Minute,
+		})
+	}
+
+	return report
+}
+
+func (m *MatchReportHTTPMapper) DomainToDTO(entity *domain.MatchReport) *dto.MatchReportResponse {
+	if entity == nil {
+		return nil
+	}
+
+	response := &dto.MatchReportResponse{
+		ID:             entity.ID,
+		MatchID:        entity.MatchID,
+		Status:         entity.Status,
+		HomeGoals:      entity.HomeGoals,
+		AwayGoals:      entity.AwayGoals,
+		Attendance:     entity.Attendance,
+		Incidents:      entity.Incidents,
+		Scorers:        make([]dto.MatchReportScorerResponse, 0),
+		Cards:          make([]dto.MatchReportCardResponse, 0),
+		SubmittedBy:    m.userMapper.DomainToShortDTO(entity.SubmittedBy),
+		ReviewComments: entity.ReviewComments,
+		ReviewedByID:   entity.ReviewedByID,
+		ReviewedAt:     entity.ReviewedAt,
+		CreatedAt:      entity.CreatedAt,
+		UpdatedAt:      entity.UpdatedAt,
+	}
+
+	if entity.Match != nil {
+		response.Match = m.matchMapper.DomainToWithTeamsDTO(entity.Match)
+	}
+
+	for _, event := range entity.Events {
+		switch event.Type {
+		case domain.MatchEventGoal, domain.MatchEventOwnGoal:
+			response.Scorers = append(response.Scorers, dto.MatchReportScorerResponse{
+				Player:         m.playerMapper.DomainToShortDTO(event.Player),
+				PlayerID:       event.PlayerID,
+				TeamID:         event.TeamID,
+				Minute:         event.Minute,
+				AssistPlayerID: event.AssistPlayerID,
+				OwnGoal:        event.Type == domain.MatchEventOwnGoal,
+			})
+		case domain.MatchEventYellowCard, domain.MatchEventRedCard:
+			color := cardColorYellow
+			if event.Type == domain.MatchEventRedCard {
+				color = cardColorRed
+			}
+			response.Cards = append(response.Cards, dto.MatchReportCardResponse{
+				Player:   m.playerMapper.DomainToShortDTO(event.Player),
+				PlayerID: event.PlayerID,
+				TeamID:   event.TeamID,
+				Minute:   event.Minute,
+				Color:    color,
+			})
+		}
+	}
+
+	return response
+}
+
+func (m *MatchReportHTTPMapper) DomainListToDTO(entities []domain.MatchReport) []dto.MatchReportResponse {
+	if entities == nil {
+		return nil
+	}
+
+	result := make([]dto.MatchReportResponse, len(entities))
+	for i, entity := range entities {
+		response := m.DomainToDTO(&entity)
+		if response != nil {
+			result[i] = *response
+		}
+	}
+	return result
+}
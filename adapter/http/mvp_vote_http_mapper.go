@@ -0,0 +1,66 @@
+package http
+
+import (
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+type MVPVoteHTTPMapper struct {
+	playerMapper *PlayerHTTPMapper
+}
+
+func NewMVPVoteHTTPMapper() *MVPVoteHTTPMapper {
+	return &MVPVoteHTTPMapper{
+		playerMapper: NewPlayerHTTPMapper(),
+	}
+}
+
+func (m *MVPVoteHTTPMapper) VoteToDTO(entity *domain.MVPVote) *dto.MVPVoteResponse {
+	if entity == nil {
+		return nil
+	}
+
+	return &dto.MVPVoteResponse{
+		MatchID:   entity.MatchID,
+		PlayerID:  entity.PlayerID,
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+func (m *MVPVoteHTTPMapper) ResultsToDTO(results *domain.MVPPollResults, now time.Time) *dto.MVPPollResponse {
+	if results == nil || results.Poll == nil {
+		return nil
+	}
+
+	poll := results.Poll
+	response := &dto.MVPPollResponse{
+		MatchID:    poll.MatchID,
+		OpensAt:    poll.OpensAt,
+		ClosesAt:   poll.ClosesAt,
+		Open:       poll.IsOpenAt(now),
+		ClosedAt:   poll.ClosedAt,
+		Candidates: make([]dto.MVPCandidateResponse, 0, len(results.Candidates)),
+		MyVote:     m.VoteToDTO(results.UserVote),
+	}
+
+	for _, candidate := range results.Candidates {
+		player := m.playerMapper.DomainToShortDTO(candidate.Player)
+		if player == nil {
+			continue
+		}
+
+		entry := dto.MVPCandidateResponse{Player: *player}
+		if results.VotesRevealed {
+			votes := candidate.Votes
+			entry.Votes = &votes
+		}
+		if poll.WinnerPlayerID != nil && *poll.WinnerPlayerID == candidate.Player.ID {
+			response.Winner = player
+		}
+		response.Candidates = append(response.Candidates, entry)
+	}
+
+	return response
+}
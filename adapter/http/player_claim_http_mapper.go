@@ -0,0 +1,99 @@
+package http
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+type PlayerClaimHTTPMapper struct {
+	playerMapper *PlayerHTTPMapper
+	userMapper   *UserHTTPMapper
+}
+
+func NewPlayerClaimHTTPMapper() *PlayerClaimHTTPMapper {
+	return &PlayerClaimHTTPMapper{
+		playerMapper: NewPlayerHTTPMapper(),
+		userMapper:   NewUserHTTPMapper(),
+	}
+}
+
+// DTO to Domain Conversions (HTTP layer)
+func (m *PlayerClaimHTTPMapper) DTOToDomain(dto *dto.CreatePlayerClaimRequest, userID string) *domain.PlayerClaim {
+	if dto == nil {
+		return nil
+	}
+
+	return &domain.PlayerClaim{
+		UserID:   userID,
+		PlayerID: dto.PlayerID,
+		Message:  dto.Message,
+	}
+}
+
+func (m *PlayerClaimHTTPMapper) DomainToDTO(entity *domain.PlayerClaim) *dto.PlayerClaimResponse {
+	if entity == nil {
+		return nil
+	}
+
+	return &dto.PlayerClaimResponse{
+		ID:             entity.ID,
+		PlayerID:       entity.PlayerID,
+		Player:         m.playerMapper.DomainToShortDTO(entity.Player),
+		User:           m.userMapper.DomainToShortDTO(entity.User),
+		Status:         entity.Status,
+		Message:        entity.Message,
+		ReviewComments: entity.ReviewComments,
+		ReviewedByID:   entity.ReviewedByID,
+		ReviewedAt:     entity.ReviewedAt,
+		CreatedAt:      entity.CreatedAt,
+		UpdatedAt:      entity.UpdatedAt,
+	}
+}
+
+func (m *PlayerClaimHTTPMapper) DomainListToDTO(entities []domain.PlayerClaim) []dto.PlayerClaimResponse {
+	if entities == nil {
+		return nil
+	}
+
+	result := make([]dto.PlayerClaimResponse, len(entities))
+	for i, entity := range entities {
+		response := m.DomainToDTO(&entity)
+		if response != nil {
+			result[i] = *response
+		}
+	}
+	return result
+}
+
+func (m *PlayerClaimHTTPMapper) DashboardToDTO(dashboard *domain.PlayerDashboard) *dto.PlayerDashboardResponse {
+	if dashboard == nil {
+		return nil
+	}
+
+	response := &dto.PlayerDashboardResponse{
+		Player:  m.playerMapper.DomainToDTO(dashboard.Player),
+		Seasons: make([]dto.PlayerSeasonSplitResponse, len(dashboard.Seasons)),
+		Totals:  seasonSplitToDTO(dashboard.Totals),
+	}
+	for i, split := range dashboard.Seasons {
+		response.Seasons[i] = seasonSplitToDTO(split)
+	}
+	return response
+}
+
+func seasonSplitToDTO(split domain.PlayerSeasonSplit) dto.PlayerSeasonSplitResponse {
+	return dto.PlayerSeasonSplitResponse{
+		SeasonID:      split.SeasonID,
+		SeasonYear:    split.SeasonYear,
+		Appearances:   split.Appearances,
+		Starts:        split.Starts,
+		MinutesPlayed: split.MinutesPlayed,
+		Goals:         split.Goals,
+		Assists:       split.Assists,
+		Saves:         split.Saves,
+		YellowCards:   split.YellowCards,
+		RedCards:      split.RedCards,
+		MVPs:          split.MVPs,
+		AverageRating: split.AverageRating,
+	}
+}
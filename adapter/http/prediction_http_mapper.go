@@ -0,0 +1,111 @@
+package http
+
+import (
+	"strings"
+
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+type PredictionHTTPMapper struct {
+	matchMapper *MatchHTTPMapper
+}
+
+func NewPredictionHTTPMapper() *PredictionHTTPMapper {
+	return &PredictionHTTPMapper{
+		matchMapper: NewMatchHTTPMapper(),
+	}
+}
+
+// DTO to Domain Conversions (HTTP layer)
+func (m *PredictionHTTPMapper) DTOToDomain(dto *dto.CreatePredictionRequest, matchID uint64, userID string) *domain.Prediction {
+	if dto == nil {
+		return nil
+	}
+
+	prediction := &domain.Prediction{
+		UserID:  userID,
+		MatchID: matchID,
+	}
+	if dto.HomeGoals != nil {
+		prediction.HomeGoals = *dto.HomeGoals
+	}
+	if dto.AwayGoals != nil {
+		prediction.AwayGoals = *dto.AwayGoals
+	}
+
+	return prediction
+}
+
+func (m *PredictionHTTPMapper) UpdateDTOToDomain(dto *dto.UpdatePredictionRequest) *domain.Prediction {
+	if dto == nil {
+		return nil
+	}
+
+	prediction := &domain.Prediction{}
+	if dto.HomeGoals != nil {
+		prediction.HomeGoals = *dto.HomeGoals
+	}
+	if dto.AwayGoals != nil {
+		prediction.AwayGoals = *dto.AwayGoals
+	}
+
+	return prediction
+}
+
+func (m *PredictionHTTPMapper) DomainToDTO(entity *domain.Prediction) *dto.PredictionResponse {
+	if entity == nil {
+		return nil
+	}
+
+	return &dto.PredictionResponse{
+		ID:        entity.ID,
+		MatchID:   entity.MatchID,
+		Match:     m.matchMapper.DomainToWithTeamsDTO(entity.Match),
+		HomeGoals: entity.HomeGoals,
+		AwayGoals: entity.AwayGoals,
+		Points:    entity.Points,
+		Exact:     entity.Exact,
+		ScoredAt:  entity.ScoredAt,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
+
+func (m *PredictionHTTPMapper) DomainListToDTO(entities []domain.Prediction) []dto.PredictionResponse {
+	if entities == nil {
+		return nil
+	}
+
+	result := make([]dto.PredictionResponse, len(entities))
+	for i, entity := range entities {
+		response := m.DomainToDTO(&entity)
+		if response != nil {
+			result[i] = *response
+		}
+	}
+	return result
+}
+
+// LeaderboardToDTO converts leaderboard rows, showing only the first letter of the
+// last name so that fans are not fully identified on a public ranking.
+func (m *PredictionHTTPMapper) LeaderboardToDTO(entries []domain.PredictionLeaderboardEntry) []dto.PredictionLeaderboardEntry {
+	result := make([]dto.PredictionLeaderboardEntry, len(entries))
+	for i, entry := range entries {
+		displayName := entry.Name
+		if lastName := strings.TrimSpace(entry.LastName); lastName != "" {
+			displayName += " " + strings.ToUpper(string([]rune(lastName)[0])) + "."
+		}
+
+		result[i] = dto.PredictionLeaderboardEntry{
+			Rank:        entry.Rank,
+			UserID:      entry.UserID,
+			DisplayName: displayName,
+			ImgProfile:  entry.ImgProfile,
+			Points:      entry.Points,
+			ExactScores: entry.ExactScores,
+			Predictions: entry.Predictions,
+		}
+	}
+	return result
+}
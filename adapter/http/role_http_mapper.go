@@ -56,11 +56,34 @@ func (m *RoleHTTPMapper) DomainToDTO(role *domain.Role) *dto.RoleResponse {
 		ID:          role.ID,
 		Name:        role.Name,
 		Description: role.Description,
+		Permissions: permissionList(role.EffectivePermissions()),
 		CreatedAt:   role.CreatedAt,
 		UpdatedAt:   role.UpdatedAt,
 	}
 }
 
+// DomainToPermissionsDTO converts a domain.Role to RolePermissionsResponse DTO
+func (m *RoleHTTPMapper) DomainToPermissionsDTO(role *domain.Role) *dto.RolePermissionsResponse {
+	if role == nil {
+		return nil
+	}
+
+	return &dto.RolePermissionsResponse{
+		RoleID:      role.ID,
+		RoleName:    role.Name,
+		Permissions: permissionList(role.EffectivePermissions()),
+		Editable:    !role.HasFixedPermissions(),
+	}
+}
+
+// permissionList makes sure roles without permissions are rendered as an empty list
+func permissionList(permissions []string) []string {
+	if permissions == nil {
+		return []string{}
+	}
+	return permissions
+}
+
 // DomainToShortDTO converts a domain.Role to RoleShort DTO
 func (m *RoleHTTPMapper) DomainToShortDTO(role *domain.Role) *dto.RoleShort {
 	if role == nil {
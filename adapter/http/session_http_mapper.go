@@ -0,0 +1,38 @@
+package http
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+type SessionHTTPMapper struct{}
+
+func NewSessionHTTPMapper() *SessionHTTPMapper {
+	return &SessionHTTPMapper{}
+}
+
+// ToDTO maps a session, flagging it when it is the session of the current request.
+func (m *SessionHTTPMapper) ToDTO(entity *domain.Session, currentSessionID string) *dto.SessionResponse {
+	if entity == nil {
+		return nil
+	}
+
+	return &dto.SessionResponse{
+		ID:         entity.ID,
+		UserAgent:  entity.UserAgent,
+		IPAddress:  entity.IPAddress,
+		CreatedAt:  entity.CreatedAt,
+		LastSeenAt: entity.LastSeenAt,
+		ExpiresAt:  entity.ExpiresAt,
+		RevokedAt:  entity.RevokedAt,
+		Current:    entity.ID == currentSessionID,
+	}
+}
+
+func (m *SessionHTTPMapper) ToDTOList(entities []domain.Session, currentSessionID string) []dto.SessionResponse {
+	responses := make([]dto.SessionResponse, 0, len(entities))
+	for i := range entities {
+		responses = append(responses, *m.ToDTO(&entities[i], currentSessionID))
+	}
+	return responses
+}
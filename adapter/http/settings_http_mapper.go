@@ -0,0 +1,41 @@
+package http
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+type SettingsHTTPMapper struct{}
+
+func NewSettingsHTTPMapper() *SettingsHTTPMapper {
+	return &SettingsHTTPMapper{}
+}
+
+// DTO to Domain Conversions (HTTP layer)
+func (m *SettingsHTTPMapper) EmailDomainsDTOToDomain(dto *dto.EmailDomainSettingsRequest) *domain.EmailDomainPolicy {
+	if dto == nil {
+		return nil
+	}
+
+	return &domain.EmailDomainPolicy{
+		Allowed: dto.Allowed,
+		Blocked: dto.Blocked,
+	}
+}
+
+// Domain to DTO Conversions (HTTP layer)
+func (m *SettingsHTTPMapper) EmailDomainsDomainToDTO(policy *domain.EmailDomainPolicy) *dto.EmailDomainSettingsResponse {
+	if policy == nil {
+		return nil
+	}
+
+	response := &dto.EmailDomainSettingsResponse{
+		Allowed:     policy.Allowed,
+		Blocked:     policy.Blocked,
+		UpdatedByID: policy.UpdatedByID,
+	}
+	if !policy.UpdatedAt.IsZero() {
+		response.UpdatedAt = &policy.UpdatedAt
+	}
+	return response
+}
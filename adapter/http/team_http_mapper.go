@@ -26,6 +26,7 @@ func (m *TeamHTTPMapper) DTOToDomain(dto *dto.CreateTeamRequest) *domain.Team {
 		SecondaryColor: dto.SecondaryColor,
 		Shield:         dto.Shield,
 		NextMatchID:    dto.NextMatchID,
+		HomeVenueID:    dto.HomeVenueID,
 		CreatedAt:      time.Now(),
 		UpdatedAt:      time.Now(),
 	}
@@ -56,6 +57,9 @@ func (m *TeamHTTPMapper) UpdateDTOToDomain(dto *dto.UpdateTeamRequest) *domain.T
 	if dto.NextMatchID != nil {
 		team.NextMatchID = dto.NextMatchID
 	}
+	if dto.HomeVenueID != nil {
+		team.HomeVenueID = dto.HomeVenueID
+	}
 
 	return team
 }
@@ -73,6 +77,7 @@ func (m *TeamHTTPMapper) DomainToDTO(entity *domain.Team) *dto.TeamResponse {
 		SecondaryColor: entity.SecondaryColor,
 		Shield:         entity.Shield,
 		NextMatchID:    entity.NextMatchID,
+		HomeVenue:      NewVenueHTTPMapper().DomainToShortDTO(entity.HomeVenue),
 		CreatedAt:      entity.CreatedAt,
 		UpdatedAt:      entity.UpdatedAt,
 	}
@@ -83,7 +88,7 @@ func (m *TeamHTTPMapper) DomainToDTO(entity *domain.Team) *dto.TeamResponse {
 			ID:        entity.NextMatch.ID,
 			Status:    entity.NextMatch.Status,
 			Kickoff:   entity.NextMatch.Kickoff,
-			Location:  entity.NextMatch.Location,
+			Venue:     NewVenueHTTPMapper().DomainToShortDTO(entity.NextMatch.Venue),
 			HomeGoals: entity.NextMatch.HomeGoals,
 			AwayGoals: entity.NextMatch.AwayGoals,
 		}
@@ -63,18 +63,20 @@ func (m *TeamStatsHTTPMapper) UpdateRequestToDomain(request dto.UpdateTeamStatsR
 // Domain to DTO Conversions (HTTP layer)
 func (m *TeamStatsHTTPMapper) DomainToDTO(entity *domain.TeamStats) dto.TeamStatsResponse {
 	response := dto.TeamStatsResponse{
-		ID:           entity.ID,
-		Wins:         entity.Wins,
-		Draws:        entity.Draws,
-		Losses:       entity.Losses,
-		GoalsFor:     entity.GoalsFor,
-		GoalsAgainst: entity.GoalsAgainst,
-		Points:       entity.Points,
-		Rank:         entity.Rank,
-		SeasonID:     entity.SeasonID,
-		TeamID:       entity.TeamID,
-		CreatedAt:    entity.CreatedAt,
-		UpdatedAt:    entity.UpdatedAt,
+		ID:             entity.ID,
+		MatchesPlayed:  entity.MatchesPlayed(),
+		Wins:           entity.Wins,
+		Draws:          entity.Draws,
+		Losses:         entity.Losses,
+		GoalsFor:       entity.GoalsFor,
+		GoalsAgainst:   entity.GoalsAgainst,
+		GoalDifference: entity.GoalDifference(),
+		Points:         entity.Points,
+		Rank:           entity.Rank,
+		SeasonID:       entity.SeasonID,
+		TeamID:         entity.TeamID,
+		CreatedAt:      entity.CreatedAt,
+		UpdatedAt:      entity.UpdatedAt,
 	}
 
 	// Include related entities if they exist
@@ -0,0 +1,42 @@
+package http
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+type TrashHTTPMapper struct{}
+
+func NewTrashHTTPMapper() *TrashHTTPMapper {
+	return &TrashHTTPMapper{}
+}
+
+// Domain to DTO Conversions (HTTP layer)
+func (m *TrashHTTPMapper) DomainToDTO(entity *domain.TrashItem) *dto.TrashItemResponse {
+	if entity == nil {
+		return nil
+	}
+
+	return &dto.TrashItemResponse{
+		EntityType: entity.EntityType,
+		EntityID:   entity.EntityID,
+		Label:      entity.Label,
+		DeletedAt:  entity.DeletedAt,
+		PurgeAt:    entity.PurgeAt,
+	}
+}
+
+func (m *TrashHTTPMapper) DomainListToDTO(entities []domain.TrashItem) []dto.TrashItemResponse {
+	if entities == nil {
+		return nil
+	}
+
+	result := make([]dto.TrashItemResponse, len(entities))
+	for i, entity := range entities {
+		response := m.DomainToDTO(&entity)
+		if response != nil {
+			result[i] = *response
+		}
+	}
+	return result
+}
@@ -1,6 +1,8 @@
 package http
 
 import (
+	"strings"
+
 	"github.com/EdwinRincon/browersfc-api/api/dto"
 	"github.com/EdwinRincon/browersfc-api/domain"
 )
@@ -29,6 +31,19 @@ func (m *UserHTTPMapper) DTOToDomain(userDTO *dto.CreateUserRequest, roleID uint
 	}
 }
 
+// RegisterDTOToDomain converts a RegisterRequest DTO to a domain.User entity.
+// The role is assigned by the domain service.
+func (m *UserHTTPMapper) RegisterDTOToDomain(registerDTO *dto.RegisterRequest) *domain.User {
+	if registerDTO == nil {
+		return nil
+	}
+	return &domain.User{
+		Name:     registerDTO.Name,
+		LastName: registerDTO.LastName,
+		Username: registerDTO.Username,
+	}
+}
+
 // UpdateDTOToDomain converts an UpdateUserRequest DTO to a domain.User entity
 func (m *UserHTTPMapper) UpdateDTOToDomain(userDTO *dto.UpdateUserRequest) *domain.User {
 	if userDTO == nil {
@@ -61,6 +76,37 @@ func (m *UserHTTPMapper) UpdateDTOToDomain(userDTO *dto.UpdateUserRequest) *doma
 	return domainUser
 }
 
+// ProfileDTOToDomain converts an UpdateProfileRequest DTO to a domain.UserProfileUpdate
+func (m *UserHTTPMapper) ProfileDTOToDomain(profileDTO *dto.UpdateProfileRequest) domain.UserProfileUpdate {
+	if profileDTO == nil {
+		return domain.UserProfileUpdate{}
+	}
+	return domain.UserProfileUpdate{
+		Name:       trimmedString(profileDTO.Name),
+		LastName:   trimmedString(profileDTO.LastName),
+		Birthdate:  profileDTO.Birthdate,
+		ImgProfile: trimmedString(profileDTO.ImgProfile),
+		ImgBanner:  trimmedString(profileDTO.ImgBanner),
+	}
+}
+
+// ProfileChangesToDTO converts domain.UserProfileChange entities to response DTOs
+func (m *UserHTTPMapper) ProfileChangesToDTO(changes []domain.UserProfileChange) []dto.UserProfileChangeResponse {
+	result := make([]dto.UserProfileChangeResponse, len(changes))
+	for i, change := range changes {
+		result[i] = dto.UserProfileChangeResponse{
+			ID:        change.ID,
+			ChangedBy: change.ChangedBy,
+			Field:     change.Field,
+			OldValue:  change.OldValue,
+			NewValue:  change.NewValue,
+			IPAddress: change.IPAddress,
+			CreatedAt: change.CreatedAt,
+		}
+	}
+	return result
+}
+
 // DomainToDTO converts a domain.User to UserResponse DTO
 func (m *UserHTTPMapper) DomainToDTO(user *domain.User, _ *dto.RoleShort) *dto.UserResponse {
 	if user == nil {
@@ -73,6 +119,7 @@ func (m *UserHTTPMapper) DomainToDTO(user *domain.User, _ *dto.RoleShort) *dto.U
 		Username:   user.Username,
 		ImgProfile: user.ImgProfile,
 		ImgBanner:  user.ImgBanner,
+		TeamID:     user.TeamID,
 		CreatedAt:  user.CreatedAt,
 		UpdatedAt:  user.UpdatedAt,
 	}
@@ -104,6 +151,24 @@ func (m *UserHTTPMapper) DomainToShortDTO(user *domain.User) *dto.UserShort {
 	}
 }
 
+// DomainToAuthDTO converts a domain.User to the AuthUserResponse returned after a login
+func (m *UserHTTPMapper) DomainToAuthDTO(user *domain.User) *dto.AuthUserResponse {
+	if user == nil {
+		return nil
+	}
+	response := &dto.AuthUserResponse{
+		ID:         user.ID,
+		Name:       user.Name,
+		LastName:   user.LastName,
+		Username:   user.Username,
+		ImgProfile: user.ImgProfile,
+	}
+	if user.Role != nil {
+		response.RoleName = user.Role.Name
+	}
+	return response
+}
+
 // DomainListToDTO converts a slice of domain.User to UserResponse DTOs
 func (m *UserHTTPMapper) DomainListToDTO(users []domain.User) []dto.UserResponse {
 	userResponses := make([]dto.UserResponse, len(users))
@@ -114,3 +179,12 @@ func (m *UserHTTPMapper) DomainListToDTO(users []domain.User) []dto.UserResponse
 	}
 	return userResponses
 }
+
+// trimmedString returns a copy of the string without surrounding whitespace, or nil when it is nil.
+func trimmedString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*s)
+	return &trimmed
+}
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+type VenueHTTPMapper struct{}
+
+func NewVenueHTTPMapper() *VenueHTTPMapper {
+	return &VenueHTTPMapper{}
+}
+
+// DTO to Domain Conversions (HTTP layer)
+func (m *VenueHTTPMapper) DTOToDomain(dto *dto.CreateVenueRequest) *domain.Venue {
+	if dto == nil {
+		return nil
+	}
+
+	return &domain.Venue{
+		Name:      dto.Name,
+		Address:   dto.Address,
+		Capacity:  dto.Capacity,
+		Surface:   dto.Surface,
+		Latitude:  dto.Latitude,
+		Longitude: dto.Longitude,
+	}
+}
+
+func (m *VenueHTTPMapper) UpdateDTOToDomain(dto *dto.UpdateVenueRequest) *domain.Venue {
+	if dto == nil {
+		return nil
+	}
+
+	venue := &domain.Venue{
+		Latitude:  dto.Latitude,
+		Longitude: dto.Longitude,
+	}
+
+	if dto.Name != nil {
+		venue.Name = *dto.Name
+	}
+	if dto.Address != nil {
+		venue.Address = *dto.Address
+	}
+	if dto.Capacity != nil {
+		venue.Capacity = *dto.Capacity
+	}
+	if dto.Surface != nil {
+		venue.Surface = *dto.Surface
+	}
+
+	return venue
+}
+
+func (m *VenueHTTPMapper) DomainToDTO(entity *domain.Venue) *dto.VenueResponse {
+	if entity == nil {
+		return nil
+	}
+
+	return &dto.VenueResponse{
+		ID:        entity.ID,
+		Name:      entity.Name,
+		Address:   entity.Address,
+		Capacity:  entity.Capacity,
+		Surface:   entity.Surface,
+		Latitude:  entity.Latitude,
+		Longitude: entity.Longitude,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
+
+func (m *VenueHTTPMapper) DomainListToDTO(entities []domain.Venue) []dto.VenueResponse {
+	if entities == nil {
+		return nil
+	}
+
+	result := make([]dto.VenueResponse, len(entities))
+	for i, entity := range entities {
+		response := m.DomainToDTO(&entity)
+		if response != nil {
+			result[i] = *response
+		}
+	}
+	return result
+}
+
+func (m *VenueHTTPMapper) DomainToShortDTO(entity *domain.Venue) *dto.VenueShort {
+	if entity == nil {
+		return nil
+	}
+
+	return &dto.VenueShort{
+		ID:   entity.ID,
+		Name: entity.Name,
+	}
+}
@@ -31,20 +31,26 @@ func (m *AuthenticationMapper) ToDomainClaims(appClaims *jwt.AppClaims) *domain.
 	}
 
 	return &domain.AuthenticationClaims{
-		UserID:    appClaims.Subject, // JWT Subject contains the user ID
-		Username:  appClaims.Username,
-		Role:      appClaims.Role,
-		ExpiresAt: expiresAt,
-		IssuedAt:  issuedAt,
+		TokenID:     appClaims.ID,      // JWT ID (jti) identifies the token for revocation
+		UserID:      appClaims.Subject, // JWT Subject contains the user ID
+		Username:    appClaims.Username,
+		Role:        appClaims.Role,
+		Permissions: appClaims.Permissions,
+		SessionID:   appClaims.SessionID,
+		ExpiresAt:   expiresAt,
+		IssuedAt:    issuedAt,
 	}
 }
 
 // FromDomainClaims converts domain authentication claims to JWT AppClaims
 func (m *AuthenticationMapper) FromDomainClaims(domainClaims *domain.AuthenticationClaims) *jwt.AppClaims {
 	return &jwt.AppClaims{
-		Username: domainClaims.Username,
-		Role:     domainClaims.Role,
+		Username:    domainClaims.Username,
+		Role:        domainClaims.Role,
+		Permissions: domainClaims.Permissions,
+		SessionID:   domainClaims.SessionID,
 		RegisteredClaims: jwtlib.RegisteredClaims{
+			ID:        domainClaims.TokenID,
 			Subject:   domainClaims.UserID,
 			ExpiresAt: jwtlib.NewNumericDate(domainClaims.ExpiresAt),
 			IssuedAt:  jwtlib.NewNumericDate(domainClaims.IssuedAt),
@@ -0,0 +1,48 @@
+package persistence
+
+import (
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+)
+
+// AccountPersistenceMapper handles persistence layer conversions for account deletion requests
+type AccountPersistenceMapper struct{}
+
+func NewAccountPersistenceMapper() *AccountPersistenceMapper {
+	return &AccountPersistenceMapper{}
+}
+
+// DeletionToModel converts a domain.AccountDeletion to model.AccountDeletion for persistence
+func (m *AccountPersistenceMapper) DeletionToModel(entity *domain.AccountDeletion) *model.AccountDeletion {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.AccountDeletion{
+		UserID:      entity.UserID,
+		RequestedAt: entity.RequestedAt,
+		ScheduledAt: entity.ScheduledAt,
+	}
+}
+
+// DeletionToDomain converts a model.AccountDeletion to domain.AccountDeletion for business logic
+func (m *AccountPersistenceMapper) DeletionToDomain(model *model.AccountDeletion) *domain.AccountDeletion {
+	if model == nil {
+		return nil
+	}
+
+	return &domain.AccountDeletion{
+		UserID:      model.UserID,
+		RequestedAt: model.RequestedAt,
+		ScheduledAt: model.ScheduledAt,
+	}
+}
+
+// DeletionListToDomain converts a slice of model.AccountDeletion to domain.AccountDeletion
+func (m *AccountPersistenceMapper) DeletionListToDomain(models []model.AccountDeletion) []domain.AccountDeletion {
+	result := make([]domain.AccountDeletion, len(models))
+	for i := range models {
+		result[i] = *m.DeletionToDomain(&models[i])
+	}
+	return result
+}
@@ -0,0 +1,79 @@
+package persistence
+
+import (
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+)
+
+type APIKeyPersistenceMapper struct{}
+
+func NewAPIKeyPersistenceMapper() *APIKeyPersistenceMapper {
+	return &APIKeyPersistenceMapper{}
+}
+
+// Domain to Model Conversions (Infrastructure layer)
+func (m *APIKeyPersistenceMapper) ToModel(entity *domain.APIKey) *model.APIKey {
+	if entity == nil {
+		return nil
+	}
+
+	scopes := make([]model.APIKeyScope, len(entity.Scopes))
+	for i, scope := range entity.Scopes {
+		scopes[i] = model.APIKeyScope{
+			APIKeyID:   entity.ID,
+			Permission: scope,
+		}
+	}
+
+	return &model.APIKey{
+		ID:         entity.ID,
+		UserID:     entity.UserID,
+		Name:       entity.Name,
+		Prefix:     entity.Prefix,
+		KeyHash:    entity.KeyHash,
+		Scopes:     scopes,
+		ExpiresAt:  entity.ExpiresAt,
+		LastUsedAt: entity.LastUsedAt,
+		RevokedAt:  entity.RevokedAt,
+		CreatedAt:  entity.CreatedAt,
+	}
+}
+
+func (m *APIKeyPersistenceMapper) ToDomain(model *model.APIKey) *domain.APIKey {
+	if model == nil {
+		return nil
+	}
+
+	scopes := make([]string, len(model.Scopes))
+	for i, scope := range model.Scopes {
+		scopes[i] = scope.Permission
+	}
+
+	return &domain.APIKey{
+		ID:         model.ID,
+		UserID:     model.UserID,
+		Name:       model.Name,
+		Prefix:     model.Prefix,
+		KeyHash:    model.KeyHash,
+		Scopes:     scopes,
+		ExpiresAt:  model.ExpiresAt,
+		LastUsedAt: model.LastUsedAt,
+		RevokedAt:  model.RevokedAt,
+		CreatedAt:  model.CreatedAt,
+	}
+}
+
+func (m *APIKeyPersistenceMapper) ToDomainList(models []model.APIKey) []domain.APIKey {
+	if models == nil {
+		return nil
+	}
+
+	domains := make([]domain.APIKey, len(models))
+	for i, model := range models {
+		domain := m.ToDomain(&model)
+		if domain != nil {
+			domains[i] = *domain
+		}
+	}
+	return domains
+}
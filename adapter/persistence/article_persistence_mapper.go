@@ -25,6 +25,7 @@ func (m *ArticlePersistenceMapper) DomainToModel(entity *domain.Article) *model.
 		ImgBanner: entity.ImgBanner,
 		Date:      entity.Date,
 		SeasonID:  entity.SeasonID,
+		Version:   entity.Version,
 		CreatedAt: entity.CreatedAt,
 		UpdatedAt: entity.UpdatedAt,
 	}
@@ -57,6 +58,7 @@ func (m *ArticlePersistenceMapper) ModelToDomain(model *model.Article) *domain.A
 		Date:      model.Date,
 		SeasonID:  model.SeasonID,
 		Season:    season,
+		Version:   model.Version,
 		CreatedAt: model.CreatedAt,
 		UpdatedAt: model.UpdatedAt,
 	}
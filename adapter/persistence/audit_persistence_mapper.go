@@ -0,0 +1,94 @@
+package persistence
+
+import (
+	"encoding/json"
+
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+)
+
+type AuditPersistenceMapper struct {
+	userMapper *UserPersistenceMapper
+}
+
+func NewAuditPersistenceMapper() *AuditPersistenceMapper {
+	return &AuditPersistenceMapper{
+		userMapper: NewUserPersistenceMapper(),
+	}
+}
+
+// Domain to Model Conversions (Infrastructure layer)
+func (m *AuditPersistenceMapper) DomainToModel(entity *domain.AuditEntry) (*model.AuditEntry, error) {
+	if entity == nil {
+		return nil, nil
+	}
+
+	changes, err := json.Marshal(entity.Changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AuditEntry{
+		ID:         entity.ID,
+		ActorID:    entity.ActorID,
+		APIKeyID:   entity.APIKeyID,
+		Action:     entity.Action,
+		EntityType: entity.EntityType,
+		EntityID:   entity.EntityID,
+		Before:     rawMessageToString(entity.Before),
+		After:      rawMessageToString(entity.After),
+		Changes:    string(changes),
+		RequestID:  entity.RequestID,
+		IPAddress:  entity.IPAddress,
+		CreatedAt:  entity.CreatedAt,
+	}, nil
+}
+
+func (m *AuditPersistenceMapper) ModelToDomain(model *model.AuditEntry) *domain.AuditEntry {
+	if model == nil {
+		return nil
+	}
+
+	var changes []domain.AuditChange
+	// Entries are only written by this mapper, so the JSON is known to be valid
+	_ = json.Unmarshal([]byte(model.Changes), &changes)
+
+	return &domain.AuditEntry{
+		ID:         model.ID,
+		ActorID:    model.ActorID,
+		APIKeyID:   model.APIKeyID,
+		Action:     model.Action,
+		EntityType: model.EntityType,
+		EntityID:   model.EntityID,
+		Before:     stringToRawMessage(model.Before),
+		After:      stringToRawMessage(model.After),
+		Changes:    changes,
+		RequestID:  model.RequestID,
+		IPAddress:  model.IPAddress,
+		CreatedAt:  model.CreatedAt,
+		Actor:      m.userMapper.ModelToDomain(model.Actor),
+	}
+}
+
+func (m *AuditPersistenceMapper) ModelListToDomain(models []model.AuditEntry) []domain.AuditEntry {
+	result := make([]domain.AuditEntry, len(models))
+	for i := range models {
+		result[i] = *m.ModelToDomain(&models[i])
+	}
+	return result
+}
+
+func rawMessageToString(data json.RawMessage) *string {
+	if len(data) == 0 {
+		return nil
+	}
+	value := string(data)
+	return &value
+}
+
+func stringToRawMessage(value *string) json.RawMessage {
+	if value == nil {
+		return nil
+	}
+	return json.RawMessage(*value)
+}
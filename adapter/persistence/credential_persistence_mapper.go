@@ -0,0 +1,69 @@
+package persistence
+
+import (
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+)
+
+type CredentialPersistenceMapper struct{}
+
+func NewCredentialPersistenceMapper() *CredentialPersistenceMapper {
+	return &CredentialPersistenceMapper{}
+}
+
+// Domain to Model Conversions (Infrastructure layer)
+func (m *CredentialPersistenceMapper) ToModel(entity *domain.PasswordCredential) *model.PasswordCredential {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.PasswordCredential{
+		UserID:       entity.UserID,
+		PasswordHash: entity.PasswordHash,
+		CreatedAt:    entity.CreatedAt,
+		UpdatedAt:    entity.UpdatedAt,
+	}
+}
+
+func (m *CredentialPersistenceMapper) ToDomain(model *model.PasswordCredential) *domain.PasswordCredential {
+	if model == nil {
+		return nil
+	}
+
+	return &domain.PasswordCredential{
+		UserID:       model.UserID,
+		PasswordHash: model.PasswordHash,
+		CreatedAt:    model.CreatedAt,
+		UpdatedAt:    model.UpdatedAt,
+	}
+}
+
+func (m *CredentialPersistenceMapper) ResetTokenToModel(entity *domain.PasswordResetToken) *model.PasswordResetToken {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.PasswordResetToken{
+		ID:        entity.ID,
+		UserID:    entity.UserID,
+		TokenHash: entity.TokenHash,
+		ExpiresAt: entity.ExpiresAt,
+		UsedAt:    entity.UsedAt,
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+func (m *CredentialPersistenceMapper) ResetTokenToDomain(model *model.PasswordResetToken) *domain.PasswordResetToken {
+	if model == nil {
+		return nil
+	}
+
+	return &domain.PasswordResetToken{
+		ID:        model.ID,
+		UserID:    model.UserID,
+		TokenHash: model.TokenHash,
+		ExpiresAt: model.ExpiresAt,
+		UsedAt:    model.UsedAt,
+		CreatedAt: model.CreatedAt,
+	}
+}
@@ -0,0 +1,49 @@
+package persistence
+
+import (
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+)
+
+type EmailDomainRulePersistenceMapper struct{}
+
+func NewEmailDomainRulePersistenceMapper() *EmailDomainRulePersistenceMapper {
+	return &EmailDomainRulePersistenceMapper{}
+}
+
+// Domain to Model Conversions (Infrastructure layer)
+func (m *EmailDomainRulePersistenceMapper) DomainToModel(entity *domain.EmailDomainRule) *model.EmailDomainRule {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.EmailDomainRule{
+		ID:          entity.ID,
+		Domain:      entity.Domain,
+		Kind:        entity.Kind,
+		CreatedByID: entity.CreatedByID,
+		CreatedAt:   entity.CreatedAt,
+	}
+}
+
+func (m *EmailDomainRulePersistenceMapper) ModelToDomain(model *model.EmailDomainRule) *domain.EmailDomainRule {
+	if model == nil {
+		return nil
+	}
+
+	return &domain.EmailDomainRule{
+		ID:          model.ID,
+		Domain:      model.Domain,
+		Kind:        model.Kind,
+		CreatedByID: model.CreatedByID,
+		CreatedAt:   model.CreatedAt,
+	}
+}
+
+func (m *EmailDomainRulePersistenceMapper) ModelListToDomain(models []model.EmailDomainRule) []domain.EmailDomainRule {
+	result := make([]domain.EmailDomainRule, len(models))
+	for i := range models {
+		result[i] = *m.ModelToDomain(&models[i])
+	}
+	return result
+}
@@ -0,0 +1,76 @@
+package persistence
+
+import (
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+)
+
+type InvitationPersistenceMapper struct {
+	roleMapper *RolePersistenceMapper
+	teamMapper *TeamPersistenceMapper
+}
+
+func NewInvitationPersistenceMapper() *InvitationPersistenceMapper {
+	return &InvitationPersistenceMapper{
+		roleMapper: NewRolePersistenceMapper(),
+		teamMapper: NewTeamPersistenceMapper(),
+	}
+}
+
+// Domain to Model Conversions (Infrastructure layer)
+func (m *InvitationPersistenceMapper) DomainToModel(entity *domain.Invitation) *model.Invitation {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.Invitation{
+		ID:           entity.ID,
+		Email:        entity.Email,
+		RoleID:       entity.RoleID,
+		TeamID:       entity.TeamID,
+		TokenHash:    entity.TokenHash,
+		InvitedByID:  entity.InvitedByID,
+		ExpiresAt:    entity.ExpiresAt,
+		AcceptedAt:   entity.AcceptedAt,
+		AcceptedByID: entity.AcceptedByID,
+		RevokedAt:    entity.RevokedAt,
+		CreatedAt:    entity.CreatedAt,
+	}
+}
+
+func (m *InvitationPersistenceMapper) ModelToDomain(model *model.Invitation) *domain.Invitation {
+	if model == nil {
+		return nil
+	}
+
+	return &domain.Invitation{
+		ID:           model.ID,
+		Email:        model.Email,
+		RoleID:       model.RoleID,
+		TeamID:       model.TeamID,
+		TokenHash:    model.TokenHash,
+		InvitedByID:  model.InvitedByID,
+		ExpiresAt:    model.ExpiresAt,
+		AcceptedAt:   model.AcceptedAt,
+		AcceptedByID: model.AcceptedByID,
+		RevokedAt:    model.RevokedAt,
+		CreatedAt:    model.CreatedAt,
+		Role:         m.roleMapper.ModelToDomain(model.Role),
+		Team:         m.teamMapper.ModelToDomain(model.Team),
+	}
+}
+
+func (m *InvitationPersistenceMapper) ModelListToDomain(models []model.Invitation) []domain.Invitation {
+	if models == nil {
+		return nil
+	}
+
+	result := make([]domain.Invitation, len(models))
+	for i, model := range models {
+		entity := m.ModelToDomain(&model)
+		if entity != nil {
+			result[i] = *entity
+		}
+	}
+	return result
+}
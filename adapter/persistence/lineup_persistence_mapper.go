@@ -23,6 +23,7 @@ func (m *LineupPersistenceMapper) DomainToModel(entity *domain.Lineup) *model.Li
 		PlayerID:  entity.PlayerID,
 		MatchID:   entity.MatchID,
 		Starting:  entity.Starting,
+		Version:   entity.Version,
 		CreatedAt: entity.CreatedAt,
 		UpdatedAt: entity.UpdatedAt,
 	}
@@ -65,6 +66,7 @@ func (m *LineupPersistenceMapper) ModelToDomain(model *model.Lineup) *domain.Lin
 		PlayerID:  model.PlayerID,
 		MatchID:   model.MatchID,
 		Starting:  model.Starting,
+		Version:   model.Version,
 		CreatedAt: model.CreatedAt,
 		UpdatedAt: model.UpdatedAt,
 		Player:    player,
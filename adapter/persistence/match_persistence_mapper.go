@@ -21,13 +21,14 @@ func (m *MatchPersistenceMapper) DomainToModel(entity *domain.Match) *model.Matc
 		ID:          entity.ID,
 		Status:      entity.Status,
 		Kickoff:     entity.Kickoff,
-		Location:    entity.Location,
+		VenueID:     entity.VenueID,
 		HomeGoals:   entity.HomeGoals,
 		AwayGoals:   entity.AwayGoals,
 		HomeTeamID:  entity.HomeTeamID,
 		AwayTeamID:  entity.AwayTeamID,
 		SeasonID:    entity.SeasonID,
 		MVPPlayerID: entity.MVPPlayerID,
+		Version:     entity.Version,
 		CreatedAt:   entity.CreatedAt,
 		UpdatedAt:   entity.UpdatedAt,
 	}
@@ -42,13 +43,14 @@ func (m *MatchPersistenceMapper) ModelToDomain(model *model.Match) *domain.Match
 		ID:          model.ID,
 		Status:      model.Status,
 		Kickoff:     model.Kickoff,
-		Location:    model.Location,
+		VenueID:     model.VenueID,
 		HomeGoals:   model.HomeGoals,
 		AwayGoals:   model.AwayGoals,
 		HomeTeamID:  model.HomeTeamID,
 		AwayTeamID:  model.AwayTeamID,
 		SeasonID:    model.SeasonID,
 		MVPPlayerID: model.MVPPlayerID,
+		Version:     model.Version,
 		CreatedAt:   model.CreatedAt,
 		UpdatedAt:   model.UpdatedAt,
 	}
@@ -74,6 +76,11 @@ func (m *MatchPersistenceMapper) ModelToDomain(model *model.Match) *domain.Match
 		domainMatch.MVPPlayer = playerMapper.ModelToDomain(model.MVPPlayer)
 	}
 
+	if model.Venue != nil {
+		venueMapper := NewVenuePersistenceMapper()
+		domainMatch.Venue = venueMapper.ModelToDomain(model.Venue)
+	}
+
 	return domainMatch
 }
 
@@ -0,0 +1,124 @@
+package persistence
+
+import (
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+)
+
+type MatchReportPersistenceMapper struct{}
+
+func NewMatchReportPersistenceMapper() *MatchReportPersistenceMapper {
+	return &MatchReportPersistenceMapper{}
+}
+
+// Domain to Model Conversions (Infrastructure layer)
+func (m *MatchReportPersistenceMapper) DomainToModel(entity *domain.MatchReport) *model.MatchReport {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.MatchReport{
+		ID:             entity.ID,
+		MatchID:        entity.MatchID,
+		SubmittedByID:  entity.SubmittedByID,
+		Status:         entity.Status,
+		HomeGoals:      entity.HomeGoals,
+		AwayGoals:      entity.AwayGoals,
+		Attendance:     entity.Attendance,
+		Incidents:      entity.Incidents,
+		ReviewComments: entity.ReviewComments,
+		ReviewedByID:   entity.ReviewedByID,
+		ReviewedAt:     entity.ReviewedAt,
+		Events:         m.EventsToModel(entity.ID, entity.Events),
+		CreatedAt:      entity.CreatedAt,
+		UpdatedAt:      entity.UpdatedAt,
+	}
+}
+
+// EventsToModel converts report events, attaching them to the given report ID
+func (m *MatchReportPersistenceMapper) EventsToModel(reportID uint64, events []domain.MatchReportEvent) []model.MatchReportEvent {
+	if events == nil {
+		return nil
+	}
+
+	models := make([]model.MatchReportEvent, len(events))
+	for i, event := range events {
+		models[i] = model.MatchReportEvent{
+			ReportID:       reportID,
+			Type:           event.Type,
+			PlayerID:       event.PlayerID,
+			TeamID:         event.TeamID,
+			AssistPlayerID: event.AssistPlayerID,
+			Minute:         event.Minute,
+		}
+	}
+	return models
+}
+
+func (m *MatchReportPersistenceMapper) ModelToDomain(model *model.MatchReport) *domain.MatchReport {
+	if model == nil {
+		return nil
+	}
+
+	report := &domain.MatchReport{
+		ID:             model.ID,
+		MatchID:        model.MatchID,
+		SubmittedByID:  model.SubmittedByID,
+		Status:         model.Status,
+		HomeGoals:      model.HomeGoals,
+		AwayGoals:      model.AwayGoals,
+		Attendance:     model.Attendance,
+		Incidents:      model.Incidents,
+		ReviewComments: model.ReviewComments,
+		ReviewedByID:   model.ReviewedByID,
+		ReviewedAt:     model.ReviewedAt,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}
+
+	if model.Events != nil {
+		playerMapper := NewPlayerPersistenceMapper()
+		report.Events = make([]domain.MatchReportEvent, len(model.Events))
+		for i, event := range model.Events {
+			report.Events[i] = domain.MatchReportEvent{
+				ID:             event.ID,
+				ReportID:       event.ReportID,
+				Type:           event.Type,
+				PlayerID:       event.PlayerID,
+				TeamID:         event.TeamID,
+				AssistPlayerID: event.AssistPlayerID,
+				Minute:         event.Minute,
+				Player:         playerMapper.ModelToDomain(event.Player),
+			}
+		}
+	}
+
+	// Map preloaded relationships if they exist
+	if model.Match != nil {
+		matchMapper := NewMatchPersistenceMapper()
+		report.Match = matchMapper.ModelToDomain(model.Match)
+	}
+
+	if model.SubmittedBy != nil {
+		userMapper := NewUserPersistenceMapper()
+		report.SubmittedBy = userMapper.ModelToDomain(model.SubmittedBy)
+	}
+
+	return report
+}
+
+func (m *MatchReportPersistenceMapper) ModelListToDomain(models []model.MatchReport) []domain.MatchReport {
+	if models == nil {
+		return nil
+	}
+
+	domains := make([]domain.MatchReport, len(models))
+	for i, model := range models {
+		domain := m.ModelToDomain(&model)
+		if domain != nil {
+			domains[i] = *domain
+		}
+	}
+
+	return domains
+}
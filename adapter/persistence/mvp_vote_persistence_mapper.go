@@ -0,0 +1,91 @@
+package persistence
+
+import (
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+)
+
+type MVPVotePersistenceMapper struct{}
+
+func NewMVPVotePersistenceMapper() *MVPVotePersistenceMapper {
+	return &MVPVotePersistenceMapper{}
+}
+
+// Domain to Model Conversions (Infrastructure layer)
+func (m *MVPVotePersistenceMapper) PollToModel(entity *domain.MVPPoll) *model.MVPPoll {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.MVPPoll{
+		ID:             entity.ID,
+		MatchID:        entity.MatchID,
+		OpensAt:        entity.OpensAt,
+		ClosesAt:       entity.ClosesAt,
+		ClosedAt:       entity.ClosedAt,
+		WinnerPlayerID: entity.WinnerPlayerID,
+		CreatedAt:      entity.CreatedAt,
+		UpdatedAt:      entity.UpdatedAt,
+	}
+}
+
+func (m *MVPVotePersistenceMapper) PollToDomain(model *model.MVPPoll) *domain.MVPPoll {
+	if model == nil {
+		return nil
+	}
+
+	return &domain.MVPPoll{
+		ID:             model.ID,
+		MatchID:        model.MatchID,
+		OpensAt:        model.OpensAt,
+		ClosesAt:       model.ClosesAt,
+		ClosedAt:       model.ClosedAt,
+		WinnerPlayerID: model.WinnerPlayerID,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}
+}
+
+func (m *MVPVotePersistenceMapper) PollListToDomain(models []model.MVPPoll) []domain.MVPPoll {
+	if models == nil {
+		return nil
+	}
+
+	domains := make([]domain.MVPPoll, len(models))
+	for i, model := range models {
+		domain := m.PollToDomain(&model)
+		if domain != nil {
+			domains[i] = *domain
+		}
+	}
+
+	return domains
+}
+
+func (m *MVPVotePersistenceMapper) VoteToModel(entity *domain.MVPVote) *model.MVPVote {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.MVPVote{
+		ID:        entity.ID,
+		MatchID:   entity.MatchID,
+		UserID:    entity.UserID,
+		PlayerID:  entity.PlayerID,
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+func (m *MVPVotePersistenceMapper) VoteToDomain(model *model.MVPVote) *domain.MVPVote {
+	if model == nil {
+		return nil
+	}
+
+	return &domain.MVPVote{
+		ID:        model.ID,
+		MatchID:   model.MatchID,
+		UserID:    model.UserID,
+		PlayerID:  model.PlayerID,
+		CreatedAt: model.CreatedAt,
+	}
+}
@@ -0,0 +1,67 @@
+package persistence
+
+import (
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+)
+
+type PlayerClaimPersistenceMapper struct {
+	userMapper   *UserPersistenceMapper
+	playerMapper *PlayerPersistenceMapper
+}
+
+func NewPlayerClaimPersistenceMapper() *PlayerClaimPersistenceMapper {
+	return &PlayerClaimPersistenceMapper{
+		userMapper:   NewUserPersistenceMapper(),
+		playerMapper: NewPlayerPersistenceMapper(),
+	}
+}
+
+// Domain to Model Conversions (Infrastructure layer)
+func (m *PlayerClaimPersistenceMapper) DomainToModel(entity *domain.PlayerClaim) *model.PlayerClaim {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.PlayerClaim{
+		ID:             entity.ID,
+		UserID:         entity.UserID,
+		PlayerID:       entity.PlayerID,
+		Status:         entity.Status,
+		Message:        entity.Message,
+		ReviewComments: entity.ReviewComments,
+		ReviewedByID:   entity.ReviewedByID,
+		ReviewedAt:     entity.ReviewedAt,
+		CreatedAt:      entity.CreatedAt,
+		UpdatedAt:      entity.UpdatedAt,
+	}
+}
+
+func (m *PlayerClaimPersistenceMapper) ModelToDomain(model *model.PlayerClaim) *domain.PlayerClaim {
+	if model == nil {
+		return nil
+	}
+
+	return &domain.PlayerClaim{
+		ID:             model.ID,
+		UserID:         model.UserID,
+		PlayerID:       model.PlayerID,
+		Status:         model.Status,
+		Message:        model.Message,
+		ReviewComments: model.ReviewComments,
+		ReviewedByID:   model.ReviewedByID,
+		ReviewedAt:     model.ReviewedAt,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+		User:           m.userMapper.ModelToDomain(model.User),
+		Player:         m.playerMapper.ModelToDomain(model.Player),
+	}
+}
+
+func (m *PlayerClaimPersistenceMapper) ModelListToDomain(models []model.PlayerClaim) []domain.PlayerClaim {
+	result := make([]domain.PlayerClaim, len(models))
+	for i := range models {
+		result[i] = *m.ModelToDomain(&models[i])
+	}
+	return result
+}
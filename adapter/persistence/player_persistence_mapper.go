@@ -38,6 +38,7 @@ func (m *PlayerPersistenceMapper) DomainToModel(entity *domain.Player) *model.Pl
 		CareerSummary: entity.CareerSummary,
 		MVPCount:      entity.MVPCount,
 		UserID:        entity.UserID,
+		Version:       entity.Version,
 		CreatedAt:     entity.CreatedAt,
 		UpdatedAt:     entity.UpdatedAt,
 	}
@@ -69,6 +70,7 @@ func (m *PlayerPersistenceMapper) ModelToDomain(model *model.Player) *domain.Pla
 		CareerSummary: model.CareerSummary,
 		MVPCount:      model.MVPCount,
 		UserID:        model.UserID,
+		Version:       model.Version,
 		CreatedAt:     model.CreatedAt,
 		UpdatedAt:     model.UpdatedAt,
 	}
@@ -33,6 +33,7 @@ func (m *PlayerStatsPersistenceMapper) DomainToModel(entity *domain.PlayerStat)
 		MinutesPlayed: entity.MinutesPlayed,
 		IsMVP:         entity.IsMVP,
 		Position:      entity.Position,
+		Version:       entity.Version,
 		CreatedAt:     entity.CreatedAt,
 		UpdatedAt:     entity.UpdatedAt,
 	}
@@ -59,6 +60,7 @@ func (m *PlayerStatsPersistenceMapper) ModelToDomain(model *model.PlayerStat) *d
 		MinutesPlayed: model.MinutesPlayed,
 		IsMVP:         model.IsMVP,
 		Position:      model.Position,
+		Version:       model.Version,
 		CreatedAt:     model.CreatedAt,
 		UpdatedAt:     model.UpdatedAt,
 	}
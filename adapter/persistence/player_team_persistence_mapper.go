@@ -22,6 +22,7 @@ func (m *PlayerTeamPersistenceMapper) DomainToModel(entity *domain.PlayerTeam) *
 		PlayerID:  entity.PlayerID,
 		TeamID:    entity.TeamID,
 		SeasonID:  entity.SeasonID,
+		Version:   entity.Version,
 		CreatedAt: entity.CreatedAt,
 		UpdatedAt: entity.UpdatedAt,
 	}
@@ -60,6 +61,7 @@ func (m *PlayerTeamPersistenceMapper) ModelToDomain(model *model.PlayerTeam) *do
 		Season:    season,
 		StartDate: model.StartDate,
 		EndDate:   model.EndDate,
+		Version:   model.Version,
 		CreatedAt: model.CreatedAt,
 		UpdatedAt: model.UpdatedAt,
 	}
@@ -0,0 +1,80 @@
+package persistence
+
+import (
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+)
+
+type PredictionPersistenceMapper struct{}
+
+func NewPredictionPersistenceMapper() *PredictionPersistenceMapper {
+	return &PredictionPersistenceMapper{}
+}
+
+// Domain to Model Conversions (Infrastructure layer)
+func (m *PredictionPersistenceMapper) DomainToModel(entity *domain.Prediction) *model.Prediction {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.Prediction{
+		ID:        entity.ID,
+		UserID:    entity.UserID,
+		MatchID:   entity.MatchID,
+		HomeGoals: entity.HomeGoals,
+		AwayGoals: entity.AwayGoals,
+		Points:    entity.Points,
+		Exact:     entity.Exact,
+		ScoredAt:  entity.ScoredAt,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
+
+func (m *PredictionPersistenceMapper) ModelToDomain(model *model.Prediction) *domain.Prediction {
+	if model == nil {
+		return nil
+	}
+
+	prediction := &domain.Prediction{
+		ID:        model.ID,
+		UserID:    model.UserID,
+		MatchID:   model.MatchID,
+		HomeGoals: model.HomeGoals,
+		AwayGoals: model.AwayGoals,
+		Points:    model.Points,
+		Exact:     model.Exact,
+		ScoredAt:  model.ScoredAt,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+
+	// Map preloaded relationships if they exist
+	if model.Match != nil {
+		matchMapper := NewMatchPersistenceMapper()
+		prediction.Match = matchMapper.ModelToDomain(model.Match)
+	}
+
+	if model.User != nil {
+		userMapper := NewUserPersistenceMapper()
+		prediction.User = userMapper.ModelToDomain(model.User)
+	}
+
+	return prediction
+}
+
+func (m *PredictionPersistenceMapper) ModelListToDomain(models []model.Prediction) []domain.Prediction {
+	if models == nil {
+		return nil
+	}
+
+	domains := make([]domain.Prediction, len(models))
+	for i, model := range models {
+		domain := m.ModelToDomain(&model)
+		if domain != nil {
+			domains[i] = *domain
+		}
+	}
+
+	return domains
+}
@@ -0,0 +1,47 @@
+package persistence
+
+import (
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+)
+
+type RefreshTokenPersistenceMapper struct{}
+
+func NewRefreshTokenPersistenceMapper() *RefreshTokenPersistenceMapper {
+	return &RefreshTokenPersistenceMapper{}
+}
+
+// Domain to Model Conversions (Infrastructure layer)
+func (m *RefreshTokenPersistenceMapper) ToModel(entity *domain.RefreshToken) *model.RefreshToken {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.RefreshToken{
+		ID:        entity.ID,
+		UserID:    entity.UserID,
+		FamilyID:  entity.FamilyID,
+		TokenHash: entity.TokenHash,
+		ExpiresAt: entity.ExpiresAt,
+		UsedAt:    entity.UsedAt,
+		RevokedAt: entity.RevokedAt,
+		CreatedAt: entity.CreatedAt,
+	}
+}
+
+func (m *RefreshTokenPersistenceMapper) ToDomain(model *model.RefreshToken) *domain.RefreshToken {
+	if model == nil {
+		return nil
+	}
+
+	return &domain.RefreshToken{
+		ID:        model.ID,
+		UserID:    model.UserID,
+		FamilyID:  model.FamilyID,
+		TokenHash: model.TokenHash,
+		ExpiresAt: model.ExpiresAt,
+		UsedAt:    model.UsedAt,
+		RevokedAt: model.RevokedAt,
+		CreatedAt: model.CreatedAt,
+	}
+}
@@ -22,6 +22,7 @@ func (m *RolePersistenceMapper) DomainToModel(domainRole *domain.Role) *model.Ro
 		ID:          domainRole.ID,
 		Name:        domainRole.Name,
 		Description: domainRole.Description,
+		Version:     domainRole.Version,
 	}
 
 	// Only set timestamps if they have meaningful values (not zero time)
@@ -41,15 +42,37 @@ func (m *RolePersistenceMapper) ModelToDomain(modelRole *model.Role) *domain.Rol
 		return nil
 	}
 
+	var permissions []string
+	if len(modelRole.Permissions) > 0 {
+		permissions = make([]string, len(modelRole.Permissions))
+		for i, rolePermission := range modelRole.Permissions {
+			permissions[i] = rolePermission.Permission
+		}
+	}
+
 	return &domain.Role{
 		ID:          modelRole.ID,
 		Name:        modelRole.Name,
 		Description: modelRole.Description,
+		Permissions: permissions,
+		Version:     modelRole.Version,
 		CreatedAt:   modelRole.CreatedAt,
 		UpdatedAt:   modelRole.UpdatedAt,
 	}
 }
 
+// PermissionsToModel converts a role's permissions to model.RolePermission rows
+func (m *RolePersistenceMapper) PermissionsToModel(roleID uint64, permissions []string) []model.RolePermission {
+	rolePermissions := make([]model.RolePermission, len(permissions))
+	for i, permission := range permissions {
+		rolePermissions[i] = model.RolePermission{
+			RoleID:     roleID,
+			Permission: permission,
+		}
+	}
+	return rolePermissions
+}
+
 // ModelListToDomain converts a slice of model.Role to domain.Role for business logic
 func (m *RolePersistenceMapper) ModelListToDomain(roles []model.Role) []domain.Role {
 	result := make([]domain.Role, len(roles))
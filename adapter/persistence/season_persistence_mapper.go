@@ -23,6 +23,7 @@ func (m *SeasonPersistenceMapper) DomainToModel(entity *domain.Season) *model.Se
 		StartDate: entity.StartDate,
 		EndDate:   entity.EndDate,
 		IsCurrent: entity.IsCurrent,
+		Version:   entity.Version,
 		CreatedAt: entity.CreatedAt,
 		UpdatedAt: entity.UpdatedAt,
 	}
@@ -39,6 +40,7 @@ func (m *SeasonPersistenceMapper) ModelToDomain(model *model.Season) *domain.Sea
 		StartDate: model.StartDate,
 		EndDate:   model.EndDate,
 		IsCurrent: model.IsCurrent,
+		Version:   model.Version,
 		CreatedAt: model.CreatedAt,
 		UpdatedAt: model.UpdatedAt,
 	}
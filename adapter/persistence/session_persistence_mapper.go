@@ -0,0 +1,62 @@
+package persistence
+
+import (
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+)
+
+type SessionPersistenceMapper struct{}
+
+func NewSessionPersistenceMapper() *SessionPersistenceMapper {
+	return &SessionPersistenceMapper{}
+}
+
+// Domain to Model Conversions (Infrastructure layer)
+func (m *SessionPersistenceMapper) ToModel(entity *domain.Session) *model.Session {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.Session{
+		ID:         entity.ID,
+		UserID:     entity.UserID,
+		UserAgent:  entity.UserAgent,
+		IPAddress:  entity.IPAddress,
+		LastSeenAt: entity.LastSeenAt,
+		ExpiresAt:  entity.ExpiresAt,
+		RevokedAt:  entity.RevokedAt,
+		CreatedAt:  entity.CreatedAt,
+	}
+}
+
+func (m *SessionPersistenceMapper) ToDomain(model *model.Session) *domain.Session {
+	if model == nil {
+		return nil
+	}
+
+	return &domain.Session{
+		ID:         model.ID,
+		UserID:     model.UserID,
+		UserAgent:  model.UserAgent,
+		IPAddress:  model.IPAddress,
+		LastSeenAt: model.LastSeenAt,
+		ExpiresAt:  model.ExpiresAt,
+		RevokedAt:  model.RevokedAt,
+		CreatedAt:  model.CreatedAt,
+	}
+}
+
+func (m *SessionPersistenceMapper) ToDomainList(models []model.Session) []domain.Session {
+	if models == nil {
+		return nil
+	}
+
+	domains := make([]domain.Session, len(models))
+	for i, model := range models {
+		domain := m.ToDomain(&model)
+		if domain != nil {
+			domains[i] = *domain
+		}
+	}
+	return domains
+}
@@ -25,6 +25,8 @@ func (m *TeamPersistenceMapper) DomainToModel(entity *domain.Team) *model.Team {
 		SecondaryColor: entity.SecondaryColor,
 		Shield:         entity.Shield,
 		NextMatchID:    entity.NextMatchID,
+		HomeVenueID:    entity.HomeVenueID,
+		Version:        entity.Version,
 		CreatedAt:      entity.CreatedAt,
 		UpdatedAt:      entity.UpdatedAt,
 	}
@@ -43,6 +45,8 @@ func (m *TeamPersistenceMapper) ModelToDomain(model *model.Team) *domain.Team {
 		SecondaryColor: model.SecondaryColor,
 		Shield:         model.Shield,
 		NextMatchID:    model.NextMatchID,
+		HomeVenueID:    model.HomeVenueID,
+		Version:        model.Version,
 		CreatedAt:      model.CreatedAt,
 		UpdatedAt:      model.UpdatedAt,
 	}
@@ -53,10 +57,17 @@ func (m *TeamPersistenceMapper) ModelToDomain(model *model.Team) *domain.Team {
 			ID:        model.NextMatch.ID,
 			Status:    model.NextMatch.Status,
 			Kickoff:   model.NextMatch.Kickoff,
-			Location:  model.NextMatch.Location,
 			HomeGoals: model.NextMatch.HomeGoals,
 			AwayGoals: model.NextMatch.AwayGoals,
 		}
+		if model.NextMatch.Venue != nil {
+			team.NextMatch.Venue = NewVenuePersistenceMapper().ModelToDomain(model.NextMatch.Venue)
+		}
+	}
+
+	if model.HomeVenue != nil {
+		venueMapper := NewVenuePersistenceMapper()
+		team.HomeVenue = venueMapper.ModelToDomain(model.HomeVenue)
 	}
 
 	return team
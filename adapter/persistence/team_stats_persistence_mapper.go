@@ -28,6 +28,7 @@ func (m *TeamStatsPersistenceMapper) DomainToModel(entity *domain.TeamStats) *mo
 		Rank:         entity.Rank,
 		SeasonID:     entity.SeasonID,
 		TeamID:       entity.TeamID,
+		Version:      entity.Version,
 		CreatedAt:    entity.CreatedAt,
 		UpdatedAt:    entity.UpdatedAt,
 	}
@@ -63,6 +64,7 @@ func (m *TeamStatsPersistenceMapper) ModelToDomain(model *model.TeamStat) *domai
 		TeamID:       model.TeamID,
 		Team:         team,
 		Season:       season,
+		Version:      model.Version,
 		CreatedAt:    model.CreatedAt,
 		UpdatedAt:    model.UpdatedAt,
 	}
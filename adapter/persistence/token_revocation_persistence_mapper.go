@@ -0,0 +1,26 @@
+package persistence
+
+import (
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+)
+
+type TokenRevocationPersistenceMapper struct{}
+
+func NewTokenRevocationPersistenceMapper() *TokenRevocationPersistenceMapper {
+	return &TokenRevocationPersistenceMapper{}
+}
+
+// Domain to Model Conversions (Infrastructure layer)
+func (m *TokenRevocationPersistenceMapper) ToModel(entity *domain.RevokedToken) *model.RevokedToken {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.RevokedToken{
+		TokenID:   entity.TokenID,
+		UserID:    entity.UserID,
+		ExpiresAt: entity.ExpiresAt,
+		RevokedAt: entity.RevokedAt,
+	}
+}
@@ -0,0 +1,45 @@
+package persistence
+
+import (
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+)
+
+type UserIdentityPersistenceMapper struct{}
+
+func NewUserIdentityPersistenceMapper() *UserIdentityPersistenceMapper {
+	return &UserIdentityPersistenceMapper{}
+}
+
+// Domain to Model Conversions (Infrastructure layer)
+func (m *UserIdentityPersistenceMapper) ToModel(entity *domain.UserIdentity) *model.UserIdentity {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.UserIdentity{
+		ID:          entity.ID,
+		UserID:      entity.UserID,
+		Provider:    entity.Provider,
+		Subject:     entity.Subject,
+		Email:       entity.Email,
+		LastLoginAt: entity.LastLoginAt,
+		CreatedAt:   entity.CreatedAt,
+	}
+}
+
+func (m *UserIdentityPersistenceMapper) ToDomain(model *model.UserIdentity) *domain.UserIdentity {
+	if model == nil {
+		return nil
+	}
+
+	return &domain.UserIdentity{
+		ID:          model.ID,
+		UserID:      model.UserID,
+		Provider:    model.Provider,
+		Subject:     model.Subject,
+		Email:       model.Email,
+		LastLoginAt: model.LastLoginAt,
+		CreatedAt:   model.CreatedAt,
+	}
+}
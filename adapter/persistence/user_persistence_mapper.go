@@ -31,6 +31,8 @@ func (m *UserPersistenceMapper) DomainToModel(domainUser *domain.User) *model.Us
 		ImgProfile: domainUser.ImgProfile,
 		ImgBanner:  domainUser.ImgBanner,
 		RoleID:     domainUser.RoleID,
+		TeamID:     domainUser.TeamID,
+		Version:    domainUser.Version,
 	}
 
 	// Only set timestamps if they have meaningful values (not zero time)
@@ -66,6 +68,8 @@ func (m *UserPersistenceMapper) ModelToDomain(modelUser *model.User) *domain.Use
 		ImgBanner:  modelUser.ImgBanner,
 		RoleID:     modelUser.RoleID,
 		Role:       role,
+		TeamID:     modelUser.TeamID,
+		Version:    modelUser.Version,
 		CreatedAt:  modelUser.CreatedAt,
 		UpdatedAt:  modelUser.UpdatedAt,
 	}
@@ -81,3 +85,39 @@ func (m *UserPersistenceMapper) ModelListToDomain(users []model.User) []domain.U
 	}
 	return result
 }
+
+// ProfileChangeToModel converts a domain.UserProfileChange to model.UserProfileChange for persistence
+func (m *UserPersistenceMapper) ProfileChangeToModel(change *domain.UserProfileChange) *model.UserProfileChange {
+	if change == nil {
+		return nil
+	}
+
+	return &model.UserProfileChange{
+		ID:        change.ID,
+		UserID:    change.UserID,
+		ChangedBy: change.ChangedBy,
+		Field:     change.Field,
+		OldValue:  change.OldValue,
+		NewValue:  change.NewValue,
+		IPAddress: change.IPAddress,
+		CreatedAt: change.CreatedAt,
+	}
+}
+
+// ProfileChangeToDomain converts a model.UserProfileChange to domain.UserProfileChange for business logic
+func (m *UserPersistenceMapper) ProfileChangeToDomain(change *model.UserProfileChange) *domain.UserProfileChange {
+	if change == nil {
+		return nil
+	}
+
+	return &domain.UserProfileChange{
+		ID:        change.ID,
+		UserID:    change.UserID,
+		ChangedBy: change.ChangedBy,
+		Field:     change.Field,
+		OldValue:  change.OldValue,
+		NewValue:  change.NewValue,
+		IPAddress: change.IPAddress,
+		CreatedAt: change.CreatedAt,
+	}
+}
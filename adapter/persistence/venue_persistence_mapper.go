@@ -0,0 +1,67 @@
+package persistence
+
+import (
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+)
+
+type VenuePersistenceMapper struct{}
+
+func NewVenuePersistenceMapper() *VenuePersistenceMapper {
+	return &VenuePersistenceMapper{}
+}
+
+// Domain to Model Conversions (Infrastructure layer)
+func (m *VenuePersistenceMapper) DomainToModel(entity *domain.Venue) *model.Venue {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.Venue{
+		ID:        entity.ID,
+		Name:      entity.Name,
+		Address:   entity.Address,
+		Capacity:  entity.Capacity,
+		Surface:   entity.Surface,
+		Latitude:  entity.Latitude,
+		Longitude: entity.Longitude,
+		Version:   entity.Version,
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
+
+func (m *VenuePersistenceMapper) ModelToDomain(model *model.Venue) *domain.Venue {
+	if model == nil {
+		return nil
+	}
+
+	return &domain.Venue{
+		ID:        model.ID,
+		Name:      model.Name,
+		Address:   model.Address,
+		Capacity:  model.Capacity,
+		Surface:   model.Surface,
+		Latitude:  model.Latitude,
+		Longitude: model.Longitude,
+		Version:   model.Version,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+}
+
+func (m *VenuePersistenceMapper) ModelListToDomain(models []model.Venue) []domain.Venue {
+	if models == nil {
+		return nil
+	}
+
+	domains := make([]domain.Venue, len(models))
+	for i, model := range models {
+		domain := m.ModelToDomain(&model)
+		if domain != nil {
+			domains[i] = *domain
+		}
+	}
+
+	return domains
+}
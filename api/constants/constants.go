@@ -7,31 +7,46 @@ import (
 // User Roles
 const (
 	RoleAdmin   = "admin"
+	RoleCoach   = "coach"
+	RoleReferee = "referee"
 	RolePlayer  = "player"
 	RoleDefault = "fan"
 )
 
 // Common error messages
 const (
-	MsgInvalidID         = "Invalid ID format"
-	MsgInvalidTeamID     = "Invalid team ID"
-	MsgInvalidPlayerID   = "Invalid player ID"
-	MsgInvalidUserID     = "Invalid user ID"
-	MsgInvalidRoleData   = "Invalid role data"
-	MsgInvalidSeasonData = "Invalid season data"
-	MsgInvalidData       = "Invalid data"
-	MsgInvalidTeamData   = "Invalid team data"
-	MsgInvalidUserData   = "Invalid user data"
-	MsgInvalidLineupID   = "Invalid lineup ID format"
-	MsgInvalidMatchID    = "Invalid match ID format"
-	MsgNotFound          = "Resource not found"
-	MsgUnauthorized      = "Unauthorized access"
-	MsgForbidden         = "Forbidden access"
-	MsgInternalError     = "An unexpected error occurred"
-	MsgLineupCreated     = "Lineup created successfully"
-	MsgLineupRetrieved   = "Lineup retrieved successfully"
-	MsgLineupsRetrieved  = "Lineups retrieved successfully"
-	MsgLineupUpdated     = "Lineup updated successfully"
+	MsgInvalidID             = "Invalid ID format"
+	MsgInvalidTeamID         = "Invalid team ID"
+	MsgInvalidPlayerID       = "Invalid player ID"
+	MsgInvalidUserID         = "Invalid user ID"
+	MsgInvalidRoleData       = "Invalid role data"
+	MsgInvalidSeasonData     = "Invalid season data"
+	MsgInvalidData           = "Invalid data"
+	MsgInvalidTeamData       = "Invalid team data"
+	MsgInvalidUserData       = "Invalid user data"
+	MsgInvalidLineupID       = "Invalid lineup ID format"
+	MsgInvalidMatchID        = "Invalid match ID format"
+	MsgInvalidVenueID        = "Invalid venue ID"
+	MsgInvalidVenueData      = "Invalid venue data"
+	MsgInvalidReportID       = "Invalid match report ID"
+	MsgInvalidReportData     = "Invalid match report data"
+	MsgInvalidPredictionID   = "Invalid prediction ID"
+	MsgInvalidPredictionData = "Invalid prediction data"
+	MsgInvalidVoteData       = "Invalid MVP vote data"
+	MsgInvalidAPIKeyData     = "Invalid API key data"
+	MsgInvalidClaimID        = "Invalid player claim ID"
+	MsgInvalidClaimData      = "Invalid player claim data"
+	MsgInvalidInvitationID   = "Invalid invitation ID"
+	MsgInvalidInvitationData = "Invalid invitation data"
+	MsgInvalidEmailDomains   = "Domains must be valid host names and cannot be both allowed and blocked"
+	MsgNotFound              = "Resource not found"
+	MsgUnauthorized          = "Unauthorized access"
+	MsgForbidden             = "Forbidden access"
+	MsgInternalError         = "An unexpected error occurred"
+	MsgLineupCreated         = "Lineup created successfully"
+	MsgLineupRetrieved       = "Lineup retrieved successfully"
+	MsgLineupsRetrieved      = "Lineups retrieved successfully"
+	MsgLineupUpdated         = "Lineup updated successfully"
 
 	// Common validation messages
 	MsgInvalidIDSimple = "Invalid ID"
@@ -39,8 +54,11 @@ const (
 
 // Database query constants
 const (
-	QueryIDEquals    = "id = ?"
-	QueryOrderFormat = "`%s` %s"
+	QueryIDEquals      = "id = ?"
+	QueryVersionEquals = "version = ?"
+	QueryOrderFormat   = "`%s` %s"
+	// QueryMatchNotDeleted keeps the lineups and player stats of matches in the trash out of listings
+	QueryMatchNotDeleted = "match_id IN (SELECT id FROM matches WHERE deleted_at IS NULL)"
 )
 
 // Database preload constants for GORM
@@ -53,18 +71,59 @@ const (
 var (
 	ErrRecordNotFound          = errors.New("record not found")
 	ErrRecordAlreadyExists     = errors.New("record already exists")
+	ErrRecordInUse             = errors.New("record is referenced by other records")
+	ErrVersionMismatch         = errors.New("record was modified by another request")
 	ErrInvalidData             = errors.New("invalid data")
 	ErrInvalidID               = errors.New("invalid ID")
 	ErrInvalidPaginationParams = errors.New("invalid pagination parameters")
 	ErrInvalidRequestFormat    = errors.New("invalid request format")
 	ErrInternalServer          = errors.New("internal server error")
 	ErrCannotDeleteSystemRole  = errors.New("cannot delete system role")
+	ErrCannotRenameSystemRole  = errors.New("cannot rename system role")
 	ErrPlayerNotFound          = errors.New("player not found")
 	ErrTeamNotFound            = errors.New("team not found")
 	ErrSeasonNotFound          = errors.New("season not found")
 	ErrMatchNotFound           = errors.New("match not found")
 	ErrLineupNotFound          = errors.New("lineup not found")
+	ErrVenueNotFound           = errors.New("venue not found")
+	ErrVenueInUse              = errors.New("venue is referenced by existing matches")
+	ErrMatchNotCompleted       = errors.New("match is not completed")
+	ErrMatchReportNotFound     = errors.New("match report not found")
+	ErrMatchReportNotPending   = errors.New("match report is not pending review")
+	ErrMatchReportApproved     = errors.New("match already has an approved report")
+	ErrMatchReportInconsistent = errors.New("match report events do not match the final score")
+	ErrForbidden               = errors.New("forbidden")
+	ErrPredictionNotFound      = errors.New("prediction not found")
+	ErrPredictionsClosed       = errors.New("predictions are closed for this match")
+	ErrMVPPollNotFound         = errors.New("MVP voting has not started for this match")
+	ErrMVPVotingClosed         = errors.New("MVP voting is closed for this match")
+	ErrPlayerNotInLineup       = errors.New("player did not appear in the match lineups")
+	ErrUserCannotManageTeam    = errors.New("user role does not grant any team-scoped permission")
+	ErrInvalidPermission       = errors.New("unknown permission")
+	ErrFixedRolePermissions    = errors.New("role permissions cannot be modified")
+	ErrInvalidRefreshToken     = errors.New("invalid or expired refresh token")
+	ErrRefreshTokenReused      = errors.New("refresh token was already used")
+	ErrTokenRevoked            = errors.New("token has been revoked")
+	ErrSessionNotFound         = errors.New("session not found")
+	ErrAPIKeyNotFound          = errors.New("API key not found")
+	ErrInvalidAPIKey           = errors.New("invalid, expired or revoked API key")
+	ErrScopeNotGranted         = errors.New("scope is not granted to the key owner")
+	ErrEmailNotVerified        = errors.New("email is not verified by the identity provider")
+	ErrEmailDomainNotAllowed   = errors.New("email domain not allowed")
+	ErrInvalidCredentials      = errors.New("invalid username or password")
+	ErrTooManyLoginAttempts    = errors.New("too many failed login attempts")
+	ErrInvalidPassword         = errors.New("password does not meet the password policy")
+	ErrInvalidResetToken       = errors.New("invalid or expired password reset token")
 	ErrOverlappingDates        = errors.New("date range overlaps with existing player team record")
+	ErrPlayerClaimNotFound     = errors.New("player claim not found")
+	ErrPlayerClaimNotPending   = errors.New("player claim is not pending review")
+	ErrPlayerClaimPending      = errors.New("user already has a pending player claim")
+	ErrPlayerAlreadyLinked     = errors.New("player is already linked to a user")
+	ErrUserAlreadyLinked       = errors.New("user is already linked to a player")
+	ErrInvitationNotFound      = errors.New("invitation not found")
+	ErrInvitationNotUsable     = errors.New("invitation was already accepted, revoked or has expired")
+	ErrIdentityAlreadyLinked   = errors.New("identity is already linked to a user")
+	ErrMailNotSent             = errors.New("email could not be sent")
 )
 
 const APIBasePath = "/api"
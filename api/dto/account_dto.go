@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"time"
+)
+
+// AccountDeletionResponse describes a pending request to erase the current user's account.
+type AccountDeletionResponse struct {
+	RequestedAt time.Time `json:"requested_at"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+}
+
+// UserIdentityExport is an external sign-in method of the user.
+type UserIdentityExport struct {
+	Provider    string    `json:"provider"`
+	Email       string    `json:"email"`
+	CreatedAt   time.Time `json:"created_at"`
+	LastLoginAt time.Time `json:"last_login_at"`
+}
+
+// UserDataExportResponse is the personal data held about the current user.
+type UserDataExportResponse struct {
+	GeneratedAt    time.Time                   `json:"generated_at"`
+	Profile        UserResponse                `json:"profile"`
+	Player         *PlayerResponse             `json:"player,omitempty"`
+	Predictions    []PredictionResponse        `json:"predictions"`
+	MVPVotes       []MVPVoteResponse           `json:"mvp_votes"`
+	Sessions       []SessionResponse           `json:"sessions"`
+	Identities     []UserIdentityExport        `json:"identities"`
+	APIKeys        []APIKeyResponse            `json:"api_keys"`
+	ProfileChanges []UserProfileChangeResponse `json:"profile_changes"`
+	AuditEntries   []AuditEntryResponse        `json:"audit_entries"`
+	Deletion       *AccountDeletionResponse    `json:"deletion,omitempty"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditChangeResponse struct {
+	Field string          `json:"field" example:"HomeGoals"`
+	Old   json.RawMessage `json:"old,omitempty" swaggertype:"string" example:"1"`
+	New   json.RawMessage `json:"new,omitempty" swaggertype:"string" example:"2"`
+}
+
+// AuditEntryResponse is a change made to an entity. Before and after hold the fields of the
+// entity without its related entities; they are missing for creations and deletions respectively.
+type AuditEntryResponse struct {
+	ID         uint64                `json:"id"`
+	Actor      *UserShort            `json:"actor,omitempty"`
+	APIKeyID   *string               `json:"api_key_id,omitempty"`
+	Action     string                `json:"action" example:"update"`
+	EntityType string                `json:"entity_type" example:"match"`
+	EntityID   string                `json:"entity_id" example:"42"`
+	Before     json.RawMessage       `json:"before,omitempty" swaggertype:"object"`
+	After      json.RawMessage       `json:"after,omitempty" swaggertype:"object"`
+	Changes    []AuditChangeResponse `json:"changes"`
+	RequestID  string                `json:"request_id"`
+	IPAddress  string                `json:"ip_address"`
+	CreatedAt  time.Time             `json:"created_at"`
+}
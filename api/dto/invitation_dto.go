@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"time"
+)
+
+type CreateInvitationRequest struct {
+	Email  string  `json:"email" binding:"required,email,max=100" example:"coach@example.com"`
+	RoleID uint64  `json:"role_id" binding:"required,gte=1,lte=255" example:"3"`
+	TeamID *uint64 `json:"team_id,omitempty" binding:"omitempty,min=1" example:"1"`
+}
+
+// AcceptInvitationRequest starts the login that accepts an invitation with the chosen provider.
+type AcceptInvitationRequest struct {
+	Token    string `json:"token" binding:"required,max=100"`
+	Provider string `json:"provider" binding:"required,max=30" example:"google"`
+}
+
+type InvitationResponse struct {
+	ID           uint64     `json:"id"`
+	Email        string     `json:"email"`
+	Role         *RoleShort `json:"role,omitempty"`
+	Team         *TeamShort `json:"team,omitempty"`
+	Status       string     `json:"status" example:"pending"`
+	InvitedByID  string     `json:"invited_by_id"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+	AcceptedAt   *time.Time `json:"accepted_at,omitempty"`
+	AcceptedByID *string    `json:"accepted_by_id,omitempty"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
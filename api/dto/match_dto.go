@@ -7,7 +7,7 @@ import (
 type CreateMatchRequest struct {
 	Status      string    `json:"status" binding:"required,oneof=scheduled in_progress completed postponed cancelled"`
 	Kickoff     time.Time `json:"kickoff" binding:"required"`
-	Location    string    `json:"location" binding:"required,max=35"`
+	VenueID     *uint64   `json:"venue_id" binding:"required"`
 	HomeGoals   uint8     `json:"home_goals"`
 	AwayGoals   uint8     `json:"away_goals"`
 	HomeTeamID  uint64    `json:"home_team_id" binding:"required"`
@@ -19,7 +19,7 @@ type CreateMatchRequest struct {
 type UpdateMatchRequest struct {
 	Status      *string    `json:"status,omitempty" binding:"omitempty,oneof=scheduled in_progress completed postponed cancelled"`
 	Kickoff     *time.Time `json:"kickoff,omitempty" binding:"omitempty"`
-	Location    *string    `json:"location,omitempty" binding:"omitempty,max=35"`
+	VenueID     *uint64    `json:"venue_id,omitempty"`
 	HomeGoals   *uint8     `json:"home_goals,omitempty"`
 	AwayGoals   *uint8     `json:"away_goals,omitempty"`
 	HomeTeamID  *uint64    `json:"home_team_id,omitempty"`
@@ -32,7 +32,7 @@ type MatchResponse struct {
 	ID        uint64       `json:"id"`
 	Status    string       `json:"status"`
 	Kickoff   time.Time    `json:"kickoff"`
-	Location  string       `json:"location"`
+	Venue     *VenueShort  `json:"venue,omitempty"`
 	HomeGoals uint8        `json:"home_goals"`
 	AwayGoals uint8        `json:"away_goals"`
 	HomeTeam  TeamShort    `json:"home_team,omitempty"`
@@ -45,12 +45,19 @@ type MatchResponse struct {
 
 // MatchShort is a simplified match representation for use in other responses
 type MatchShort struct {
-	ID        uint64    `json:"id"`
-	Status    string    `json:"status"`
-	Kickoff   time.Time `json:"kickoff"`
-	Location  string    `json:"location"`
-	HomeGoals uint8     `json:"home_goals"`
-	AwayGoals uint8     `json:"away_goals"`
+	ID        uint64      `json:"id"`
+	Status    string      `json:"status"`
+	Kickoff   time.Time   `json:"kickoff"`
+	Venue     *VenueShort `json:"venue,omitempty"`
+	HomeGoals uint8       `json:"home_goals"`
+	AwayGoals uint8       `json:"away_goals"`
+}
+
+// MatchWithTeams is a match summary that also identifies both teams
+type MatchWithTeams struct {
+	MatchShort
+	HomeTeam *TeamShort `json:"home_team,omitempty"`
+	AwayTeam *TeamShort `json:"away_team,omitempty"`
 }
 
 // MatchDetailResponse represents a detailed match response including lineups and stats
@@ -58,7 +65,7 @@ type MatchDetailResponse struct {
 	ID          uint64            `json:"id"`
 	Status      string            `json:"status"`
 	Kickoff     time.Time         `json:"kickoff"`
-	Location    string            `json:"location"`
+	Venue       *VenueShort       `json:"venue,omitempty"`
 	HomeGoals   uint8             `json:"home_goals"`
 	AwayGoals   uint8             `json:"away_goals"`
 	HomeTeam    TeamShort         `json:"home_team,omitempty"`
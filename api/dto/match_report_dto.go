@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"time"
+)
+
+// SubmitMatchReportRequest is used both to submit a report and to resubmit it after rejection.
+type SubmitMatchReportRequest struct {
+	HomeGoals  *uint8                 `json:"home_goals" binding:"required" example:"2"`
+	AwayGoals  *uint8                 `json:"away_goals" binding:"required" example:"1"`
+	Attendance uint32                 `json:"attendance" example:"350"`
+	Incidents  string                 `json:"incidents" binding:"max=2000" example:"Match delayed 10 minutes due to rain"`
+	Scorers    []MatchReportScorerDTO `json:"scorers" binding:"omitempty,dive"`
+	Cards      []MatchReportCardDTO   `json:"cards" binding:"omitempty,dive"`
+}
+
+type MatchReportScorerDTO struct {
+	PlayerID       uint64  `json:"player_id" binding:"required" example:"7"`
+	TeamID         uint64  `json:"team_id" binding:"required" example:"1"`
+	Minute         uint8   `json:"minute" binding:"lte=130" example:"34"`
+	AssistPlayerID *uint64 `json:"assist_player_id,omitempty" example:"9"`
+	OwnGoal        bool    `json:"own_goal" example:"false"`
+}
+
+type MatchReportCardDTO struct {
+	PlayerID uint64 `json:"player_id" binding:"required" example:"4"`
+	TeamID   uint64 `json:"team_id" binding:"required" example:"2"`
+	Minute   uint8  `json:"minute" binding:"lte=130" example:"61"`
+	Color    string `json:"color" binding:"required,oneof=yellow red" example:"yellow"`
+}
+
+type ReviewMatchReportRequest struct {
+	Comments string `json:"comments" binding:"max=2000" example:"Scorer in minute 34 was number 9, not 7"`
+}
+
+type MatchReportResponse struct {
+	ID             uint64                      `json:"id"`
+	MatchID        uint64                      `json:"match_id"`
+	Match          *MatchWithTeams             `json:"match,omitempty"`
+	Status         string                      `json:"status"`
+	HomeGoals      uint8                       `json:"home_goals"`
+	AwayGoals      uint8                       `json:"away_goals"`
+	Attendance     uint32                      `json:"attendance"`
+	Incidents      string                      `json:"incidents"`
+	Scorers        []MatchReportScorerResponse `json:"scorers"`
+	Cards          []MatchReportCardResponse   `json:"cards"`
+	SubmittedBy    *UserShort                  `json:"submitted_by,omitempty"`
+	ReviewComments string                      `json:"review_comments,omitempty"`
+	ReviewedByID   *string                     `json:"reviewed_by_id,omitempty"`
+	ReviewedAt     *time.Time                  `json:"reviewed_at,omitempty"`
+	CreatedAt      time.Time                   `json:"created_at"`
+	UpdatedAt      time.Time                   `json:"updated_at"`
+}
+
+type MatchReportScorerResponse struct {
+	Player         *PlayerShort `json:"player,omitempty"`
+	PlayerID       uint64       `json:"player_id"`
+	TeamID         uint64       `json:"team_id"`
+	Minute         uint8        `json:"minute"`
+	AssistPlayerID *uint64      `json:"assist_player_id,omitempty"`
+	OwnGoal        bool         `json:"own_goal"`
+}
+
+type MatchReportCardResponse struct {
+	Player   *PlayerShort `json:"player,omitempty"`
+	PlayerID uint64       `json:"player_id"`
+	TeamID   uint64       `json:"team_id"`
+	Minute   uint8        `json:"minute"`
+	Color    string       `json:"color"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"time"
+)
+
+type CreateMVPVoteRequest struct {
+	PlayerID uint64 `json:"player_id" binding:"required" example:"7"`
+}
+
+type MVPVoteResponse struct {
+	MatchID   uint64    `json:"match_id"`
+	PlayerID  uint64    `json:"player_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// MVPPollResponse shows the MVP vote of a match. Vote counts are only included once voting has closed.
+type MVPPollResponse struct {
+	MatchID    uint64                 `json:"match_id"`
+	OpensAt    time.Time              `json:"opens_at"`
+	ClosesAt   time.Time              `json:"closes_at"`
+	Open       bool                   `json:"open"`
+	ClosedAt   *time.Time             `json:"closed_at,omitempty"`
+	Winner     *PlayerShort           `json:"winner,omitempty"`
+	Candidates []MVPCandidateResponse `json:"candidates"`
+	MyVote     *MVPVoteResponse       `json:"my_vote,omitempty"`
+}
+
+type MVPCandidateResponse struct {
+	Player PlayerShort `json:"player"`
+	Votes  *uint64     `json:"votes,omitempty"`
+}
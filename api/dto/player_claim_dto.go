@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"time"
+)
+
+type CreatePlayerClaimRequest struct {
+	PlayerID uint64 `json:"player_id" binding:"required" example:"7"`
+	Message  string `json:"message" binding:"max=500" example:"I play as number 9 in the veterans team"`
+}
+
+type ReviewPlayerClaimRequest struct {
+	Comments string `json:"comments" binding:"max=2000" example:"Confirmed with the team coach"`
+}
+
+type PlayerClaimResponse struct {
+	ID             uint64       `json:"id"`
+	PlayerID       uint64       `json:"player_id"`
+	Player         *PlayerShort `json:"player,omitempty"`
+	User           *UserShort   `json:"user,omitempty"`
+	Status         string       `json:"status"`
+	Message        string       `json:"message,omitempty"`
+	ReviewComments string       `json:"review_comments,omitempty"`
+	ReviewedByID   *string      `json:"reviewed_by_id,omitempty"`
+	ReviewedAt     *time.Time   `json:"reviewed_at,omitempty"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
+// PlayerSeasonSplitResponse holds the statistics of a player over one season, or over
+// all seasons for the dashboard totals.
+type PlayerSeasonSplitResponse struct {
+	SeasonID      uint64  `json:"season_id,omitempty"`
+	SeasonYear    uint16  `json:"season_year,omitempty"`
+	Appearances   uint16  `json:"appearances"`
+	Starts        uint16  `json:"starts"`
+	MinutesPlayed uint32  `json:"minutes_played"`
+	Goals         uint16  `json:"goals"`
+	Assists       uint16  `json:"assists"`
+	Saves         uint16  `json:"saves"`
+	YellowCards   uint16  `json:"yellow_cards"`
+	RedCards      uint16  `json:"red_cards"`
+	MVPs          uint16  `json:"mvps"`
+	AverageRating float64 `json:"average_rating"`
+}
+
+type PlayerDashboardResponse struct {
+	Player  *PlayerResponse             `json:"player"`
+	Seasons []PlayerSeasonSplitResponse `json:"seasons"`
+	Totals  PlayerSeasonSplitResponse   `json:"totals"`
+}
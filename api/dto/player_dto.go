@@ -34,6 +34,11 @@ type UpdatePlayerRequest struct {
 	TeamIDs       []uint64 `json:"team_ids,omitempty"`
 }
 
+// UpdatePlayerAvailabilityRequest marks a squad player as available or injured.
+type UpdatePlayerAvailabilityRequest struct {
+	Injured *bool `json:"injured" binding:"required"`
+}
+
 type PlayerResponse struct {
 	ID            uint64               `json:"id"`
 	NickName      string               `json:"nick_name"`
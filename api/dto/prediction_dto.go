@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+)
+
+type CreatePredictionRequest struct {
+	HomeGoals *uint8 `json:"home_goals" binding:"required,lte=30" example:"2"`
+	AwayGoals *uint8 `json:"away_goals" binding:"required,lte=30" example:"1"`
+}
+
+type UpdatePredictionRequest struct {
+	HomeGoals *uint8 `json:"home_goals" binding:"required,lte=30" example:"1"`
+	AwayGoals *uint8 `json:"away_goals" binding:"required,lte=30" example:"1"`
+}
+
+type PredictionResponse struct {
+	ID        uint64          `json:"id"`
+	MatchID   uint64          `json:"match_id"`
+	Match     *MatchWithTeams `json:"match,omitempty"`
+	HomeGoals uint8           `json:"home_goals"`
+	AwayGoals uint8           `json:"away_goals"`
+	Points    *uint8          `json:"points,omitempty"`
+	Exact     bool            `json:"exact"`
+	ScoredAt  *time.Time      `json:"scored_at,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// PredictionLeaderboardEntry is a ranked row of the season prediction leaderboard
+type PredictionLeaderboardEntry struct {
+	Rank        uint64 `json:"rank"`
+	UserID      string `json:"user_id"`
+	DisplayName string `json:"display_name"`
+	ImgProfile  string `json:"img_profile,omitempty"`
+	Points      uint64 `json:"points"`
+	ExactScores uint64 `json:"exact_scores"`
+	Predictions uint64 `json:"predictions"`
+}
@@ -16,10 +16,23 @@ type RoleResponse struct {
 	ID          uint64    `json:"id"`
 	Name        string    `json:"name"`
 	Description string    `json:"description"`
+	Permissions []string  `json:"permissions"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
+// UpdateRolePermissionsRequest replaces the permissions granted to a role.
+type UpdateRolePermissionsRequest struct {
+	Permissions []string `json:"permissions" binding:"required,dive,max=50"`
+}
+
+type RolePermissionsResponse struct {
+	RoleID      uint64   `json:"role_id"`
+	RoleName    string   `json:"role_name"`
+	Permissions []string `json:"permissions"`
+	Editable    bool     `json:"editable"`
+}
+
 type RoleShort struct {
 	ID   uint64 `json:"id"`
 	Name string `json:"name"`
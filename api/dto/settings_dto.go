@@ -0,0 +1,18 @@
+package dto
+
+import (
+	"time"
+)
+
+// EmailDomainSettingsRequest replaces the allowed and blocked email domains of new accounts.
+type EmailDomainSettingsRequest struct {
+	Allowed []string `json:"allowed" binding:"max=500,dive,required,max=253" example:"gmail.com"`
+	Blocked []string `json:"blocked" binding:"max=500,dive,required,max=253" example:"mailinator.com"`
+}
+
+type EmailDomainSettingsResponse struct {
+	Allowed     []string   `json:"allowed"`
+	Blocked     []string   `json:"blocked"`
+	UpdatedByID *string    `json:"updated_by_id,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
@@ -11,6 +11,7 @@ type CreateTeamRequest struct {
 	SecondaryColor string  `json:"secondary_color" binding:"required,max=10"`
 	Shield         string  `json:"shield" binding:"required,url"`
 	NextMatchID    *uint64 `json:"next_match_id,omitempty"`
+	HomeVenueID    *uint64 `json:"home_venue_id,omitempty"`
 }
 
 type UpdateTeamRequest struct {
@@ -20,6 +21,7 @@ type UpdateTeamRequest struct {
 	SecondaryColor *string `json:"secondary_color,omitempty" binding:"omitempty,max=10"`
 	Shield         *string `json:"shield,omitempty" binding:"omitempty,url"`
 	NextMatchID    *uint64 `json:"next_match_id,omitempty"`
+	HomeVenueID    *uint64 `json:"home_venue_id,omitempty"`
 }
 
 type TeamResponse struct {
@@ -31,6 +33,7 @@ type TeamResponse struct {
 	Shield         string      `json:"shield"`
 	NextMatchID    *uint64     `json:"next_match_id,omitempty"`
 	NextMatch      *MatchShort `json:"next_match,omitempty"`
+	HomeVenue      *VenueShort `json:"home_venue,omitempty"`
 	CreatedAt      time.Time   `json:"created_at"`
 	UpdatedAt      time.Time   `json:"updated_at"`
 }
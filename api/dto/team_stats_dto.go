@@ -29,20 +29,22 @@ type UpdateTeamStatsRequest struct {
 }
 
 type TeamStatsResponse struct {
-	ID           uint64       `json:"id"`
-	Wins         uint16       `json:"wins"`
-	Draws        uint16       `json:"draws"`
-	Losses       uint16       `json:"losses"`
-	GoalsFor     uint16       `json:"goals_for"`
-	GoalsAgainst uint16       `json:"goals_against"`
-	Points       int16        `json:"points"`
-	Rank         uint16       `json:"rank"`
-	SeasonID     uint64       `json:"season_id"`
-	TeamID       uint64       `json:"team_id"`
-	Team         *TeamShort   `json:"team,omitempty"`
-	Season       *SeasonShort `json:"season,omitempty"`
-	CreatedAt    time.Time    `json:"created_at"`
-	UpdatedAt    time.Time    `json:"updated_at"`
+	ID             uint64       `json:"id"`
+	MatchesPlayed  int          `json:"matches_played"`
+	Wins           uint16       `json:"wins"`
+	Draws          uint16       `json:"draws"`
+	Losses         uint16       `json:"losses"`
+	GoalsFor       uint16       `json:"goals_for"`
+	GoalsAgainst   uint16       `json:"goals_against"`
+	GoalDifference int          `json:"goal_difference"`
+	Points         int16        `json:"points"`
+	Rank           uint16       `json:"rank"`
+	SeasonID       uint64       `json:"season_id"`
+	TeamID         uint64       `json:"team_id"`
+	Team           *TeamShort   `json:"team,omitempty"`
+	Season         *SeasonShort `json:"season,omitempty"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
 }
 
 type TeamStatsShort struct {
@@ -0,0 +1,12 @@
+package dto
+
+import "time"
+
+// TrashItemResponse is a deleted record that can still be restored until it is purged.
+type TrashItemResponse struct {
+	EntityType string    `json:"entity_type" example:"match"`
+	EntityID   uint64    `json:"entity_id" example:"42"`
+	Label      string    `json:"label" example:"BRW vs FCA 2026-05-10"`
+	DeletedAt  time.Time `json:"deleted_at"`
+	PurgeAt    time.Time `json:"purge_at"`
+}
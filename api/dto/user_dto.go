@@ -7,7 +7,7 @@ import (
 type CreateUserRequest struct {
 	Name     string `json:"name" binding:"required,min=2,max=35"`
 	LastName string `json:"last_name" binding:"required,min=2,max=35"`
-	Username string `json:"username" binding:"required,min=3,max=50,alphanum"`
+	Username string `json:"username" binding:"required,email,max=50"`
 	RoleID   uint64 `json:"role_id,omitempty" binding:"omitempty,gte=0,lte=255"`
 }
 
@@ -21,6 +21,62 @@ type UpdateUserRequest struct {
 	RoleID     *uint64    `json:"role_id,omitempty" binding:"omitempty,gte=0,lte=255"`
 }
 
+// UpdateProfileRequest holds the fields users can change on their own profile. Omitted fields
+// are left unchanged and an empty image removes it.
+type UpdateProfileRequest struct {
+	Name       *string    `json:"name,omitempty" binding:"omitempty,min=2,max=35"`
+	LastName   *string    `json:"last_name,omitempty" binding:"omitempty,min=2,max=35"`
+	Birthdate  *time.Time `json:"birthdate,omitempty" example:"1990-01-01T00:00:00Z"`
+	ImgProfile *string    `json:"img_profile,omitempty" binding:"omitempty,max=255,url|eq="`
+	ImgBanner  *string    `json:"img_banner,omitempty" binding:"omitempty,max=255,url|eq="`
+}
+
+// UserProfileChangeResponse is a recorded change of a profile field.
+type UserProfileChangeResponse struct {
+	ID        uint64    `json:"id"`
+	ChangedBy string    `json:"changed_by"`
+	Field     string    `json:"field"`
+	OldValue  string    `json:"old_value"`
+	NewValue  string    `json:"new_value"`
+	IPAddress string    `json:"ip_address,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AssignUserTeamRequest links a coach to the team they manage. A null team_id unlinks them.
+type AssignUserTeamRequest struct {
+	TeamID *uint64 `json:"team_id" binding:"omitempty,min=1"`
+}
+
+// RegisterRequest creates an account that signs in with its email and password.
+type RegisterRequest struct {
+	Name     string `json:"name" binding:"required,min=2,max=35"`
+	LastName string `json:"last_name" binding:"required,min=2,max=35"`
+	Username string `json:"username" binding:"required,email,max=100"`
+	Password string `json:"password" binding:"required,min=8,max=128"`
+}
+
+type LoginRequest struct {
+	Username string `json:"username" binding:"required,max=100"`
+	Password string `json:"password" binding:"required,max=128"`
+}
+
+// ResetPasswordRequest redeems a password reset token.
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required,min=8,max=128"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required,max=128"`
+	NewPassword     string `json:"new_password" binding:"required,min=8,max=128"`
+}
+
+// PasswordResetTokenResponse is returned once when a reset token is issued, since only its hash is stored.
+type PasswordResetTokenResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
 type UserResponse struct {
 	ID         string    `json:"id"`
 	Name       string    `json:"name"`
@@ -30,6 +86,7 @@ type UserResponse struct {
 	ImgProfile string    `json:"img_profile,omitempty"`
 	ImgBanner  string    `json:"img_banner,omitempty"`
 	Role       RoleShort `json:"role,omitempty"`
+	TeamID     *uint64   `json:"team_id,omitempty"`
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
 }
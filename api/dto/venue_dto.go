@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+)
+
+type CreateVenueRequest struct {
+	Name      string   `json:"name" binding:"required,max=100" example:"Estadio Central"`
+	Address   string   `json:"address" binding:"max=200" example:"Calle 10 #20-30, Bogotá"`
+	Capacity  uint32   `json:"capacity" example:"1500"`
+	Surface   string   `json:"surface" binding:"omitempty,oneof=natural_grass artificial_turf hybrid indoor" example:"artificial_turf"`
+	Latitude  *float64 `json:"latitude,omitempty" binding:"omitempty,gte=-90,lte=90" example:"4.6097"`
+	Longitude *float64 `json:"longitude,omitempty" binding:"omitempty,gte=-180,lte=180" example:"-74.0817"`
+}
+
+type UpdateVenueRequest struct {
+	Name      *string  `json:"name,omitempty" binding:"omitempty,max=100"`
+	Address   *string  `json:"address,omitempty" binding:"omitempty,max=200"`
+	Capacity  *uint32  `json:"capacity,omitempty"`
+	Surface   *string  `json:"surface,omitempty" binding:"omitempty,oneof=natural_grass artificial_turf hybrid indoor"`
+	Latitude  *float64 `json:"latitude,omitempty" binding:"omitempty,gte=-90,lte=90"`
+	Longitude *float64 `json:"longitude,omitempty" binding:"omitempty,gte=-180,lte=180"`
+}
+
+type VenueResponse struct {
+	ID        uint64    `json:"id"`
+	Name      string    `json:"name"`
+	Address   string    `json:"address"`
+	Capacity  uint32    `json:"capacity"`
+	Surface   string    `json:"surface"`
+	Latitude  *float64  `json:"latitude,omitempty"`
+	Longitude *float64  `json:"longitude,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// VenueShort is a simplified venue representation for use in other responses
+type VenueShort struct {
+	ID   uint64 `json:"id"`
+	Name string `json:"name"`
+}
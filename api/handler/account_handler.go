@@ -0,0 +1,238 @@
+package handler
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	httpMapper "github.com/EdwinRincon/browersfc-api/adapter/http"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/helper"
+	domainservice "github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/EdwinRincon/browersfc-api/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// AccountHandler handles the data-subject requests of the current user.
+type AccountHandler struct {
+	AccountDomainService *domainservice.AccountDomainService
+	AccountMapper        *httpMapper.AccountHTTPMapper
+}
+
+func NewAccountHandler(accountDomainService *domainservice.AccountDomainService) *AccountHandler {
+	return &AccountHandler{
+		AccountDomainService: accountDomainService,
+		AccountMapper:        httpMapper.NewAccountHTTPMapper(),
+	}
+}
+
+// ExportMyData godoc
+// @Summary Export the personal data of the current user
+// @Description Returns the profile, linked player, predictions, MVP votes, sessions, sign-in providers, API keys, profile history and audit entries of the current user.
+// @Description By default the data is a ZIP archive with one JSON file per section; format=json returns a single JSON document instead.
+// @Tags users
+// @ID exportMyData
+// @Produce application/zip
+// @Produce json
+// @Param format query string false "Bundle format" Enums(zip, json)
+// @Success 200 {object} dto.UserDataExportResponse "Personal data export"
+// @Failure 400 {object} helper.AppError "Invalid format"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 403 {object} helper.AppError "Not available with an API key"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/me/export [get]
+// @Security BearerAuth
+func (h *AccountHandler) ExportMyData(c *gin.Context) {
+	format := c.DefaultQuery("format", "zip")
+	if format != "zip" && format != "json" {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("format", "Format must be zip or json"))
+		return
+	}
+	if !h.requireUserSession(c) {
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	userID := c.GetString("user_id")
+	export, err := h.AccountDomainService.ExportUserData(ctx, userID)
+	if err != nil {
+		if errors.Is(err, constants.ErrRecordNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("user"))
+			return
+		}
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	logger.Info(c, "personal data exported", "user_id", userID, "format", format)
+
+	response := h.AccountMapper.ExportToDTO(export)
+	fileName := fmt.Sprintf("browersfc-data-%s", export.GeneratedAt.UTC().Format("20060102-150405"))
+	c.Header("Cache-Control", "no-store")
+	if format == "json" {
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.json"`, fileName))
+		helper.WriteSuccessResponse(c, http.StatusOK, response, "Personal data exported successfully")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.zip"`, fileName))
+	c.Header("Content-Type", "application/zip")
+	c.Status(http.StatusOK)
+	if err := writeDataExportZip(c.Writer, response); err != nil {
+		// Headers are already sent, so the client only sees a truncated archive
+		logger.Error(c, "failed to write personal data export", "user_id", userID, "error", err)
+	}
+}
+
+// RequestMyAccountDeletion godoc
+// @Summary Delete the account of the current user
+// @Description Schedules the account for erasure after a grace period, during which the request can be cancelled with DELETE /users/me/deletion.
+// @Description The account is then anonymized: personal data is replaced, sign-in methods, sessions, API keys and profile history are deleted and the linked player profile is unlinked. Predictions, votes and audit entries are kept without the personal data.
+// @Tags users
+// @ID requestMyAccountDeletion
+// @Produce json
+// @Success 202 {object} dto.AccountDeletionResponse "Account deletion scheduled"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 403 {object} helper.AppError "Not available with an API key"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/me [delete]
+// @Security BearerAuth
+func (h *AccountHandler) RequestMyAccountDeletion(c *gin.Context) {
+	if !h.requireUserSession(c) {
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	userID := c.GetString("user_id")
+	deletion, err := h.AccountDomainService.RequestDeletion(ctx, userID)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	logger.Info(c, "account deletion requested", "user_id", userID, "scheduled_at", deletion.ScheduledAt)
+	helper.WriteSuccessResponse(c, http.StatusAccepted, h.AccountMapper.DeletionToDTO(deletion), "Account deletion scheduled")
+}
+
+// GetMyAccountDeletion godoc
+// @Summary Get the pending deletion of the current user's account
+// @Tags users
+// @ID getMyAccountDeletion
+// @Produce json
+// @Success 200 {object} dto.AccountDeletionResponse "Account deletion retrieved successfully"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 404 {object} helper.AppError "No deletion pending"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/me/deletion [get]
+// @Security BearerAuth
+func (h *AccountHandler) GetMyAccountDeletion(c *gin.Context) {
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	deletion, err := h.AccountDomainService.GetDeletion(ctx, c.GetString("user_id"))
+	if err != nil {
+		if errors.Is(err, constants.ErrRecordNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("account deletion"))
+			return
+		}
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, h.AccountMapper.DeletionToDTO(deletion), "Account deletion retrieved successfully")
+}
+
+// CancelMyAccountDeletion godoc
+// @Summary Cancel the pending deletion of the current user's account
+// @Tags users
+// @ID cancelMyAccountDeletion
+// @Success 204 "No Content"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 403 {object} helper.AppError "Not available with an API key"
+// @Failure 404 {object} helper.AppError "No deletion pending"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/me/deletion [delete]
+// @Security BearerAuth
+func (h *AccountHandler) CancelMyAccountDeletion(c *gin.Context) {
+	if !h.requireUserSession(c) {
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	userID := c.GetString("user_id")
+	if err := h.AccountDomainService.CancelDeletion(ctx, userID); err != nil {
+		if errors.Is(err, constants.ErrRecordNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("account deletion"))
+			return
+		}
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	logger.Info(c, "account deletion cancelled", "user_id", userID)
+	c.Status(http.StatusNoContent)
+}
+
+// requireUserSession rejects requests authenticated with an API key, since a leaked key
+// must not be able to read all the user's data or erase the account.
+func (h *AccountHandler) requireUserSession(c *gin.Context) bool {
+	if c.GetString("api_key_id") != "" {
+		helper.WriteErrorResponse(c, helper.NewForbiddenError("This operation is not available with an API key"))
+		return false
+	}
+	return true
+}
+
+// writeDataExportZip writes the export as a ZIP archive with one JSON file per section.
+func writeDataExportZip(w io.Writer, export *dto.UserDataExportResponse) error {
+	files := []struct {
+		name string
+		data any
+	}{
+		{"profile.json", export.Profile},
+		{"player.json", export.Player},
+		{"predictions.json", export.Predictions},
+		{"mvp_votes.json", export.MVPVotes},
+		{"sessions.json", export.Sessions},
+		{"identities.json", export.Identities},
+		{"api_keys.json", export.APIKeys},
+		{"profile_changes.json", export.ProfileChanges},
+		{"audit_entries.json", export.AuditEntries},
+		{"deletion.json", export.Deletion},
+	}
+
+	archive := zip.NewWriter(w)
+	for _, file := range files {
+		entry, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     file.name,
+			Method:   zip.Deflate,
+			Modified: export.GeneratedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		encoder := json.NewEncoder(entry)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.data); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
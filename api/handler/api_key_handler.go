@@ -0,0 +1,249 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	httpMapper "github.com/EdwinRincon/browersfc-api/adapter/http"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/helper"
+	domainservice "github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/EdwinRincon/browersfc-api/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// APIKeyHandler handles personal API key requests.
+type APIKeyHandler struct {
+	APIKeyDomainService *domainservice.APIKeyDomainService
+	APIKeyMapper        *httpMapper.APIKeyHTTPMapper
+}
+
+func NewAPIKeyHandler(apiKeyDomainService *domainservice.APIKeyDomainService) *APIKeyHandler {
+	return &APIKeyHandler{
+		APIKeyDomainService: apiKeyDomainService,
+		APIKeyMapper:        httpMapper.NewAPIKeyHTTPMapper(),
+	}
+}
+
+// CreateMyAPIKey godoc
+// @Summary Create a personal API key
+// @Description Mints an API key for machine clients, sent in the X-API-Key header. Scopes must be permissions granted to the current user's role.
+// @Description The key is only returned in this response. Requests authenticated with an API key cannot create other keys.
+// @Tags api-keys
+// @ID createMyAPIKey
+// @Accept json
+// @Produce json
+// @Param apiKey body dto.CreateAPIKeyRequest true "API key name, scopes and optional expiry"
+// @Success 201 {object} dto.CreatedAPIKeyResponse "Created"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 403 {object} helper.AppError "Scope not granted"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/me/api-keys [post]
+// @Security BearerAuth
+func (h *APIKeyHandler) CreateMyAPIKey(c *gin.Context) {
+	h.createAPIKey(c, c.GetString("user_id"))
+}
+
+// GetMyAPIKeys godoc
+// @Summary List the current user's API keys
+// @Tags api-keys
+// @ID getMyAPIKeys
+// @Produce json
+// @Success 200 {array} dto.APIKeyResponse "API keys retrieved successfully"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/me/api-keys [get]
+// @Security BearerAuth
+func (h *APIKeyHandler) GetMyAPIKeys(c *gin.Context) {
+	h.getAPIKeys(c, c.GetString("user_id"))
+}
+
+// RevokeMyAPIKey godoc
+// @Summary Revoke one of the current user's API keys
+// @Tags api-keys
+// @ID revokeMyAPIKey
+// @Param id path string true "API key ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 400 {object} helper.AppError "Invalid UUID format"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 404 {object} helper.AppError "API key not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/me/api-keys/{id} [delete]
+// @Security BearerAuth
+func (h *APIKeyHandler) RevokeMyAPIKey(c *gin.Context) {
+	h.revokeAPIKey(c, c.GetString("user_id"), c.Param("id"))
+}
+
+// CreateUserAPIKey godoc
+// @Summary Create an API key for a user
+// @Description Mints an API key on behalf of a user, such as the account used by the scoreboard display. Scopes must be granted to that user's role.
+// @Tags api-keys
+// @ID createUserAPIKey
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID (UUID)"
+// @Param apiKey body dto.CreateAPIKeyRequest true "API key name, scopes and optional expiry"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
+// @Success 201 {object} dto.CreatedAPIKeyResponse "Created"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 403 {object} helper.AppError "Scope not granted"
+// @Failure 404 {object} helper.AppError "User not found"
+// @Failure 409 {object} helper.AppError "Request with the same Idempotency-Key still in progress"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/users/{id}/api-keys [post]
+// @Security BearerAuth
+func (h *APIKeyHandler) CreateUserAPIKey(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", "Invalid UUID format"))
+		return
+	}
+
+	h.createAPIKey(c, userID.String())
+}
+
+// GetUserAPIKeys godoc
+// @Summary List the API keys of a user
+// @Tags api-keys
+// @ID getUserAPIKeys
+// @Produce json
+// @Param id path string true "User ID (UUID)"
+// @Success 200 {array} dto.APIKeyResponse "API keys retrieved successfully"
+// @Failure 400 {object} helper.AppError "Invalid UUID format"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 403 {object} helper.AppError "Forbidden"
+// @Failure 404 {object} helper.AppError "User not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/users/{id}/api-keys [get]
+// @Security BearerAuth
+func (h *APIKeyHandler) GetUserAPIKeys(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", "Invalid UUID format"))
+		return
+	}
+
+	h.getAPIKeys(c, userID.String())
+}
+
+// RevokeUserAPIKey godoc
+// @Summary Revoke an API key of a user
+// @Tags api-keys
+// @ID revokeUserAPIKey
+// @Param id path string true "User ID (UUID)"
+// @Param keyId path string true "API key ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 400 {object} helper.AppError "Invalid UUID format"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 403 {object} helper.AppError "Forbidden"
+// @Failure 404 {object} helper.AppError "API key not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/users/{id}/api-keys/{keyId} [delete]
+// @Security BearerAuth
+func (h *APIKeyHandler) RevokeUserAPIKey(c *gin.Context) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", "Invalid UUID format"))
+		return
+	}
+
+	h.revokeAPIKey(c, userID.String(), c.Param("keyId"))
+}
+
+// createAPIKey mints a key for the user from the request body.
+func (h *APIKeyHandler) createAPIKey(c *gin.Context, userID string) {
+	// A leaked key must not be able to mint keys that outlive its own revocation
+	if c.GetString("api_key_id") != "" {
+		helper.WriteErrorResponse(c, helper.NewForbiddenError("API keys cannot be created with an API key"))
+		return
+	}
+
+	var createRequest dto.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&createRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidAPIKeyData))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	apiKey := h.APIKeyMapper.DTOToDomain(&createRequest, userID)
+
+	createdAPIKey, rawKey, err := h.APIKeyDomainService.CreateAPIKey(ctx, apiKey)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrInvalidPermission):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("scopes", "Unknown permission"))
+		case errors.Is(err, constants.ErrScopeNotGranted):
+			helper.WriteErrorResponse(c, helper.NewForbiddenError(err.Error()))
+		case errors.Is(err, constants.ErrRecordNotFound):
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("user"))
+		case errors.Is(err, constants.ErrInvalidData):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("body", constants.MsgInvalidAPIKeyData))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	logger.Info(c, "API key created",
+		"api_key_id", createdAPIKey.ID,
+		"user_id", createdAPIKey.UserID,
+		"created_by", c.GetString("user_id"))
+
+	middleware.MarkSecretResponse(c)
+	helper.WriteSuccessResponse(c, http.StatusCreated, h.APIKeyMapper.ToCreatedDTO(createdAPIKey, rawKey), "API key created successfully")
+}
+
+// getAPIKeys lists the keys of the user.
+func (h *APIKeyHandler) getAPIKeys(c *gin.Context, userID string) {
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	apiKeys, err := h.APIKeyDomainService.GetUserAPIKeys(ctx, userID)
+	if err != nil {
+		if errors.Is(err, constants.ErrRecordNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("user"))
+			return
+		}
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, h.APIKeyMapper.ToDTOList(apiKeys), "API keys retrieved successfully")
+}
+
+// revokeAPIKey revokes a key of the user.
+func (h *APIKeyHandler) revokeAPIKey(c *gin.Context, userID, rawAPIKeyID string) {
+	apiKeyID, err := uuid.Parse(rawAPIKeyID)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", "Invalid UUID format"))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	if err := h.APIKeyDomainService.RevokeAPIKey(ctx, userID, apiKeyID.String()); err != nil {
+		if errors.Is(err, constants.ErrAPIKeyNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("API key"))
+			return
+		}
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	logger.Info(c, "API key revoked", "api_key_id", apiKeyID.String(), "revoked_by", c.GetString("user_id"))
+	c.Status(http.StatusNoContent)
+}
@@ -35,9 +35,12 @@ func NewArticleHandler(articleDomainService *domainservice.ArticleDomainService)
 // @Accept       json
 // @Produce      json
 // @Param        article  body      dto.CreateArticleRequest  true  "Article data"
+// @Param        Idempotency-Key header string false "Key that makes retries of the request safe"
 // @Success      201   {object}  dto.ArticleShort  "Article created successfully"
 // @Failure      400   {object}  helper.AppError "Invalid input"
 // @Failure      404   {object}  helper.AppError "Season not found"
+// @Failure      409   {object}  helper.AppError "Request with the same Idempotency-Key still in progress"
+// @Failure      422   {object}  helper.AppError "Idempotency-Key already used for a different request"
 // @Failure      500   {object}  helper.AppError "Internal server error"
 // @Router       /admin/articles [post]
 // @Security     BearerAuth
@@ -77,7 +80,10 @@ func (h *ArticleHandler) CreateArticle(c *gin.Context) {
 // @Tags         articles
 // @ID           getArticleByID
 // @Param        id  path      int  true  "Article ID"
+// @Param        If-None-Match header string false "ETag from a previous response"
 // @Success      200 {object}  dto.ArticleResponse "Article retrieved successfully"
+// @Success      304 "Not Modified"
+// @Header       200 {string} ETag "Version of the article"
 // @Failure      400 {object}  helper.AppError "Invalid ID format"
 // @Failure      404 {object}  helper.AppError "Article not found"
 // @Failure      500 {object}  helper.AppError "Internal server error"
@@ -104,6 +110,10 @@ func (h *ArticleHandler) GetArticleByID(c *gin.Context) {
 		return
 	}
 
+	if helper.WriteNotModified(c, article.Version) {
+		return
+	}
+
 	articleResponse := h.ArticleMapper.DomainToDTO(article)
 	helper.WriteSuccessResponse(c, http.StatusOK, articleResponse, "Article retrieved successfully")
 }
@@ -230,9 +240,13 @@ func (h *ArticleHandler) GetArticlesBySeasonID(c *gin.Context) {
 // @Produce      json
 // @Param        id       path      int                      true  "Article ID"
 // @Param        article  body      dto.UpdateArticleRequest true  "Updated article data"
+// @Param        If-Match header string true "ETag of the article being changed"
 // @Success      200      {object}  dto.ArticleShort  "Article updated successfully"
+// @Header       200 {string} ETag "New version of the article"
 // @Failure      400      {object}  helper.AppError "Invalid input or ID format"
 // @Failure      404      {object}  helper.AppError "Article or Season not found"
+// @Failure      412      {object}  helper.AppError "The article was modified since it was read"
+// @Failure      428      {object}  helper.AppError "If-Match header missing"
 // @Failure      500      {object}  helper.AppError "Internal server error"
 // @Router       /admin/articles/{id} [put]
 // @Security     BearerAuth
@@ -270,6 +284,10 @@ func (h *ArticleHandler) UpdateArticle(c *gin.Context) {
 
 	finalArticle, err := h.ArticleDomainService.UpdateArticle(ctx, id, updatedArticle)
 	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("article"))
+			return
+		}
 		switch {
 		case errors.Is(err, constants.ErrRecordNotFound):
 			helper.WriteErrorResponse(c, helper.NewNotFoundError("article"))
@@ -283,6 +301,7 @@ func (h *ArticleHandler) UpdateArticle(c *gin.Context) {
 		}
 	}
 
+	helper.SetETag(c, finalArticle.Version)
 	response := h.ArticleMapper.DomainToShortDTO(finalArticle)
 	helper.WriteSuccessResponse(c, http.StatusOK, response, "Article updated successfully")
 }
@@ -293,8 +312,11 @@ func (h *ArticleHandler) UpdateArticle(c *gin.Context) {
 // @Tags         articles
 // @ID           deleteArticle
 // @Param        id   path      int  true  "Article ID"
+// @Param        If-Match header string true "ETag of the article being changed"
 // @Success      204 "No Content"
 // @Failure      400  {object}  helper.AppError "Invalid ID format"
+// @Failure      412  {object}  helper.AppError "The article was modified since it was read"
+// @Failure      428  {object}  helper.AppError "If-Match header missing"
 // @Router       /admin/articles/{id} [delete]
 // @Security     BearerAuth
 func (h *ArticleHandler) DeleteArticle(c *gin.Context) {
@@ -310,6 +332,10 @@ func (h *ArticleHandler) DeleteArticle(c *gin.Context) {
 	defer cancel()
 
 	err = h.ArticleDomainService.DeleteArticle(ctx, id)
+	if errors.Is(err, constants.ErrVersionMismatch) {
+		helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("article"))
+		return
+	}
 	if err != nil && !errors.Is(err, constants.ErrRecordNotFound) {
 		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
 		return
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	httpMapper "github.com/EdwinRincon/browersfc-api/adapter/http"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/helper"
+	domainservice "github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+type AuditHandler struct {
+	AuditDomainService *domainservice.AuditDomainService
+	AuditMapper        *httpMapper.AuditHTTPMapper
+}
+
+func NewAuditHandler(auditDomainService *domainservice.AuditDomainService) *AuditHandler {
+	return &AuditHandler{
+		AuditDomainService: auditDomainService,
+		AuditMapper:        httpMapper.NewAuditHTTPMapper(),
+	}
+}
+
+// GetPaginatedAuditEntries godoc
+// @Summary Get the audit log
+// @Description Lists the changes made through the admin API newest first, with who made them, the request ID, the IP address and the changed fields.
+// @Description Filter by entity type and ID to see the history of a single record.
+// @Tags audit
+// @ID getPaginatedAuditEntries
+// @Produce json
+// @Param entity query string false "Entity type" Enums(api_key, article, email_domains, invitation, lineup, match, match_report, player, player_claim, player_stats, player_team, role, season, team, team_stats, user, venue)
+// @Param id query string false "Entity ID, requires entity"
+// @Param actor query string false "ID of the user who made the changes"
+// @Param action query string false "Action" Enums(create, update, delete, restore)
+// @Param page query int false "Page number" default(0)
+// @Param pageSize query int false "Page size" default(10)
+// @Success 200 {object} helper.AppSuccess{data=helper.PaginatedResponse{items=[]dto.AuditEntryResponse, totalCount=int}}
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 403 {object} helper.AppError "Forbidden"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/audit [get]
+// @Security BearerAuth
+func (h *AuditHandler) GetPaginatedAuditEntries(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "0"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+
+	if page < 0 {
+		page = 0
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	filter := domain.AuditFilter{
+		EntityType: c.Query("entity"),
+		EntityID:   c.Query("id"),
+		ActorID:    c.Query("actor"),
+		Action:     c.Query("action"),
+	}
+	entries, total, err := h.AuditDomainService.GetPaginatedAuditEntries(ctx, filter, page, pageSize)
+	if err != nil {
+		if errors.Is(err, constants.ErrInvalidData) {
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("entity", "Unknown entity type or action, or id given without entity"))
+		} else {
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	response := helper.PaginatedResponse{
+		Items:      h.AuditMapper.DomainListToDTO(entries),
+		TotalCount: total,
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, response, "Audit entries retrieved successfully")
+}
@@ -0,0 +1,210 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	httpMapper "github.com/EdwinRincon/browersfc-api/adapter/http"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/helper"
+	domainservice "github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/EdwinRincon/browersfc-api/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type InvitationHandler struct {
+	InvitationDomainService *domainservice.InvitationDomainService
+	InvitationMapper        *httpMapper.InvitationHTTPMapper
+}
+
+func NewInvitationHandler(invitationDomainService *domainservice.InvitationDomainService) *InvitationHandler {
+	return &InvitationHandler{
+		InvitationDomainService: invitationDomainService,
+		InvitationMapper:        httpMapper.NewInvitationHTTPMapper(),
+	}
+}
+
+// CreateInvitation godoc
+// @Summary Invite a user by email
+// @Description Emails a single-use link to create an account with the given role and, for coaches, team. The pending invitations for the same email are revoked.
+// @Tags invitations
+// @ID createInvitation
+// @Accept json
+// @Produce json
+// @Param invitation body dto.CreateInvitationRequest true "Invitation data"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
+// @Success 201 {object} dto.InvitationResponse "Created"
+// @Failure 400 {object} helper.AppError "Invalid input, role or team not found"
+// @Failure 403 {object} helper.AppError "Email domain not allowed"
+// @Failure 409 {object} helper.AppError "The email already has an account"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
+// @Failure 500 {object} helper.AppError "Internal server error or email not sent"
+// @Router /admin/invitations [post]
+// @Security BearerAuth
+func (h *InvitationHandler) CreateInvitation(c *gin.Context) {
+	var createRequest dto.CreateInvitationRequest
+	if err := c.ShouldBindJSON(&createRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidInvitationData))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	invitation := h.InvitationMapper.DTOToDomain(&createRequest, c.GetString("user_id"))
+
+	createdInvitation, err := h.InvitationDomainService.CreateInvitation(ctx, invitation)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrEmailDomainNotAllowed):
+			helper.WriteErrorResponse(c, helper.NewForbiddenError("Email domain not allowed"))
+		case errors.Is(err, constants.ErrRecordAlreadyExists):
+			helper.WriteErrorResponse(c, helper.NewConflictError("email", "A user with this email already exists"))
+		case errors.Is(err, constants.ErrRecordNotFound):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("role_id", "Role not found"))
+		case errors.Is(err, constants.ErrTeamNotFound):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("team_id", "Team not found"))
+		case errors.Is(err, constants.ErrInvalidData):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("body", constants.MsgInvalidInvitationData))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	logger.Info(c, "invitation sent", "invitation_id", createdInvitation.ID, "role_id", createdInvitation.RoleID, "invited_by", createdInvitation.InvitedByID)
+	helper.WriteSuccessResponse(c, http.StatusCreated, h.InvitationMapper.DomainToDTO(createdInvitation), "Invitation sent successfully")
+}
+
+// GetPaginatedInvitations godoc
+// @Summary Get invitations
+// @Description Lists the invitations newest first.
+// @Tags invitations
+// @ID getPaginatedInvitations
+// @Produce json
+// @Param status query string false "Invitation status" Enums(pending, accepted, expired, revoked)
+// @Param email query string false "Invited email"
+// @Param page query int false "Page number" default(0)
+// @Param pageSize query int false "Page size" default(10)
+// @Success 200 {object} helper.AppSuccess{data=helper.PaginatedResponse{items=[]dto.InvitationResponse, totalCount=int}}
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/invitations [get]
+// @Security BearerAuth
+func (h *InvitationHandler) GetPaginatedInvitations(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "0"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+
+	if page < 0 {
+		page = 0
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 10
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	filter := domain.InvitationFilter{
+		Status: c.Query("status"),
+		Email:  c.Query("email"),
+	}
+	invitations, total, err := h.InvitationDomainService.GetPaginatedInvitations(ctx, filter, page, pageSize)
+	if err != nil {
+		if errors.Is(err, constants.ErrInvalidData) {
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("status", "Status must be one of pending, accepted, expired or revoked"))
+		} else {
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	response := helper.PaginatedResponse{
+		Items:      h.InvitationMapper.DomainListToDTO(invitations),
+		TotalCount: total,
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, response, "Invitations retrieved successfully")
+}
+
+// GetInvitationByID godoc
+// @Summary Get an invitation by ID
+// @Tags invitations
+// @ID getInvitationByID
+// @Produce json
+// @Param id path int true "Invitation ID"
+// @Success 200 {object} dto.InvitationResponse "Success"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 404 {object} helper.AppError "Invitation not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/invitations/{id} [get]
+// @Security BearerAuth
+func (h *InvitationHandler) GetInvitationByID(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidInvitationID))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	invitation, err := h.InvitationDomainService.GetInvitationByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, constants.ErrInvitationNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("invitation"))
+			return
+		}
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, h.InvitationMapper.DomainToDTO(invitation), "Invitation found successfully")
+}
+
+// RevokeInvitation godoc
+// @Summary Revoke a pending invitation
+// @Tags invitations
+// @ID revokeInvitation
+// @Param id path int true "Invitation ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 404 {object} helper.AppError "Invitation not found"
+// @Failure 409 {object} helper.AppError "Invitation already accepted or revoked"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/invitations/{id} [delete]
+// @Security BearerAuth
+func (h *InvitationHandler) RevokeInvitation(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidInvitationID))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	if err := h.InvitationDomainService.RevokeInvitation(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, constants.ErrInvitationNotFound):
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("invitation"))
+		case errors.Is(err, constants.ErrInvitationNotUsable):
+			helper.WriteErrorResponse(c, helper.NewConflictError("invitation", "The invitation was already accepted or revoked"))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	logger.Info(c, "invitation revoked", "invitation_id", id)
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/EdwinRincon/browersfc-api/pkg/jwt"
+	"github.com/gin-gonic/gin"
+)
+
+// JWKSHandler publishes the public keys that verify the access tokens issued by the API.
+type JWKSHandler struct {
+	jwtService *jwt.JWTService
+}
+
+// NewJWKSHandler creates a new JWKSHandler.
+func NewJWKSHandler(jwtService *jwt.JWTService) *JWKSHandler {
+	return &JWKSHandler{jwtService: jwtService}
+}
+
+// GetJWKS returns the JSON Web Key Set (RFC 7517) so other services can verify tokens
+// without sharing a secret. Each token names its key in the kid header. The response is
+// the standard key set document rather than the API envelope, as verifiers expect.
+func (h *JWKSHandler) GetJWKS(c *gin.Context) {
+	// Verifiers may cache the keys briefly; rotated keys stay in the set for longer than this
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.jwtService.JWKS())
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -13,28 +14,35 @@ import (
 )
 
 type LineupHandler struct {
-	LineupDomainService *domainservice.LineupDomainService
-	LineupMapper        *httpMapper.LineupHTTPMapper
+	LineupDomainService     *domainservice.LineupDomainService
+	TeamAccessDomainService *domainservice.TeamAccessDomainService
+	LineupMapper            *httpMapper.LineupHTTPMapper
 }
 
-func NewLineupHandler(lineupDomainService *domainservice.LineupDomainService) *LineupHandler {
+func NewLineupHandler(lineupDomainService *domainservice.LineupDomainService, teamAccessDomainService *domainservice.TeamAccessDomainService) *LineupHandler {
 	return &LineupHandler{
-		LineupDomainService: lineupDomainService,
-		LineupMapper:        httpMapper.NewLineupHTTPMapper(),
+		LineupDomainService:     lineupDomainService,
+		TeamAccessDomainService: teamAccessDomainService,
+		LineupMapper:            httpMapper.NewLineupHTTPMapper(),
 	}
 }
 
 // @Summary Create a new lineup
-// @Description Add a new lineup for a match
+// @Description Add a new lineup for a match. Coaches can only add players of their own team to its matches.
 // @Tags lineups
 // @Accept json
 // @Produce json
 // @Param lineup body dto.CreateLineupRequest true "Lineup data"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
 // @Success 201 {object} dto.LineupResponse
 // @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 403 {object} helper.AppError "Not allowed to manage this team"
+// @Failure 409 {object} helper.AppError "Request with the same Idempotency-Key still in progress"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
 // @Failure 500 {object} helper.AppError "Internal server error"
 // @Security BearerAuth
 // @Router /lineups [post]
+// @Router /admin/lineups [post]
 func (h *LineupHandler) CreateLineup(c *gin.Context) {
 	var createRequest dto.CreateLineupRequest
 	if err := c.ShouldBindJSON(&createRequest); err != nil {
@@ -44,6 +52,11 @@ func (h *LineupHandler) CreateLineup(c *gin.Context) {
 
 	lineupEntity := h.LineupMapper.CreateRequestToDomain(createRequest)
 
+	if err := h.TeamAccessDomainService.AuthorizeLineup(c.Request.Context(), c.GetString("user_id"), grantedPermissions(c), lineupEntity); err != nil {
+		writeTeamAccessError(c, err, "lineup")
+		return
+	}
+
 	if err := h.LineupDomainService.CreateLineup(c.Request.Context(), lineupEntity); err != nil {
 		if err == constants.ErrInvalidData {
 			helper.WriteErrorResponse(c, helper.NewBadRequestError("data", "Invalid lineup data"))
@@ -71,7 +84,10 @@ func (h *LineupHandler) CreateLineup(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path int true "Lineup ID"
+// @Param If-None-Match header string false "ETag from a previous response"
 // @Success 200 {object} dto.LineupResponse
+// @Success 304 "Not Modified"
+// @Header 200 {string} ETag "Version of the lineup"
 // @Failure 400 {object} helper.AppError "Invalid lineup ID"
 // @Failure 404 {object} helper.AppError "Lineup not found"
 // @Failure 500 {object} helper.AppError "Internal server error"
@@ -95,23 +111,33 @@ func (h *LineupHandler) GetLineupByID(c *gin.Context) {
 		return
 	}
 
+	if helper.WriteNotModified(c, lineup.Version) {
+		return
+	}
+
 	response := h.LineupMapper.DomainToResponse(lineup)
 	helper.WriteSuccessResponse(c, http.StatusOK, response, "Lineup retrieved successfully")
 }
 
 // @Summary Update lineup
-// @Description Update an existing lineup
+// @Description Update an existing lineup. Coaches can only update lineups of their own team.
 // @Tags lineups
 // @Accept json
 // @Produce json
 // @Param id path int true "Lineup ID"
 // @Param lineup body dto.UpdateLineupRequest true "Lineup data"
+// @Param If-Match header string true "ETag of the lineup being changed"
 // @Success 200 {object} dto.LineupResponse
+// @Header 200 {string} ETag "New version of the lineup"
 // @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 403 {object} helper.AppError "Not allowed to manage this team"
 // @Failure 404 {object} helper.AppError "Lineup not found"
+// @Failure 412 {object} helper.AppError "The lineup was modified since it was read"
+// @Failure 428 {object} helper.AppError "If-Match header missing"
 // @Failure 500 {object} helper.AppError "Internal server error"
 // @Security BearerAuth
 // @Router /lineups/{id} [put]
+// @Router /admin/lineups/{id} [put]
 func (h *LineupHandler) UpdateLineup(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 64)
@@ -137,9 +163,25 @@ func (h *LineupHandler) UpdateLineup(c *gin.Context) {
 		return
 	}
 
+	// Both the current entry and the updated one must belong to a team the user manages
+	userID, permissions := c.GetString("user_id"), grantedPermissions(c)
+	if err := h.TeamAccessDomainService.AuthorizeLineup(c.Request.Context(), userID, permissions, existingLineup); err != nil {
+		writeTeamAccessError(c, err, "lineup")
+		return
+	}
+
 	lineupEntity := h.LineupMapper.UpdateRequestToDomain(updateRequest, existingLineup)
 
+	if err := h.TeamAccessDomainService.AuthorizeLineup(c.Request.Context(), userID, permissions, lineupEntity); err != nil {
+		writeTeamAccessError(c, err, "lineup")
+		return
+	}
+
 	if err := h.LineupDomainService.UpdateLineup(c.Request.Context(), id, lineupEntity); err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("lineup"))
+			return
+		}
 		if err == constants.ErrLineupNotFound {
 			helper.WriteErrorResponse(c, helper.NewNotFoundError("lineup"))
 			return
@@ -159,22 +201,28 @@ func (h *LineupHandler) UpdateLineup(c *gin.Context) {
 		return
 	}
 
+	helper.SetETag(c, updatedLineup.Version)
 	response := h.LineupMapper.DomainToResponse(updatedLineup)
 	helper.WriteSuccessResponse(c, http.StatusOK, response, "Lineup updated successfully")
 }
 
 // @Summary Delete lineup
-// @Description Delete a lineup by ID
+// @Description Delete a lineup by ID. Coaches can only delete lineups of their own team.
 // @Tags lineups
 // @Accept json
 // @Produce json
 // @Param id path int true "Lineup ID"
+// @Param If-Match header string true "ETag of the lineup being changed"
 // @Success 200 {object} helper.AppSuccess
 // @Failure 400 {object} helper.AppError "Invalid lineup ID"
+// @Failure 403 {object} helper.AppError "Not allowed to manage this team"
 // @Failure 404 {object} helper.AppError "Lineup not found"
+// @Failure 412 {object} helper.AppError "The lineup was modified since it was read"
+// @Failure 428 {object} helper.AppError "If-Match header missing"
 // @Failure 500 {object} helper.AppError "Internal server error"
 // @Security BearerAuth
 // @Router /lineups/{id} [delete]
+// @Router /admin/lineups/{id} [delete]
 func (h *LineupHandler) DeleteLineup(c *gin.Context) {
 	idStr := c.Param("id")
 	id, err := strconv.ParseUint(idStr, 10, 64)
@@ -183,7 +231,16 @@ func (h *LineupHandler) DeleteLineup(c *gin.Context) {
 		return
 	}
 
+	if err := h.TeamAccessDomainService.AuthorizeLineupByID(c.Request.Context(), c.GetString("user_id"), grantedPermissions(c), id); err != nil {
+		writeTeamAccessError(c, err, "lineup")
+		return
+	}
+
 	if err := h.LineupDomainService.DeleteLineup(c.Request.Context(), id); err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("lineup"))
+			return
+		}
 		if err == constants.ErrLineupNotFound {
 			helper.WriteErrorResponse(c, helper.NewNotFoundError("lineup"))
 			return
@@ -368,3 +425,18 @@ func (h *LineupHandler) GetLineupsByPlayerID(c *gin.Context) {
 	response := h.LineupMapper.DomainListToResponse(lineups)
 	helper.WriteSuccessResponse(c, http.StatusOK, response, "Lineups retrieved successfully")
 }
+
+// writeTeamAccessError writes the response for a failed team access check.
+// The resource name is used when the checked entity itself does not exist.
+func writeTeamAccessError(c *gin.Context, err error, resource string) {
+	switch {
+	case errors.Is(err, constants.ErrForbidden):
+		helper.WriteErrorResponse(c, helper.NewForbiddenError("You can only manage resources of your own team"))
+	case errors.Is(err, constants.ErrMatchNotFound):
+		helper.WriteErrorResponse(c, helper.NewNotFoundError("match"))
+	case errors.Is(err, constants.ErrLineupNotFound), errors.Is(err, constants.ErrRecordNotFound):
+		helper.WriteErrorResponse(c, helper.NewNotFoundError(resource))
+	default:
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+	}
+}
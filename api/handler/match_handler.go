@@ -35,9 +35,11 @@ func NewMatchHandler(matchDomainService *service.MatchDomainService) *MatchHandl
 // @Accept       json
 // @Produce      json
 // @Param        match  body      dto.CreateMatchRequest  true  "Match data"
+// @Param        Idempotency-Key header string false "Key that makes retries of the request safe"
 // @Success      201    {object}  dto.MatchResponse "Created match"
 // @Failure      400    {object}  helper.AppError "Invalid input"
 // @Failure      409    {object}  helper.AppError "Conflict"
+// @Failure      422    {object}  helper.AppError "Idempotency-Key already used for a different request"
 // @Failure      500    {object}  helper.AppError "Internal server error"
 // @Router       /admin/matches [post]
 // @Security     BearerAuth
@@ -57,7 +59,11 @@ func (h *MatchHandler) CreateMatch(c *gin.Context) {
 
 	createdMatch, err := h.MatchDomainService.CreateMatch(ctx, domainMatch)
 	if err != nil {
-		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		if errors.Is(err, constants.ErrVenueNotFound) {
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("venue_id", "Venue does not exist"))
+		} else {
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
 		return
 	}
 
@@ -71,7 +77,10 @@ func (h *MatchHandler) CreateMatch(c *gin.Context) {
 // @Tags         matches
 // @ID           getMatchByID
 // @Param        id   path      int  true  "Match ID"
+// @Param        If-None-Match header string false "ETag from a previous response"
 // @Success      200  {object}  dto.MatchResponse "Match found"
+// @Success      304 "Not Modified"
+// @Header       200 {string} ETag "Version of the match"
 // @Failure      400  {object}  helper.AppError "Invalid input"
 // @Failure      404  {object}  helper.AppError "Match not found"
 // @Failure      500  {object}  helper.AppError "Internal server error"
@@ -99,6 +108,10 @@ func (h *MatchHandler) GetMatchByID(c *gin.Context) {
 		return
 	}
 
+	if helper.WriteNotModified(c, match.Version) {
+		return
+	}
+
 	matchResponse := h.MatchMapper.DomainToDTO(match)
 	helper.WriteSuccessResponse(c, http.StatusOK, matchResponse, "Match found successfully")
 }
@@ -301,6 +314,66 @@ func (h *MatchHandler) GetMatchesByTeamID(c *gin.Context) {
 	helper.WriteSuccessResponse(c, http.StatusOK, response, "Team matches retrieved successfully")
 }
 
+// GetMatchesByVenueID godoc
+// @Summary      Get matches by venue
+// @Description  Retrieves matches played at a specific venue with pagination
+// @Tags         matches
+// @ID           getMatchesByVenueID
+// @Param        id        path      int     true   "Venue ID"
+// @Param        page      query     int     false  "Page number (0-based)"
+// @Param        pageSize  query     int     false  "Page size (default 10)"
+// @Param        sort      query     string  false  "Sort field"
+// @Param        order     query     string  false  "Sort order (asc/desc)"
+// @Success      200       {object}  helper.AppSuccess{data=helper.PaginatedResponse{items=[]dto.MatchResponse, totalCount=int}}
+// @Failure      400       {object}  helper.AppError "Invalid input"
+// @Failure      404       {object}  helper.AppError "Venue not found"
+// @Failure      500       {object}  helper.AppError "Internal server error"
+// @Router       /venues/{id}/matches [get]
+func (h *MatchHandler) GetMatchesByVenueID(c *gin.Context) {
+	venueIDStr := c.Param("id")
+	venueID, err := strconv.ParseUint(venueIDStr, 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidVenueID))
+		return
+	}
+
+	sort := c.DefaultQuery("sort", "kickoff")
+	order := c.DefaultQuery("order", "desc")
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "0"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+
+	if page < 0 {
+		page = 0
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 10
+	}
+	if order != "asc" && order != "desc" {
+		order = "desc"
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	matches, total, err := h.MatchDomainService.GetMatchesByVenueID(ctx, venueID, sort, order, page, pageSize)
+	if err != nil {
+		if errors.Is(err, constants.ErrVenueNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("venue"))
+		} else {
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	response := helper.PaginatedResponse{
+		Items:      h.MatchMapper.DomainListToDTO(matches),
+		TotalCount: total,
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, response, "Venue matches retrieved successfully")
+}
+
 // GetNextMatchByTeamID godoc
 // @Summary      Get next match for a team
 // @Description  Returns the next scheduled match for a specific team
@@ -347,9 +420,13 @@ func (h *MatchHandler) GetNextMatchByTeamID(c *gin.Context) {
 // @Produce      json
 // @Param        id     path      int                 true  "Match ID"
 // @Param        match  body      dto.UpdateMatchRequest true  "Updated match data"
+// @Param        If-Match header string true "ETag of the match being changed"
 // @Success      200    {object}  dto.MatchResponse "Match updated"
+// @Header       200 {string} ETag "New version of the match"
 // @Failure      400    {object}  helper.AppError "Invalid input"
 // @Failure      404    {object}  helper.AppError "Match not found"
+// @Failure      412    {object}  helper.AppError "The match was modified since it was read"
+// @Failure      428    {object}  helper.AppError "If-Match header missing"
 // @Failure      500    {object}  helper.AppError "Internal server error"
 // @Router       /admin/matches/{id} [put]
 // @Security     BearerAuth
@@ -376,14 +453,21 @@ func (h *MatchHandler) UpdateMatch(c *gin.Context) {
 
 	updatedMatch, err := h.MatchDomainService.UpdateMatch(ctx, id, domainMatch)
 	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("match"))
+			return
+		}
 		if errors.Is(err, constants.ErrRecordNotFound) {
 			helper.WriteErrorResponse(c, helper.NewNotFoundError("match"))
+		} else if errors.Is(err, constants.ErrVenueNotFound) {
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("venue_id", "Venue does not exist"))
 		} else {
 			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
 		}
 		return
 	}
 
+	helper.SetETag(c, updatedMatch.Version)
 	matchResponse := h.MatchMapper.DomainToDTO(updatedMatch)
 	helper.WriteSuccessResponse(c, http.StatusOK, matchResponse, "Match updated successfully")
 }
@@ -394,8 +478,11 @@ func (h *MatchHandler) UpdateMatch(c *gin.Context) {
 // @Tags         matches
 // @ID           deleteMatch
 // @Param        id   path      int  true  "Match ID"
+// @Param        If-Match header string true "ETag of the match being changed"
 // @Success      204 "No Content"
 // @Failure      400  {object}  helper.AppError "Invalid input"
+// @Failure      412  {object}  helper.AppError "The match was modified since it was read"
+// @Failure      428  {object}  helper.AppError "If-Match header missing"
 // @Failure      500  {object}  helper.AppError "Internal server error"
 // @Router       /admin/matches/{id} [delete]
 // @Security     BearerAuth
@@ -412,6 +499,10 @@ func (h *MatchHandler) DeleteMatch(c *gin.Context) {
 	defer cancel()
 
 	err = h.MatchDomainService.DeleteMatch(ctx, id)
+	if errors.Is(err, constants.ErrVersionMismatch) {
+		helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("match"))
+		return
+	}
 	if err != nil && !errors.Is(err, constants.ErrRecordNotFound) {
 		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
 		return
@@ -0,0 +1,414 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	httpMapper "github.com/EdwinRincon/browersfc-api/adapter/http"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/helper"
+	domainservice "github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+type MatchReportHandler struct {
+	MatchReportDomainService *domainservice.MatchReportDomainService
+	MatchReportMapper        *httpMapper.MatchReportHTTPMapper
+}
+
+func NewMatchReportHandler(matchReportDomainService *domainservice.MatchReportDomainService) *MatchReportHandler {
+	return &MatchReportHandler{
+		MatchReportDomainService: matchReportDomainService,
+		MatchReportMapper:        httpMapper.NewMatchReportHTTPMapper(),
+	}
+}
+
+// SubmitMatchReport godoc
+// @Summary Submit a report for a completed match
+// @Description Referees and coaches submit the final score, scorers, cards, incidents and attendance. The report stays pending until an administrator reviews it.
+// @Tags match-reports
+// @ID submitMatchReport
+// @Accept json
+// @Produce json
+// @Param id path int true "Match ID"
+// @Param report body dto.SubmitMatchReportRequest true "Match report data"
+// @Success 201 {object} dto.MatchReportResponse "Created"
+// @Failure 400 {object} helper.AppError "Invalid input or match not completed"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 404 {object} helper.AppError "Match not found"
+// @Failure 409 {object} helper.AppError "Match already has an approved report"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /matches/{id}/reports [post]
+// @Security BearerAuth
+func (h *MatchReportHandler) SubmitMatchReport(c *gin.Context) {
+	matchID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidMatchID))
+		return
+	}
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication required"))
+		return
+	}
+
+	var submitRequest dto.SubmitMatchReportRequest
+	if err = c.ShouldBindJSON(&submitRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidReportData))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	report := h.MatchReportMapper.DTOToDomain(&submitRequest, matchID, userID)
+
+	createdReport, err := h.MatchReportDomainService.SubmitReport(ctx, report)
+	if err != nil {
+		h.writeReportError(c, err)
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusCreated, h.MatchReportMapper.DomainToDTO(createdReport), "Match report submitted successfully")
+}
+
+// GetMatchReportsByMatchID godoc
+// @Summary Get the reports submitted for a match
+// @Tags match-reports
+// @ID getMatchReportsByMatchID
+// @Produce json
+// @Param id path int true "Match ID"
+// @Param page query int false "Page number" default(0)
+// @Param pageSize query int false "Page size" default(10)
+// @Param sort query string false "Sort field" default(created_at)
+// @Param order query string false "Sort order" Enums(asc, desc) default(desc)
+// @Success 200 {object} helper.AppSuccess{data=helper.PaginatedResponse{items=[]dto.MatchReportResponse, totalCount=int}}
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /matches/{id}/reports [get]
+// @Security BearerAuth
+func (h *MatchReportHandler) GetMatchReportsByMatchID(c *gin.Context) {
+	matchID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidMatchID))
+		return
+	}
+
+	h.writeReportList(c, domain.MatchReportFilter{MatchID: matchID})
+}
+
+// GetMyMatchReports godoc
+// @Summary Get the match reports submitted by the current user
+// @Tags match-reports
+// @ID getMyMatchReports
+// @Produce json
+// @Param status query string false "Report status" Enums(pending, approved, rejected)
+// @Param page query int false "Page number" default(0)
+// @Param pageSize query int false "Page size" default(10)
+// @Param sort query string false "Sort field" default(created_at)
+// @Param order query string false "Sort order" Enums(asc, desc) default(desc)
+// @Success 200 {object} helper.AppSuccess{data=helper.PaginatedResponse{items=[]dto.MatchReportResponse, totalCount=int}}
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /match-reports [get]
+// @Security BearerAuth
+func (h *MatchReportHandler) GetMyMatchReports(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication required"))
+		return
+	}
+
+	h.writeReportList(c, domain.MatchReportFilter{
+		Status:        c.Query("status"),
+		SubmittedByID: userID,
+	})
+}
+
+// GetMatchReportsForReview godoc
+// @Summary Get match reports for administrator review
+// @Tags match-reports
+// @ID getMatchReportsForReview
+// @Produce json
+// @Param status query string false "Report status" Enums(pending, approved, rejected) default(pending)
+// @Param page query int false "Page number" default(0)
+// @Param pageSize query int false "Page size" default(10)
+// @Param sort query string false "Sort field" default(created_at)
+// @Param order query string false "Sort order" Enums(asc, desc) default(desc)
+// @Success 200 {object} helper.AppSuccess{data=helper.PaginatedResponse{items=[]dto.MatchReportResponse, totalCount=int}}
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/match-reports [get]
+// @Security BearerAuth
+func (h *MatchReportHandler) GetMatchReportsForReview(c *gin.Context) {
+	h.writeReportList(c, domain.MatchReportFilter{
+		Status: c.DefaultQuery("status", domain.MatchReportStatusPending),
+	})
+}
+
+// GetMatchReportByID godoc
+// @Summary Get a match report by ID
+// @Description Only the submitter or an administrator can see a report.
+// @Tags match-reports
+// @ID getMatchReportByID
+// @Produce json
+// @Param id path int true "Match report ID"
+// @Success 200 {object} dto.MatchReportResponse "Success"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 403 {object} helper.AppError "Forbidden"
+// @Failure 404 {object} helper.AppError "Match report not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /match-reports/{id} [get]
+// @Security BearerAuth
+func (h *MatchReportHandler) GetMatchReportByID(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidReportID))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	report, err := h.MatchReportDomainService.GetReportByID(ctx, id)
+	if err != nil {
+		h.writeReportError(c, err)
+		return
+	}
+
+	if report.SubmittedByID != c.GetString("user_id") && !hasPermission(c, domain.PermissionMatchReportReview) {
+		helper.WriteErrorResponse(c, helper.NewForbiddenError("Only the submitter or a reviewer can access this report"))
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, h.MatchReportMapper.DomainToDTO(report), "Match report found successfully")
+}
+
+// ResubmitMatchReport godoc
+// @Summary Edit and resubmit a match report
+// @Description Replaces the content of a pending or rejected report and puts it back in the review queue. Only the submitter can resubmit.
+// @Tags match-reports
+// @ID resubmitMatchReport
+// @Accept json
+// @Produce json
+// @Param id path int true "Match report ID"
+// @Param report body dto.SubmitMatchReportRequest true "Match report data"
+// @Success 200 {object} dto.MatchReportResponse "Resubmitted"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 403 {object} helper.AppError "Forbidden"
+// @Failure 404 {object} helper.AppError "Match report not found"
+// @Failure 409 {object} helper.AppError "Report already approved"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /match-reports/{id} [put]
+// @Security BearerAuth
+func (h *MatchReportHandler) ResubmitMatchReport(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidReportID))
+		return
+	}
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication required"))
+		return
+	}
+
+	var submitRequest dto.SubmitMatchReportRequest
+	if err = c.ShouldBindJSON(&submitRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidReportData))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	updates := h.MatchReportMapper.DTOToDomain(&submitRequest, 0, userID)
+
+	updatedReport, err := h.MatchReportDomainService.ResubmitReport(ctx, id, userID, updates)
+	if err != nil {
+		h.writeReportError(c, err)
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, h.MatchReportMapper.DomainToDTO(updatedReport), "Match report resubmitted successfully")
+}
+
+// ApproveMatchReport godoc
+// @Summary Approve a pending match report
+// @Description Applies the reported result to the match, player stats and standings in a single transaction.
+// @Tags match-reports
+// @ID approveMatchReport
+// @Accept json
+// @Produce json
+// @Param id path int true "Match report ID"
+// @Param review body dto.ReviewMatchReportRequest false "Optional review comments"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
+// @Success 200 {object} dto.MatchReportResponse "Approved"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 404 {object} helper.AppError "Match report not found"
+// @Failure 409 {object} helper.AppError "Report is not pending"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/match-reports/{id}/approve [post]
+// @Security BearerAuth
+func (h *MatchReportHandler) ApproveMatchReport(c *gin.Context) {
+	h.reviewMatchReport(c, true)
+}
+
+// RejectMatchReport godoc
+// @Summary Reject a pending match report
+// @Description Sends the report back to its submitter with the review comments, which are required.
+// @Tags match-reports
+// @ID rejectMatchReport
+// @Accept json
+// @Produce json
+// @Param id path int true "Match report ID"
+// @Param review body dto.ReviewMatchReportRequest true "Review comments"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
+// @Success 200 {object} dto.MatchReportResponse "Rejected"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 404 {object} helper.AppError "Match report not found"
+// @Failure 409 {object} helper.AppError "Report is not pending"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/match-reports/{id}/reject [post]
+// @Security BearerAuth
+func (h *MatchReportHandler) RejectMatchReport(c *gin.Context) {
+	h.reviewMatchReport(c, false)
+}
+
+// reviewMatchReport handles both review outcomes, which share the same input
+func (h *MatchReportHandler) reviewMatchReport(c *gin.Context, approve bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidReportID))
+		return
+	}
+
+	reviewerID := c.GetString("user_id")
+	if reviewerID == "" {
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication required"))
+		return
+	}
+
+	var reviewRequest dto.ReviewMatchReportRequest
+	if c.Request.ContentLength != 0 {
+		if err = c.ShouldBindJSON(&reviewRequest); err != nil {
+			helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidReportData))
+			return
+		}
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	var report *domain.MatchReport
+	message := "Match report approved successfully"
+	if approve {
+		report, err = h.MatchReportDomainService.ApproveReport(ctx, id, reviewerID, reviewRequest.Comments)
+	} else {
+		report, err = h.MatchReportDomainService.RejectReport(ctx, id, reviewerID, reviewRequest.Comments)
+		message = "Match report rejected successfully"
+	}
+	if err != nil {
+		if !approve && errors.Is(err, constants.ErrInvalidData) {
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("comments", "Review comments are required when rejecting a report"))
+			return
+		}
+		h.writeReportError(c, err)
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, h.MatchReportMapper.DomainToDTO(report), message)
+}
+
+// writeReportList parses the pagination parameters and writes the filtered report page
+func (h *MatchReportHandler) writeReportList(c *gin.Context, filter domain.MatchReportFilter) {
+	sort := c.DefaultQuery("sort", "created_at")
+	order := c.DefaultQuery("order", "desc")
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "0"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+
+	if page < 0 {
+		page = 0
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 10
+	}
+	if order != "asc" && order != "desc" {
+		order = "desc"
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	reports, total, err := h.MatchReportDomainService.GetPaginatedReports(ctx, filter, sort, order, page, pageSize)
+	if err != nil {
+		if errors.Is(err, constants.ErrInvalidData) {
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("status", "Status must be one of pending, approved or rejected"))
+		} else {
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	response := helper.PaginatedResponse{
+		Items:      h.MatchReportMapper.DomainListToDTO(reports),
+		TotalCount: total,
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, response, "Match reports retrieved successfully")
+}
+
+// writeReportError maps match report workflow errors to HTTP responses
+func (h *MatchReportHandler) writeReportError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constants.ErrMatchReportNotFound), errors.Is(err, constants.ErrInvalidID):
+		helper.WriteErrorResponse(c, helper.NewNotFoundError("match report"))
+	case errors.Is(err, constants.ErrMatchNotFound):
+		helper.WriteErrorResponse(c, helper.NewNotFoundError("match"))
+	case errors.Is(err, constants.ErrMatchNotCompleted):
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("match", "Reports can only be submitted for completed matches"))
+	case errors.Is(err, constants.ErrMatchReportInconsistent):
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("scorers", "Scorers must belong to the match teams and add up to the final score"))
+	case errors.Is(err, constants.ErrPlayerNotFound):
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("player_id", "Referenced player does not exist"))
+	case errors.Is(err, constants.ErrInvalidData):
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("body", constants.MsgInvalidReportData))
+	case errors.Is(err, constants.ErrForbidden):
+		helper.WriteErrorResponse(c, helper.NewForbiddenError("Only the submitter can edit this report"))
+	case errors.Is(err, constants.ErrMatchReportApproved):
+		helper.WriteErrorResponse(c, helper.NewConflictError("match report", "The match already has an approved report"))
+	case errors.Is(err, constants.ErrMatchReportNotPending):
+		helper.WriteErrorResponse(c, helper.NewConflictError("match report", "The report is not pending review"))
+	default:
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+	}
+}
+
+// hasPermission reports whether the authenticated user's token grants the permission.
+func hasPermission(c *gin.Context, permission string) bool {
+	return slices.Contains(grantedPermissions(c), permission)
+}
+
+// grantedPermissions returns the permissions granted by the authenticated user's token.
+func grantedPermissions(c *gin.Context) []string {
+	permissions, _ := c.Get("permissions")
+	granted, _ := permissions.([]string)
+	return granted
+}
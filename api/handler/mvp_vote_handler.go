@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	httpMapper "github.com/EdwinRincon/browersfc-api/adapter/http"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/helper"
+	domainservice "github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+type MVPVoteHandler struct {
+	MVPVoteDomainService *domainservice.MVPVoteDomainService
+	MVPVoteMapper        *httpMapper.MVPVoteHTTPMapper
+}
+
+func NewMVPVoteHandler(mvpVoteDomainService *domainservice.MVPVoteDomainService) *MVPVoteHandler {
+	return &MVPVoteHandler{
+		MVPVoteDomainService: mvpVoteDomainService,
+		MVPVoteMapper:        httpMapper.NewMVPVoteHTTPMapper(),
+	}
+}
+
+// GetMVPPoll godoc
+// @Summary Get the MVP vote of a match
+// @Description Lists the players who appeared in the match lineups and the current user's vote. Vote counts and the winner are shown once voting has closed.
+// @Tags mvp-votes
+// @ID getMVPPoll
+// @Produce json
+// @Param id path int true "Match ID"
+// @Success 200 {object} dto.MVPPollResponse "Success"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 404 {object} helper.AppError "MVP voting not started"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /matches/{id}/mvp-poll [get]
+// @Security BearerAuth
+func (h *MVPVoteHandler) GetMVPPoll(c *gin.Context) {
+	matchID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidMatchID))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	results, err := h.MVPVoteDomainService.GetPollResults(ctx, matchID, c.GetString("user_id"))
+	if err != nil {
+		if errors.Is(err, constants.ErrMVPPollNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("MVP poll"))
+		} else {
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, h.MVPVoteMapper.ResultsToDTO(results, time.Now()), "MVP poll retrieved successfully")
+}
+
+// CastMVPVote godoc
+// @Summary Vote for the MVP of a match
+// @Description Authenticated users vote once per match for a player who appeared in its lineups, while the voting window after full time is open.
+// @Tags mvp-votes
+// @ID castMVPVote
+// @Accept json
+// @Produce json
+// @Param id path int true "Match ID"
+// @Param vote body dto.CreateMVPVoteRequest true "Voted player"
+// @Success 201 {object} dto.MVPVoteResponse "Created"
+// @Failure 400 {object} helper.AppError "Invalid input, voting closed or player not in lineups"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 404 {object} helper.AppError "MVP voting not started"
+// @Failure 409 {object} helper.AppError "Already voted"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /matches/{id}/mvp-votes [post]
+// @Security BearerAuth
+func (h *MVPVoteHandler) CastMVPVote(c *gin.Context) {
+	matchID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidMatchID))
+		return
+	}
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication required"))
+		return
+	}
+
+	var voteRequest dto.CreateMVPVoteRequest
+	if err = c.ShouldBindJSON(&voteRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidVoteData))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	vote, err := h.MVPVoteDomainService.CastVote(ctx, matchID, userID, voteRequest.PlayerID)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrMVPPollNotFound):
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("MVP poll"))
+		case errors.Is(err, constants.ErrMVPVotingClosed):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("match", "MVP voting is closed for this match"))
+		case errors.Is(err, constants.ErrPlayerNotInLineup):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("player_id", "The player did not appear in the match lineups"))
+		case errors.Is(err, constants.ErrRecordAlreadyExists):
+			helper.WriteErrorResponse(c, helper.NewConflictError("MVP vote", "You already voted for this match"))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusCreated, h.MVPVoteMapper.VoteToDTO(vote), "MVP vote recorded successfully")
+}
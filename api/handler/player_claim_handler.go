@@ -0,0 +1,323 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	httpMapper "github.com/EdwinRincon/browersfc-api/adapter/http"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/helper"
+	domainservice "github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/EdwinRincon/browersfc-api/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type PlayerClaimHandler struct {
+	PlayerClaimDomainService    *domainservice.PlayerClaimDomainService
+	AuthenticationDomainService *domainservice.AuthenticationDomainService
+	PlayerClaimMapper           *httpMapper.PlayerClaimHTTPMapper
+}
+
+func NewPlayerClaimHandler(playerClaimDomainService *domainservice.PlayerClaimDomainService, authService *domainservice.AuthenticationDomainService) *PlayerClaimHandler {
+	return &PlayerClaimHandler{
+		PlayerClaimDomainService:    playerClaimDomainService,
+		AuthenticationDomainService: authService,
+		PlayerClaimMapper:           httpMapper.NewPlayerClaimHTTPMapper(),
+	}
+}
+
+// SubmitPlayerClaim godoc
+// @Summary Claim a player profile for the current user
+// @Description Asks to link the current account to a player profile. The claim stays pending until an administrator approves it; a user can only have one pending claim.
+// @Tags player-claims
+// @ID submitPlayerClaim
+// @Accept json
+// @Produce json
+// @Param claim body dto.CreatePlayerClaimRequest true "Player claim data"
+// @Success 201 {object} dto.PlayerClaimResponse "Created"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 404 {object} helper.AppError "Player not found"
+// @Failure 409 {object} helper.AppError "Player or user already linked, or claim already pending"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/me/player-claims [post]
+// @Security BearerAuth
+func (h *PlayerClaimHandler) SubmitPlayerClaim(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication required"))
+		return
+	}
+
+	var claimRequest dto.CreatePlayerClaimRequest
+	if err := c.ShouldBindJSON(&claimRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidClaimData))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	claim := h.PlayerClaimMapper.DTOToDomain(&claimRequest, userID)
+
+	createdClaim, err := h.PlayerClaimDomainService.SubmitClaim(ctx, claim)
+	if err != nil {
+		h.writeClaimError(c, err)
+		return
+	}
+
+	logger.Info(c, "player claim submitted", "claim_id", createdClaim.ID, "player_id", createdClaim.PlayerID, "user_id", userID)
+	helper.WriteSuccessResponse(c, http.StatusCreated, h.PlayerClaimMapper.DomainToDTO(createdClaim), "Player claim submitted successfully")
+}
+
+// GetMyPlayerClaims godoc
+// @Summary Get the player claims of the current user
+// @Tags player-claims
+// @ID getMyPlayerClaims
+// @Produce json
+// @Param status query string false "Claim status" Enums(pending, approved, rejected)
+// @Param page query int false "Page number" default(0)
+// @Param pageSize query int false "Page size" default(10)
+// @Success 200 {object} helper.AppSuccess{data=helper.PaginatedResponse{items=[]dto.PlayerClaimResponse, totalCount=int}}
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/me/player-claims [get]
+// @Security BearerAuth
+func (h *PlayerClaimHandler) GetMyPlayerClaims(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication required"))
+		return
+	}
+
+	h.writeClaimList(c, domain.PlayerClaimFilter{
+		Status: c.Query("status"),
+		UserID: userID,
+	})
+}
+
+// GetPlayerClaimsForReview godoc
+// @Summary Get player claims for administrator review
+// @Description Lists the claims oldest first so the queue is reviewed in order.
+// @Tags player-claims
+// @ID getPlayerClaimsForReview
+// @Produce json
+// @Param status query string false "Claim status" Enums(pending, approved, rejected) default(pending)
+// @Param page query int false "Page number" default(0)
+// @Param pageSize query int false "Page size" default(10)
+// @Success 200 {object} helper.AppSuccess{data=helper.PaginatedResponse{items=[]dto.PlayerClaimResponse, totalCount=int}}
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/player-claims [get]
+// @Security BearerAuth
+func (h *PlayerClaimHandler) GetPlayerClaimsForReview(c *gin.Context) {
+	h.writeClaimList(c, domain.PlayerClaimFilter{
+		Status: c.DefaultQuery("status", domain.PlayerClaimStatusPending),
+	})
+}
+
+// ApprovePlayerClaim godoc
+// @Summary Approve a pending player claim
+// @Description Links the player to the user and rejects the other pending claims for the player. Users with the fan role are given the player role and must sign in again.
+// @Tags player-claims
+// @ID approvePlayerClaim
+// @Accept json
+// @Produce json
+// @Param id path int true "Player claim ID"
+// @Param review body dto.ReviewPlayerClaimRequest false "Optional review comments"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
+// @Success 200 {object} dto.PlayerClaimResponse "Approved"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 404 {object} helper.AppError "Player claim not found"
+// @Failure 409 {object} helper.AppError "Claim is not pending, or player or user already linked"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/player-claims/{id}/approve [post]
+// @Security BearerAuth
+func (h *PlayerClaimHandler) ApprovePlayerClaim(c *gin.Context) {
+	h.reviewPlayerClaim(c, true)
+}
+
+// RejectPlayerClaim godoc
+// @Summary Reject a pending player claim
+// @Description Closes the claim with the review comments, which are required.
+// @Tags player-claims
+// @ID rejectPlayerClaim
+// @Accept json
+// @Produce json
+// @Param id path int true "Player claim ID"
+// @Param review body dto.ReviewPlayerClaimRequest true "Review comments"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
+// @Success 200 {object} dto.PlayerClaimResponse "Rejected"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 404 {object} helper.AppError "Player claim not found"
+// @Failure 409 {object} helper.AppError "Claim is not pending"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/player-claims/{id}/reject [post]
+// @Security BearerAuth
+func (h *PlayerClaimHandler) RejectPlayerClaim(c *gin.Context) {
+	h.reviewPlayerClaim(c, false)
+}
+
+// GetMyPlayerDashboard godoc
+// @Summary Get the player dashboard of the current user
+// @Description Returns the player profile linked to the current user with their statistics split by season, newest first, and the career totals.
+// @Tags player-claims
+// @ID getMyPlayerDashboard
+// @Produce json
+// @Success 200 {object} dto.PlayerDashboardResponse "Success"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 404 {object} helper.AppError "No player linked to the user"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/me/player [get]
+// @Security BearerAuth
+func (h *PlayerClaimHandler) GetMyPlayerDashboard(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication required"))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	dashboard, err := h.PlayerClaimDomainService.GetPlayerDashboard(ctx, userID)
+	if err != nil {
+		if errors.Is(err, constants.ErrPlayerNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("linked player"))
+			return
+		}
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, h.PlayerClaimMapper.DashboardToDTO(dashboard), "Player dashboard retrieved successfully")
+}
+
+// reviewPlayerClaim handles both review outcomes, which share the same input
+func (h *PlayerClaimHandler) reviewPlayerClaim(c *gin.Context, approve bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidClaimID))
+		return
+	}
+
+	reviewerID := c.GetString("user_id")
+	if reviewerID == "" {
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication required"))
+		return
+	}
+
+	var reviewRequest dto.ReviewPlayerClaimRequest
+	if c.Request.ContentLength != 0 {
+		if err = c.ShouldBindJSON(&reviewRequest); err != nil {
+			helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidClaimData))
+			return
+		}
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	if !approve {
+		claim, err := h.PlayerClaimDomainService.RejectClaim(ctx, id, reviewerID, reviewRequest.Comments)
+		if err != nil {
+			if errors.Is(err, constants.ErrInvalidData) {
+				helper.WriteErrorResponse(c, helper.NewBadRequestError("comments", "Review comments are required when rejecting a claim"))
+				return
+			}
+			h.writeClaimError(c, err)
+			return
+		}
+
+		logger.Info(c, "player claim rejected", "claim_id", claim.ID, "reviewed_by", reviewerID)
+		helper.WriteSuccessResponse(c, http.StatusOK, h.PlayerClaimMapper.DomainToDTO(claim), "Player claim rejected successfully")
+		return
+	}
+
+	claim, roleChanged, err := h.PlayerClaimDomainService.ApproveClaim(ctx, id, reviewerID, reviewRequest.Comments)
+	if err != nil {
+		h.writeClaimError(c, err)
+		return
+	}
+
+	// Tokens carry the role permissions, so a role change must end the user's current sessions
+	if roleChanged {
+		if err := h.AuthenticationDomainService.RevokeUserSessions(ctx, claim.UserID); err != nil {
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+			return
+		}
+		logger.Info(c, "user sessions revoked after role change", "user_id", claim.UserID)
+	}
+
+	logger.Info(c, "player claim approved", "claim_id", claim.ID, "player_id", claim.PlayerID, "user_id", claim.UserID, "reviewed_by", reviewerID)
+	helper.WriteSuccessResponse(c, http.StatusOK, h.PlayerClaimMapper.DomainToDTO(claim), "Player claim approved successfully")
+}
+
+// writeClaimList parses the pagination parameters and writes the filtered claim page
+func (h *PlayerClaimHandler) writeClaimList(c *gin.Context, filter domain.PlayerClaimFilter) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "0"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+
+	if page < 0 {
+		page = 0
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 10
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	claims, total, err := h.PlayerClaimDomainService.GetPaginatedClaims(ctx, filter, page, pageSize)
+	if err != nil {
+		if errors.Is(err, constants.ErrInvalidData) {
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("status", "Status must be one of pending, approved or rejected"))
+		} else {
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	response := helper.PaginatedResponse{
+		Items:      h.PlayerClaimMapper.DomainListToDTO(claims),
+		TotalCount: total,
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, response, "Player claims retrieved successfully")
+}
+
+// writeClaimError maps player claim workflow errors to HTTP responses
+func (h *PlayerClaimHandler) writeClaimError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constants.ErrPlayerClaimNotFound):
+		helper.WriteErrorResponse(c, helper.NewNotFoundError("player claim"))
+	case errors.Is(err, constants.ErrPlayerNotFound):
+		helper.WriteErrorResponse(c, helper.NewNotFoundError("player"))
+	case errors.Is(err, constants.ErrRecordNotFound):
+		helper.WriteErrorResponse(c, helper.NewNotFoundError("user"))
+	case errors.Is(err, constants.ErrInvalidData):
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("body", constants.MsgInvalidClaimData))
+	case errors.Is(err, constants.ErrPlayerAlreadyLinked):
+		helper.WriteErrorResponse(c, helper.NewConflictError("player", "The player is already linked to an account"))
+	case errors.Is(err, constants.ErrUserAlreadyLinked):
+		helper.WriteErrorResponse(c, helper.NewConflictError("user", "The account is already linked to a player"))
+	case errors.Is(err, constants.ErrPlayerClaimPending):
+		helper.WriteErrorResponse(c, helper.NewConflictError("player claim", "The account already has a pending claim"))
+	case errors.Is(err, constants.ErrPlayerClaimNotPending):
+		helper.WriteErrorResponse(c, helper.NewConflictError("player claim", "The claim is not pending review"))
+	default:
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+	}
+}
@@ -16,14 +16,16 @@ import (
 )
 
 type PlayerHandler struct {
-	PlayerDomainService *domainservice.PlayerDomainService
-	PlayerMapper        *httpMapper.PlayerHTTPMapper
+	PlayerDomainService     *domainservice.PlayerDomainService
+	TeamAccessDomainService *domainservice.TeamAccessDomainService
+	PlayerMapper            *httpMapper.PlayerHTTPMapper
 }
 
-func NewPlayerHandler(playerDomainService *domainservice.PlayerDomainService) *PlayerHandler {
+func NewPlayerHandler(playerDomainService *domainservice.PlayerDomainService, teamAccessDomainService *domainservice.TeamAccessDomainService) *PlayerHandler {
 	return &PlayerHandler{
-		PlayerDomainService: playerDomainService,
-		PlayerMapper:        httpMapper.NewPlayerHTTPMapper(),
+		PlayerDomainService:     playerDomainService,
+		TeamAccessDomainService: teamAccessDomainService,
+		PlayerMapper:            httpMapper.NewPlayerHTTPMapper(),
 	}
 }
 
@@ -35,9 +37,11 @@ func NewPlayerHandler(playerDomainService *domainservice.PlayerDomainService) *P
 // @Accept       json
 // @Produce      json
 // @Param        player  body      dto.CreatePlayerRequest  true  "Player data"
+// @Param        Idempotency-Key header string false "Key that makes retries of the request safe"
 // @Success      201     {object}  dto.PlayerShort  "Player created successfully"
 // @Failure      400     {object}  helper.AppError "Invalid input"
 // @Failure      409     {object}  helper.AppError "Conflict (e.g., nickname exists)"
+// @Failure      422     {object}  helper.AppError "Idempotency-Key already used for a different request"
 // @Failure      500     {object}  helper.AppError "Internal server error"
 // @Router       /admin/players [post]
 // @Security     BearerAuth
@@ -82,7 +86,10 @@ func (h *PlayerHandler) CreatePlayer(c *gin.Context) {
 // @Tags         players
 // @ID           getPlayerByID
 // @Param        id   path      int  true  "Player ID"
+// @Param        If-None-Match header string false "ETag from a previous response"
 // @Success      200  {object}  dto.PlayerResponse  "Player retrieved successfully"
+// @Success      304 "Not Modified"
+// @Header       200 {string} ETag "Version of the player"
 // @Failure      400  {object}  helper.AppError "Invalid input"
 // @Failure      404  {object}  helper.AppError "Player not found"
 // @Failure      500  {object}  helper.AppError "Internal server error"
@@ -109,6 +116,10 @@ func (h *PlayerHandler) GetPlayerByID(c *gin.Context) {
 		return
 	}
 
+	if helper.WriteNotModified(c, player.Version) {
+		return
+	}
+
 	playerResponse := h.PlayerMapper.DomainToDTO(player)
 
 	helper.WriteSuccessResponse(c, http.StatusOK, playerResponse, "Player retrieved successfully")
@@ -206,10 +217,14 @@ func (h *PlayerHandler) GetPaginatedPlayers(c *gin.Context) {
 // @Produce      json
 // @Param        id      path      int           true  "Player ID"
 // @Param        player  body      dto.UpdatePlayerRequest true  "Updated player data"
+// @Param        If-Match header string true "ETag of the player being changed"
 // @Success      200     {object}  dto.PlayerShort  "Player updated successfully"
+// @Header       200 {string} ETag "New version of the player"
 // @Failure      400     {object}  helper.AppError "Invalid input"
 // @Failure      404     {object}  helper.AppError "Player not found"
 // @Failure      409     {object}  helper.AppError "Conflict (e.g., nickname exists)"
+// @Failure      412     {object}  helper.AppError "The player was modified since it was read"
+// @Failure      428     {object}  helper.AppError "If-Match header missing"
 // @Failure      500     {object}  helper.AppError "Internal server error"
 // @Router       /admin/players/{id} [put]
 // @Security     BearerAuth
@@ -236,6 +251,10 @@ func (h *PlayerHandler) UpdatePlayer(c *gin.Context) {
 
 	updatedPlayer, err := h.PlayerDomainService.UpdatePlayer(ctx, id, playerUpdate)
 	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("player"))
+			return
+		}
 		if errors.Is(err, constants.ErrRecordNotFound) {
 			helper.WriteErrorResponse(c, helper.NewNotFoundError("player"))
 		} else if errors.Is(err, constants.ErrRecordAlreadyExists) {
@@ -248,19 +267,74 @@ func (h *PlayerHandler) UpdatePlayer(c *gin.Context) {
 		return
 	}
 
+	helper.SetETag(c, updatedPlayer.Version)
 	response := h.PlayerMapper.DomainToShortDTO(updatedPlayer)
 	helper.WriteSuccessResponse(c, http.StatusOK, response, "Player updated successfully")
 }
 
+// UpdatePlayerAvailability godoc
+// @Summary      Update a player's availability
+// @Description  Marks a player as injured or available. Coaches can only update players currently in their team's squad.
+// @Tags         players
+// @ID           updatePlayerAvailability
+// @Accept       json
+// @Produce      json
+// @Param        id            path      int                                  true  "Player ID"
+// @Param        availability  body      dto.UpdatePlayerAvailabilityRequest  true  "Player availability"
+// @Success      200     {object}  dto.PlayerResponse  "Player availability updated successfully"
+// @Failure      400     {object}  helper.AppError "Invalid input"
+// @Failure      403     {object}  helper.AppError "Not allowed to manage this team"
+// @Failure      404     {object}  helper.AppError "Player not found"
+// @Failure      500     {object}  helper.AppError "Internal server error"
+// @Router       /players/{id}/availability [put]
+// @Security     BearerAuth
+func (h *PlayerHandler) UpdatePlayerAvailability(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", "Invalid player ID"))
+		return
+	}
+
+	var availabilityRequest dto.UpdatePlayerAvailabilityRequest
+	if err = c.ShouldBindJSON(&availabilityRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", "Invalid player availability"))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	if err = h.TeamAccessDomainService.AuthorizeSquadPlayer(ctx, c.GetString("user_id"), grantedPermissions(c), id); err != nil {
+		writeTeamAccessError(c, err, "player")
+		return
+	}
+
+	player, err := h.PlayerDomainService.UpdatePlayerAvailability(ctx, id, *availabilityRequest.Injured)
+	if err != nil {
+		if errors.Is(err, constants.ErrRecordNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("player"))
+		} else {
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, h.PlayerMapper.DomainToDTO(player), "Player availability updated successfully")
+}
+
 // DeletePlayer godoc
 // @Summary      Delete a player
 // @Description  Deletes a player by its ID
 // @Tags         players
 // @ID           deletePlayer
 // @Param        id   path      int  true  "Player ID"
+// @Param        If-Match header string true "ETag of the player being changed"
 // @Success      204 "No Content"
 // @Failure      400  {object}  helper.AppError "Invalid input"
 // @Failure      404  {object}  helper.AppError "Player not found"
+// @Failure      412  {object}  helper.AppError "The player was modified since it was read"
+// @Failure      428  {object}  helper.AppError "If-Match header missing"
 // @Failure      500  {object}  helper.AppError "Internal server error"
 // @Router       /admin/players/{id} [delete]
 // @Security     BearerAuth
@@ -276,6 +350,10 @@ func (h *PlayerHandler) DeletePlayer(c *gin.Context) {
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
 	err = h.PlayerDomainService.DeletePlayer(ctx, id)
+	if errors.Is(err, constants.ErrVersionMismatch) {
+		helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("player"))
+		return
+	}
 	if err != nil && !errors.Is(err, constants.ErrRecordNotFound) {
 		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
 		return
@@ -10,6 +10,7 @@ import (
 	httpMapper "github.com/EdwinRincon/browersfc-api/adapter/http"
 	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/EdwinRincon/browersfc-api/helper"
 	domainservice "github.com/EdwinRincon/browersfc-api/internal/domain/service"
 	"github.com/gin-gonic/gin"
@@ -17,28 +18,35 @@ import (
 
 type PlayerStatsHandler struct {
 	PlayerStatsDomainService *domainservice.PlayerStatsDomainService
+	TeamAccessDomainService  *domainservice.TeamAccessDomainService
 	PlayerStatsMapper        *httpMapper.PlayerStatsHTTPMapper
 }
 
-func NewPlayerStatsHandler(playerStatsDomainService *domainservice.PlayerStatsDomainService) *PlayerStatsHandler {
+func NewPlayerStatsHandler(playerStatsDomainService *domainservice.PlayerStatsDomainService, teamAccessDomainService *domainservice.TeamAccessDomainService) *PlayerStatsHandler {
 	return &PlayerStatsHandler{
 		PlayerStatsDomainService: playerStatsDomainService,
+		TeamAccessDomainService:  teamAccessDomainService,
 		PlayerStatsMapper:        httpMapper.NewPlayerStatsHTTPMapper(),
 	}
 }
 
 // CreatePlayerStat godoc
 // @Summary      Create a new player statistic
-// @Description  Creates a new player statistic with the provided data
+// @Description  Creates a new player statistic with the provided data. Coaches can only record statistics of their own team.
 // @Tags         player-stats
 // @ID           createPlayerStat
 // @Accept       json
 // @Produce      json
 // @Param        playerStat  body      dto.CreatePlayerStatRequest  true  "Player Statistic data"
+// @Param        Idempotency-Key header string false "Key that makes retries of the request safe"
 // @Success      201   {object}  dto.PlayerStatResponse  "Player Statistic created successfully"
 // @Failure      400   {object}  helper.AppError "Invalid input"
+// @Failure      403   {object}  helper.AppError "Not allowed to manage this team"
 // @Failure      404   {object}  helper.AppError "Related entity not found"
+// @Failure      409   {object}  helper.AppError "Request with the same Idempotency-Key still in progress"
+// @Failure      422   {object}  helper.AppError "Idempotency-Key already used for a different request"
 // @Failure      500   {object}  helper.AppError "Internal server error"
+// @Router       /player-stats [post]
 // @Router       /admin/player-stats [post]
 // @Security     BearerAuth
 func (h *PlayerStatsHandler) CreatePlayerStat(c *gin.Context) {
@@ -50,6 +58,11 @@ func (h *PlayerStatsHandler) CreatePlayerStat(c *gin.Context) {
 
 	domainPlayerStat := h.PlayerStatsMapper.DTOToDomain(&createRequest)
 
+	if err := h.TeamAccessDomainService.AuthorizePlayerStat(c.Request.Context(), c.GetString("user_id"), grantedPermissions(c), domainPlayerStat); err != nil {
+		writeTeamAccessError(c, err, "player stat")
+		return
+	}
+
 	err := h.PlayerStatsDomainService.CreatePlayerStat(c.Request.Context(), domainPlayerStat)
 	if err != nil {
 		switch {
@@ -82,7 +95,10 @@ func (h *PlayerStatsHandler) CreatePlayerStat(c *gin.Context) {
 // @ID           getPlayerStatByID
 // @Produce      json
 // @Param        id  path      string  true  "Player Statistic ID"
+// @Param        If-None-Match header string false "ETag from a previous response"
 // @Success      200  {object}  dto.PlayerStatResponse  "Player statistic retrieved successfully"
+// @Success      304 "Not Modified"
+// @Header       200 {string} ETag "Version of the player stat"
 // @Failure      400  {object}  helper.AppError "Invalid ID format"
 // @Failure      404  {object}  helper.AppError "Player statistic not found"
 // @Failure      500  {object}  helper.AppError "Internal server error"
@@ -104,6 +120,10 @@ func (h *PlayerStatsHandler) GetPlayerStatByID(c *gin.Context) {
 		return
 	}
 
+	if helper.WriteNotModified(c, playerStat.Version) {
+		return
+	}
+
 	response := h.PlayerStatsMapper.DomainToDTO(playerStat)
 	helper.WriteSuccessResponse(c, http.StatusOK, response, "Player statistic retrieved successfully")
 }
@@ -268,10 +288,16 @@ func (h *PlayerStatsHandler) GetPaginatedPlayerStats(c *gin.Context) {
 // @Produce      json
 // @Param        id          path      string                     true  "Player Statistic ID"
 // @Param        playerStat  body      dto.UpdatePlayerStatRequest  true  "Player Statistic update data"
+// @Param        If-Match header string true "ETag of the player stat being changed"
 // @Success      200  {object}  dto.PlayerStatResponse  "Player statistic updated successfully"
+// @Header       200 {string} ETag "New version of the player stat"
 // @Failure      400  {object}  helper.AppError "Invalid input or ID format"
+// @Failure      403  {object}  helper.AppError "Not allowed to manage this team"
 // @Failure      404  {object}  helper.AppError "Player statistic not found"
+// @Failure      412  {object}  helper.AppError "The player stat was modified since it was read"
+// @Failure      428  {object}  helper.AppError "If-Match header missing"
 // @Failure      500  {object}  helper.AppError "Internal server error"
+// @Router       /player-stats/{id} [put]
 // @Router       /admin/player-stats/{id} [put]
 // @Security     BearerAuth
 func (h *PlayerStatsHandler) UpdatePlayerStat(c *gin.Context) {
@@ -289,8 +315,25 @@ func (h *PlayerStatsHandler) UpdatePlayerStat(c *gin.Context) {
 
 	domainUpdate := h.PlayerStatsMapper.UpdateDTOToDomain(&updateRequest)
 
+	// A coach cannot move a statistic to a team they do not manage
+	userID, permissions := c.GetString("user_id"), grantedPermissions(c)
+	if err := h.TeamAccessDomainService.AuthorizePlayerStatByID(c.Request.Context(), userID, permissions, id); err != nil {
+		writeTeamAccessError(c, err, "player stat")
+		return
+	}
+	if domainUpdate.TeamID != nil {
+		if err := h.TeamAccessDomainService.AuthorizeTeam(c.Request.Context(), userID, permissions, domain.PermissionPlayerStatsWrite, *domainUpdate.TeamID); err != nil {
+			writeTeamAccessError(c, err, "team")
+			return
+		}
+	}
+
 	playerStat, err := h.PlayerStatsDomainService.UpdatePlayerStat(c.Request.Context(), id, domainUpdate)
 	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("player stat"))
+			return
+		}
 		switch {
 		case errors.Is(err, constants.ErrRecordNotFound):
 			helper.WriteErrorResponse(c, helper.NewNotFoundError(constants.MsgNotFound))
@@ -302,6 +345,7 @@ func (h *PlayerStatsHandler) UpdatePlayerStat(c *gin.Context) {
 		return
 	}
 
+	helper.SetETag(c, playerStat.Version)
 	response := h.PlayerStatsMapper.DomainToDTO(playerStat)
 	helper.WriteSuccessResponse(c, http.StatusOK, response, "Player statistic updated successfully")
 }
@@ -313,10 +357,15 @@ func (h *PlayerStatsHandler) UpdatePlayerStat(c *gin.Context) {
 // @ID           deletePlayerStat
 // @Produce      json
 // @Param        id  path      string  true  "Player Statistic ID"
+// @Param        If-Match header string true "ETag of the player stat being changed"
 // @Success      200  {object}  helper.AppSuccess  "Player statistic deleted successfully"
 // @Failure      400  {object}  helper.AppError "Invalid ID format"
+// @Failure      403  {object}  helper.AppError "Not allowed to manage this team"
 // @Failure      404  {object}  helper.AppError "Player statistic not found"
+// @Failure      412  {object}  helper.AppError "The player stat was modified since it was read"
+// @Failure      428  {object}  helper.AppError "If-Match header missing"
 // @Failure      500  {object}  helper.AppError "Internal server error"
+// @Router       /player-stats/{id} [delete]
 // @Router       /admin/player-stats/{id} [delete]
 // @Security     BearerAuth
 func (h *PlayerStatsHandler) DeletePlayerStat(c *gin.Context) {
@@ -326,8 +375,17 @@ func (h *PlayerStatsHandler) DeletePlayerStat(c *gin.Context) {
 		return
 	}
 
+	if err = h.TeamAccessDomainService.AuthorizePlayerStatByID(c.Request.Context(), c.GetString("user_id"), grantedPermissions(c), id); err != nil {
+		writeTeamAccessError(c, err, "player stat")
+		return
+	}
+
 	err = h.PlayerStatsDomainService.DeletePlayerStat(c.Request.Context(), id)
 	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("player stat"))
+			return
+		}
 		if errors.Is(err, constants.ErrRecordNotFound) {
 			helper.WriteErrorResponse(c, helper.NewNotFoundError(constants.MsgNotFound))
 		} else {
@@ -43,9 +43,11 @@ func NewPlayerTeamHandler(playerTeamDomainService *domainservice.PlayerTeamDomai
 // @Accept json
 // @Produce json
 // @Param playerTeam body dto.CreatePlayerTeamRequest true "Player-Team relationship data"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
 // @Success 201 {object} dto.PlayerTeamResponse "Player-Team relationship created successfully"
 // @Failure 400 {object} helper.AppError "Invalid input"
 // @Failure 409 {object} helper.AppError "Conflict (e.g., date overlap)"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
 // @Failure 500 {object} helper.AppError "Internal server error"
 // @Router /admin/player-teams [post]
 // @Security BearerAuth
@@ -93,7 +95,10 @@ func (h *PlayerTeamHandler) CreatePlayerTeam(c *gin.Context) {
 // @Tags playerTeams
 // @ID getPlayerTeamByID
 // @Param id path int true "PlayerTeam ID"
+// @Param If-None-Match header string false "ETag from a previous response"
 // @Success 200 {object} dto.PlayerTeamResponse "Player-team relationship retrieved successfully"
+// @Success 304 "Not Modified"
+// @Header 200 {string} ETag "Version of the player team"
 // @Failure 400 {object} helper.AppError "Invalid input"
 // @Failure 404 {object} helper.AppError "Not found"
 // @Failure 500 {object} helper.AppError "Internal server error"
@@ -120,6 +125,10 @@ func (h *PlayerTeamHandler) GetPlayerTeamByID(c *gin.Context) {
 		return
 	}
 
+	if helper.WriteNotModified(c, playerTeam.Version) {
+		return
+	}
+
 	playerTeamResponse := h.PlayerTeamMapper.DomainToDTO(playerTeam)
 
 	helper.WriteSuccessResponse(c, http.StatusOK, playerTeamResponse, msgPlayerTeamRelationshipRetrievedOK)
@@ -294,10 +303,14 @@ func (h *PlayerTeamHandler) GetPaginatedPlayerTeams(c *gin.Context) {
 // @Produce json
 // @Param id path int true "PlayerTeam ID"
 // @Param playerTeam body dto.UpdatePlayerTeamRequest true "Updated player-team data"
+// @Param If-Match header string true "ETag of the player team being changed"
 // @Success 200 {object} dto.PlayerTeamResponse "Player-team relationship updated successfully"
+// @Header 200 {string} ETag "New version of the player team"
 // @Failure 400 {object} helper.AppError "Invalid input"
 // @Failure 404 {object} helper.AppError "Not found"
 // @Failure 409 {object} helper.AppError "Conflict (e.g., date overlap)"
+// @Failure 412 {object} helper.AppError "The player team was modified since it was read"
+// @Failure 428 {object} helper.AppError "If-Match header missing"
 // @Failure 500 {object} helper.AppError "Internal server error"
 // @Router /admin/player-teams/{id} [put]
 // @Security BearerAuth
@@ -339,6 +352,10 @@ func (h *PlayerTeamHandler) UpdatePlayerTeam(c *gin.Context) {
 
 	playerTeam, err := h.PlayerTeamDomainService.UpdatePlayerTeam(ctx, id, existingPlayerTeam)
 	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("player team"))
+			return
+		}
 		switch {
 		case errors.Is(err, constants.ErrRecordNotFound):
 			helper.WriteErrorResponse(c, helper.NewNotFoundError(msgPlayerTeamRelationship))
@@ -352,6 +369,7 @@ func (h *PlayerTeamHandler) UpdatePlayerTeam(c *gin.Context) {
 		}
 	}
 
+	helper.SetETag(c, playerTeam.Version)
 	playerTeamResponse := h.PlayerTeamMapper.DomainToDTO(playerTeam)
 	helper.WriteSuccessResponse(c, http.StatusOK, playerTeamResponse, msgPlayerTeamRelationshipUpdatedOK)
 }
@@ -361,9 +379,12 @@ func (h *PlayerTeamHandler) UpdatePlayerTeam(c *gin.Context) {
 // @Tags playerTeams
 // @ID deletePlayerTeam
 // @Param id path int true "PlayerTeam ID"
+// @Param If-Match header string true "ETag of the player team being changed"
 // @Success 204 "No Content"
 // @Failure 400 {object} helper.AppError "Invalid input"
 // @Failure 404 {object} helper.AppError "Not found"
+// @Failure 412 {object} helper.AppError "The player team was modified since it was read"
+// @Failure 428 {object} helper.AppError "If-Match header missing"
 // @Failure 500 {object} helper.AppError "Internal server error"
 // @Router /admin/player-teams/{id} [delete]
 // @Security BearerAuth
@@ -380,6 +401,10 @@ func (h *PlayerTeamHandler) DeletePlayerTeam(c *gin.Context) {
 
 	err = h.PlayerTeamDomainService.DeletePlayerTeam(ctx, id)
 	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("player team"))
+			return
+		}
 		if errors.Is(err, constants.ErrRecordNotFound) {
 			helper.WriteErrorResponse(c, helper.NewNotFoundError(msgPlayerTeamRelationship))
 		} else {
@@ -0,0 +1,346 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	httpMapper "github.com/EdwinRincon/browersfc-api/adapter/http"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/helper"
+	domainservice "github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+type PredictionHandler struct {
+	PredictionDomainService *domainservice.PredictionDomainService
+	PredictionMapper        *httpMapper.PredictionHTTPMapper
+}
+
+func NewPredictionHandler(predictionDomainService *domainservice.PredictionDomainService) *PredictionHandler {
+	return &PredictionHandler{
+		PredictionDomainService: predictionDomainService,
+		PredictionMapper:        httpMapper.NewPredictionHTTPMapper(),
+	}
+}
+
+// CreatePrediction godoc
+// @Summary Predict the score of a match
+// @Description Predictions are accepted until kickoff, one per user and match.
+// @Tags predictions
+// @ID createPrediction
+// @Accept json
+// @Produce json
+// @Param id path int true "Match ID"
+// @Param prediction body dto.CreatePredictionRequest true "Predicted score"
+// @Success 201 {object} dto.PredictionResponse "Created"
+// @Failure 400 {object} helper.AppError "Invalid input or predictions closed"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 404 {object} helper.AppError "Match not found"
+// @Failure 409 {object} helper.AppError "Prediction already exists"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /matches/{id}/predictions [post]
+// @Security BearerAuth
+func (h *PredictionHandler) CreatePrediction(c *gin.Context) {
+	matchID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidMatchID))
+		return
+	}
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication required"))
+		return
+	}
+
+	var createRequest dto.CreatePredictionRequest
+	if err = c.ShouldBindJSON(&createRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidPredictionData))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	prediction := h.PredictionMapper.DTOToDomain(&createRequest, matchID, userID)
+
+	createdPrediction, err := h.PredictionDomainService.CreatePrediction(ctx, prediction)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrMatchNotFound):
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("match"))
+		case errors.Is(err, constants.ErrPredictionsClosed):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("match", "Predictions are closed for this match"))
+		case errors.Is(err, constants.ErrRecordAlreadyExists):
+			helper.WriteErrorResponse(c, helper.NewConflictError("prediction", "You already predicted this match"))
+		case errors.Is(err, constants.ErrInvalidData):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("body", constants.MsgInvalidPredictionData))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusCreated, h.PredictionMapper.DomainToDTO(createdPrediction), "Prediction created successfully")
+}
+
+// GetMyPredictions godoc
+// @Summary Get the current user's predictions
+// @Tags predictions
+// @ID getMyPredictions
+// @Produce json
+// @Param page query int false "Page number" default(0)
+// @Param pageSize query int false "Page size" default(10)
+// @Param sort query string false "Sort field" default(kickoff)
+// @Param order query string false "Sort order" Enums(asc, desc) default(desc)
+// @Success 200 {object} helper.AppSuccess{data=helper.PaginatedResponse{items=[]dto.PredictionResponse, totalCount=int}}
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /predictions [get]
+// @Security BearerAuth
+func (h *PredictionHandler) GetMyPredictions(c *gin.Context) {
+	userID := c.GetString("user_id")
+	if userID == "" {
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication required"))
+		return
+	}
+
+	sort := c.DefaultQuery("sort", "kickoff")
+	order := c.DefaultQuery("order", "desc")
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "0"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+
+	if page < 0 {
+		page = 0
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 10
+	}
+	if order != "asc" && order != "desc" {
+		order = "desc"
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	predictions, total, err := h.PredictionDomainService.GetPaginatedPredictionsByUser(ctx, userID, sort, order, page, pageSize)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	response := helper.PaginatedResponse{
+		Items:      h.PredictionMapper.DomainListToDTO(predictions),
+		TotalCount: total,
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, response, "Predictions retrieved successfully")
+}
+
+// GetPredictionByID godoc
+// @Summary Get one of the current user's predictions
+// @Tags predictions
+// @ID getPredictionByID
+// @Produce json
+// @Param id path int true "Prediction ID"
+// @Success 200 {object} dto.PredictionResponse "Success"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 404 {object} helper.AppError "Prediction not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /predictions/{id} [get]
+// @Security BearerAuth
+func (h *PredictionHandler) GetPredictionByID(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidPredictionID))
+		return
+	}
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication required"))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	prediction, err := h.PredictionDomainService.GetOwnPredictionByID(ctx, id, userID)
+	if err != nil {
+		if errors.Is(err, constants.ErrPredictionNotFound) || errors.Is(err, constants.ErrInvalidID) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("prediction"))
+		} else {
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, h.PredictionMapper.DomainToDTO(prediction), "Prediction found successfully")
+}
+
+// UpdatePrediction godoc
+// @Summary Change a prediction before kickoff
+// @Tags predictions
+// @ID updatePrediction
+// @Accept json
+// @Produce json
+// @Param id path int true "Prediction ID"
+// @Param prediction body dto.UpdatePredictionRequest true "Predicted score"
+// @Success 200 {object} dto.PredictionResponse "Updated"
+// @Failure 400 {object} helper.AppError "Invalid input or predictions closed"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 404 {object} helper.AppError "Prediction not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /predictions/{id} [put]
+// @Security BearerAuth
+func (h *PredictionHandler) UpdatePrediction(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidPredictionID))
+		return
+	}
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication required"))
+		return
+	}
+
+	var updateRequest dto.UpdatePredictionRequest
+	if err = c.ShouldBindJSON(&updateRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidPredictionData))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	updates := h.PredictionMapper.UpdateDTOToDomain(&updateRequest)
+
+	updatedPrediction, err := h.PredictionDomainService.UpdatePrediction(ctx, id, userID, updates)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrPredictionNotFound), errors.Is(err, constants.ErrInvalidID):
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("prediction"))
+		case errors.Is(err, constants.ErrPredictionsClosed):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("match", "Predictions are closed for this match"))
+		case errors.Is(err, constants.ErrInvalidData):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("body", constants.MsgInvalidPredictionData))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, h.PredictionMapper.DomainToDTO(updatedPrediction), "Prediction updated successfully")
+}
+
+// DeletePrediction godoc
+// @Summary Withdraw a prediction before kickoff
+// @Tags predictions
+// @ID deletePrediction
+// @Param id path int true "Prediction ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} helper.AppError "Invalid input or predictions closed"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 404 {object} helper.AppError "Prediction not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /predictions/{id} [delete]
+// @Security BearerAuth
+func (h *PredictionHandler) DeletePrediction(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidPredictionID))
+		return
+	}
+
+	userID := c.GetString("user_id")
+	if userID == "" {
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication required"))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	err = h.PredictionDomainService.DeletePrediction(ctx, id, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrPredictionNotFound), errors.Is(err, constants.ErrInvalidID):
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("prediction"))
+		case errors.Is(err, constants.ErrPredictionsClosed):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("match", "Predictions are closed for this match"))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetSeasonLeaderboard godoc
+// @Summary Get the season prediction leaderboard
+// @Description Ranks fans by the points earned with their predictions for matches of the season.
+// @Tags predictions
+// @ID getSeasonPredictionLeaderboard
+// @Produce json
+// @Param id path int true "Season ID"
+// @Param page query int false "Page number" default(0)
+// @Param pageSize query int false "Page size" default(10)
+// @Success 200 {object} helper.AppSuccess{data=helper.PaginatedResponse{items=[]dto.PredictionLeaderboardEntry, totalCount=int}}
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 404 {object} helper.AppError "Season not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /seasons/{id}/predictions/leaderboard [get]
+// @Security BearerAuth
+func (h *PredictionHandler) GetSeasonLeaderboard(c *gin.Context) {
+	seasonID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", errInvalidSeasonID))
+		return
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "0"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+
+	if page < 0 {
+		page = 0
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 10
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	entries, total, err := h.PredictionDomainService.GetSeasonLeaderboard(ctx, seasonID, page, pageSize)
+	if err != nil {
+		if errors.Is(err, constants.ErrSeasonNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("season"))
+		} else {
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	response := helper.PaginatedResponse{
+		Items:      h.PredictionMapper.LeaderboardToDTO(entries),
+		TotalCount: total,
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, response, "Prediction leaderboard retrieved successfully")
+}
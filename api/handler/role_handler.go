@@ -38,7 +38,10 @@ func NewRoleHandler(roleDomainService *domainservice.RoleDomainService) *RoleHan
 // @Tags         roles
 // @ID           getRoleByID
 // @Param        id   path      int  true  "Role ID"
+// @Param        If-None-Match header string false "ETag from a previous response"
 // @Success      200  {object}  dto.RoleResponse
+// @Success      304 "Not Modified"
+// @Header       200 {string} ETag "Version of the role"
 // @Failure      400  {object}  helper.AppError "Invalid input"
 // @Failure      404  {object}  helper.AppError "Role not found"
 // @Router       /admin/roles/{id} [get]
@@ -61,6 +64,10 @@ func (h *RoleHandler) GetRoleByID(c *gin.Context) {
 		return
 	}
 
+	if helper.WriteNotModified(c, domainRole.Version) {
+		return
+	}
+
 	// Convert domain role directly to response DTO
 	response := h.RoleMapper.DomainToDTO(domainRole)
 	helper.WriteSuccessResponse(c, http.StatusOK, response, "Role retrieved successfully")
@@ -74,9 +81,11 @@ func (h *RoleHandler) GetRoleByID(c *gin.Context) {
 // @Accept       json
 // @Produce      json
 // @Param        role  body      dto.CreateRoleRequest  true  "Role data"
+// @Param        Idempotency-Key header string false "Key that makes retries of the request safe"
 // @Success      201   {object}  dto.RoleResponse
 // @Failure      400   {object}  helper.AppError "Invalid input"
 // @Failure      409   {object}  helper.AppError "Role already exists"
+// @Failure      422   {object}  helper.AppError "Idempotency-Key already used for a different request"
 // @Router       /admin/roles [post]
 // @Security     BearerAuth
 func (h *RoleHandler) CreateRole(c *gin.Context) {
@@ -117,10 +126,14 @@ func (h *RoleHandler) CreateRole(c *gin.Context) {
 // @Produce      json
 // @Param        id    path      int  true  "Role ID"
 // @Param        role  body      dto.UpdateRoleRequest  true  "Updated role data"
+// @Param        If-Match header string true "ETag of the role being changed"
 // @Success      200   {object}  dto.RoleResponse
-// @Failure      400   {object}  helper.AppError "Invalid input"
+// @Header       200 {string} ETag "New version of the role"
+// @Failure      400   {object}  helper.AppError "Invalid input, or a system role renamed"
 // @Failure      404   {object}  helper.AppError "Role not found"
 // @Failure      409   {object}  helper.AppError "Role already exists"
+// @Failure      412   {object}  helper.AppError "The role was modified since it was read"
+// @Failure      428   {object}  helper.AppError "If-Match header missing"
 // @Router       /admin/roles/{id} [put]
 // @Security     BearerAuth
 func (h *RoleHandler) UpdateRole(c *gin.Context) {
@@ -147,17 +160,24 @@ func (h *RoleHandler) UpdateRole(c *gin.Context) {
 
 	updatedRole, err := h.RoleDomainService.UpdateRole(ctx, updateDomain)
 	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("role"))
+			return
+		}
 		switch err {
 		case constants.ErrRecordNotFound:
 			helper.WriteErrorResponse(c, helper.NewNotFoundError("role"))
 		case constants.ErrRecordAlreadyExists:
 			helper.WriteErrorResponse(c, helper.NewConflictError("role", "A role with these details already exists"))
+		case constants.ErrCannotRenameSystemRole:
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("name", "System roles cannot be renamed, and no other role can take their names"))
 		default:
 			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
 		}
 		return
 	}
 
+	helper.SetETag(c, updatedRole.Version)
 	// Convert domain role directly to response DTO
 	response := h.RoleMapper.DomainToDTO(updatedRole)
 	helper.WriteSuccessResponse(c, http.StatusOK, response, "Role updated successfully")
@@ -169,8 +189,12 @@ func (h *RoleHandler) UpdateRole(c *gin.Context) {
 // @Tags         roles
 // @ID           deleteRole
 // @Param        id   path      int  true  "Role ID"
+// @Param        If-Match header string true "ETag of the role being changed"
 // @Success      204 "No Content"
 // @Failure      400  {object}  helper.AppError "Invalid input"
+// @Failure      409  {object}  helper.AppError "Role is still assigned to users"
+// @Failure      412  {object}  helper.AppError "The role was modified since it was read"
+// @Failure      428  {object}  helper.AppError "If-Match header missing"
 // @Router       /admin/roles/{id} [delete]
 // @Security     BearerAuth
 func (h *RoleHandler) DeleteRole(c *gin.Context) {
@@ -187,10 +211,16 @@ func (h *RoleHandler) DeleteRole(c *gin.Context) {
 
 	err = h.RoleDomainService.DeleteRole(ctx, id)
 	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("role"))
+			return
+		}
 		if err == constants.ErrRecordNotFound {
 			helper.WriteErrorResponse(c, helper.NewNotFoundError("role"))
 		} else if errors.Is(err, constants.ErrCannotDeleteSystemRole) {
 			helper.WriteErrorResponse(c, helper.NewBadRequestError("role", "Cannot delete system role"))
+		} else if errors.Is(err, constants.ErrRecordInUse) {
+			helper.WriteErrorResponse(c, helper.NewConflictError("role", "The role is still assigned to users"))
 		} else {
 			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
 		}
@@ -255,3 +285,113 @@ func (h *RoleHandler) GetPaginatedRoles(c *gin.Context) {
 
 	helper.WriteSuccessResponse(c, http.StatusOK, response, "Roles retrieved successfully")
 }
+
+// GetRolePermissions godoc
+// @Summary      Get the permissions of a role
+// @Description  Returns the permissions granted to a role. The admin role always has every permission.
+// @Tags         roles
+// @ID           getRolePermissions
+// @Produce      json
+// @Param        id   path      int  true  "Role ID"
+// @Success      200  {object}  dto.RolePermissionsResponse
+// @Header       200 {string} ETag "Version of the role"
+// @Failure      400  {object}  helper.AppError "Invalid input"
+// @Failure      404  {object}  helper.AppError "Role not found"
+// @Failure      500  {object}  helper.AppError "Internal server error"
+// @Router       /admin/roles/{id}/permissions [get]
+// @Security     BearerAuth
+func (h *RoleHandler) GetRolePermissions(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", msgInvalidRoleID))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	domainRole, err := h.RoleDomainService.GetRoleByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, constants.ErrRecordNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("role"))
+		} else {
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	helper.SetETag(c, domainRole.Version)
+	helper.WriteSuccessResponse(c, http.StatusOK, h.RoleMapper.DomainToPermissionsDTO(domainRole), "Role permissions retrieved successfully")
+}
+
+// UpdateRolePermissions godoc
+// @Summary      Replace the permissions of a role
+// @Description  Replaces the permissions granted to a role. Changes apply to tokens issued after the update. The admin role cannot be modified.
+// @Tags         roles
+// @ID           updateRolePermissions
+// @Accept       json
+// @Produce      json
+// @Param        id           path      int                               true  "Role ID"
+// @Param        permissions  body      dto.UpdateRolePermissionsRequest  true  "Granted permissions"
+// @Param        If-Match header string true "ETag of the role being changed"
+// @Success      200  {object}  dto.RolePermissionsResponse
+// @Header       200 {string} ETag "New version of the role"
+// @Failure      400  {object}  helper.AppError "Invalid input or unknown permission"
+// @Failure      403  {object}  helper.AppError "Role permissions cannot be modified"
+// @Failure      404  {object}  helper.AppError "Role not found"
+// @Failure      412  {object}  helper.AppError "The role was modified since it was read"
+// @Failure      428  {object}  helper.AppError "If-Match header missing"
+// @Failure      500  {object}  helper.AppError "Internal server error"
+// @Router       /admin/roles/{id}/permissions [put]
+// @Security     BearerAuth
+func (h *RoleHandler) UpdateRolePermissions(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", msgInvalidRoleID))
+		return
+	}
+
+	var permissionsDTO dto.UpdateRolePermissionsRequest
+	if err = c.ShouldBindJSON(&permissionsDTO); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidRoleData))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	domainRole, err := h.RoleDomainService.SetRolePermissions(ctx, id, permissionsDTO.Permissions)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrRecordNotFound):
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("role"))
+		case errors.Is(err, constants.ErrInvalidPermission):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("permissions", "Unknown permission"))
+		case errors.Is(err, constants.ErrFixedRolePermissions):
+			helper.WriteErrorResponse(c, helper.NewForbiddenError("The admin role always has every permission"))
+		case errors.Is(err, constants.ErrVersionMismatch):
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("role"))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	helper.SetETag(c, domainRole.Version)
+	helper.WriteSuccessResponse(c, http.StatusOK, h.RoleMapper.DomainToPermissionsDTO(domainRole), "Role permissions updated successfully")
+}
+
+// GetPermissions godoc
+// @Summary      List available permissions
+// @Description  Returns every permission that can be granted to a role
+// @Tags         roles
+// @ID           getPermissions
+// @Produce      json
+// @Success      200  {array}   string
+// @Router       /admin/permissions [get]
+// @Security     BearerAuth
+func (h *RoleHandler) GetPermissions(c *gin.Context) {
+	helper.WriteSuccessResponse(c, http.StatusOK, domain.AllPermissions(), "Permissions retrieved successfully")
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strconv"
 	"time"
@@ -36,9 +37,11 @@ func NewSeasonHandler(seasonDomainService *domainService.SeasonDomainService) *S
 // @Accept       json
 // @Produce      json
 // @Param        season  body      dto.CreateSeasonRequest  true  "Season data"
+// @Param        Idempotency-Key header string false "Key that makes retries of the request safe"
 // @Success      201     {object}  dto.SeasonResponse "Created"
 // @Failure      400     {object}  helper.AppError "Invalid input"
 // @Failure      409     {object}  helper.AppError "Conflict (e.g., season already exists)"
+// @Failure      422     {object}  helper.AppError "Idempotency-Key already used for a different request"
 // @Failure      500     {object}  helper.AppError "Internal server error"
 // @Router       /admin/seasons [post]
 // @Security     BearerAuth
@@ -79,7 +82,10 @@ func (h *SeasonHandler) CreateSeason(c *gin.Context) {
 // @Tags         seasons
 // @ID           getSeasonByID
 // @Param        id   path      int  true  "Season ID"
+// @Param        If-None-Match header string false "ETag from a previous response"
 // @Success      200  {object}  dto.SeasonResponse "Success"
+// @Success      304 "Not Modified"
+// @Header       200 {string} ETag "Version of the season"
 // @Failure      400  {object}  helper.AppError "Invalid input"
 // @Failure      404  {object}  helper.AppError "Season not found"
 // @Failure      500  {object}  helper.AppError "Internal server error"
@@ -107,6 +113,10 @@ func (h *SeasonHandler) GetSeasonByID(c *gin.Context) {
 		return
 	}
 
+	if helper.WriteNotModified(c, domainSeason.Version) {
+		return
+	}
+
 	seasonResponse := h.SeasonMapper.DomainToDTO(domainSeason)
 	helper.WriteSuccessResponse(c, http.StatusOK, seasonResponse, "Season found successfully")
 }
@@ -204,10 +214,14 @@ func (h *SeasonHandler) GetPaginatedSeasons(c *gin.Context) {
 // @Produce      json
 // @Param        id      path      int                   true  "Season ID"
 // @Param        season  body      dto.UpdateSeasonRequest true  "Updated season data"
+// @Param        If-Match header string true "ETag of the season being changed"
 // @Success      200     {object}  dto.SeasonResponse "Updated"
+// @Header       200 {string} ETag "New version of the season"
 // @Failure      400     {object}  helper.AppError "Invalid input"
 // @Failure      404     {object}  helper.AppError "Season not found"
 // @Failure      409     {object}  helper.AppError "Conflict (e.g., year already exists)"
+// @Failure      412     {object}  helper.AppError "The season was modified since it was read"
+// @Failure      428     {object}  helper.AppError "If-Match header missing"
 // @Failure      500     {object}  helper.AppError "Internal server error"
 // @Router       /admin/seasons/{id} [put]
 // @Security     BearerAuth
@@ -255,6 +269,10 @@ func (h *SeasonHandler) UpdateSeason(c *gin.Context) {
 	// Update season
 	err = h.SeasonDomainService.UpdateSeason(ctx, id, updatedSeason)
 	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("season"))
+			return
+		}
 		switch err {
 		case constants.ErrRecordNotFound:
 			helper.WriteErrorResponse(c, helper.NewNotFoundError("season"))
@@ -273,6 +291,7 @@ func (h *SeasonHandler) UpdateSeason(c *gin.Context) {
 		return
 	}
 
+	helper.SetETag(c, updatedSeasonResponse.Version)
 	seasonResponse := h.SeasonMapper.DomainToDTO(updatedSeasonResponse)
 	helper.WriteSuccessResponse(c, http.StatusOK, seasonResponse, "Season updated successfully")
 }
@@ -283,9 +302,13 @@ func (h *SeasonHandler) UpdateSeason(c *gin.Context) {
 // @Tags         seasons
 // @ID           setCurrentSeason
 // @Param        id   path      int  true  "Season ID"
+// @Param        If-Match header string true "ETag of the season being changed"
 // @Success      200  {object}  map[string]interface{} "Success message"
+// @Header       200 {string} ETag "New version of the season"
 // @Failure      400  {object}  helper.AppError "Invalid input"
 // @Failure      404  {object}  helper.AppError "Season not found"
+// @Failure      412  {object}  helper.AppError "The season was modified since it was read"
+// @Failure      428  {object}  helper.AppError "If-Match header missing"
 // @Failure      500  {object}  helper.AppError "Internal server error"
 // @Router       /admin/seasons/{id}/set-current [put]
 // @Security     BearerAuth
@@ -302,9 +325,11 @@ func (h *SeasonHandler) SetCurrentSeason(c *gin.Context) {
 	defer cancel()
 
 	// Use domain service (hexagonal architecture)
-	err = h.SeasonDomainService.SetCurrentSeason(ctx, id)
+	currentSeason, err := h.SeasonDomainService.SetCurrentSeason(ctx, id)
 	if err != nil {
-		if err == constants.ErrRecordNotFound {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("season"))
+		} else if err == constants.ErrRecordNotFound {
 			helper.WriteErrorResponse(c, helper.NewNotFoundError("season"))
 		} else {
 			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
@@ -312,6 +337,7 @@ func (h *SeasonHandler) SetCurrentSeason(c *gin.Context) {
 		return
 	}
 
+	helper.SetETag(c, currentSeason.Version)
 	response := map[string]interface{}{
 		"message": "Season set as current successfully",
 		"id":      id,
@@ -326,9 +352,13 @@ func (h *SeasonHandler) SetCurrentSeason(c *gin.Context) {
 // @Tags         seasons
 // @ID           deleteSeason
 // @Param        id   path      int  true  "Season ID"
+// @Param        If-Match header string true "ETag of the season being changed"
 // @Success      204  "No Content"
 // @Failure      400  {object}  helper.AppError "Invalid input"
 // @Failure      404  {object}  helper.AppError "Season not found"
+// @Failure      409  {object}  helper.AppError "Season still has matches, articles or statistics"
+// @Failure      412  {object}  helper.AppError "The season was modified since it was read"
+// @Failure      428  {object}  helper.AppError "If-Match header missing"
 // @Failure      500  {object}  helper.AppError "Internal server error"
 // @Router       /admin/seasons/{id} [delete]
 // @Security     BearerAuth
@@ -347,8 +377,14 @@ func (h *SeasonHandler) DeleteSeason(c *gin.Context) {
 	// Use domain service (hexagonal architecture)
 	err = h.SeasonDomainService.DeleteSeason(ctx, id)
 	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("season"))
+			return
+		}
 		if err == constants.ErrRecordNotFound {
 			helper.WriteErrorResponse(c, helper.NewNotFoundError("season"))
+		} else if errors.Is(err, constants.ErrRecordInUse) {
+			helper.WriteErrorResponse(c, helper.NewConflictError("season", "The season still has matches, articles or statistics"))
 		} else {
 			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
 		}
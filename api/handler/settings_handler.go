@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	httpMapper "github.com/EdwinRincon/browersfc-api/adapter/http"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/helper"
+	domainservice "github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/EdwinRincon/browersfc-api/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type SettingsHandler struct {
+	SettingsDomainService *domainservice.SettingsDomainService
+	SettingsMapper        *httpMapper.SettingsHTTPMapper
+}
+
+func NewSettingsHandler(settingsDomainService *domainservice.SettingsDomainService) *SettingsHandler {
+	return &SettingsHandler{
+		SettingsDomainService: settingsDomainService,
+		SettingsMapper:        httpMapper.NewSettingsHTTPMapper(),
+	}
+}
+
+// GetEmailDomainSettings godoc
+// @Summary Get the allowed and blocked email domains
+// @Description New accounts, from sign-ups, admin creation or invitations, cannot use a blocked domain. When the allowlist is empty, every domain that is not blocked is allowed.
+// @Tags settings
+// @ID getEmailDomainSettings
+// @Produce json
+// @Success 200 {object} dto.EmailDomainSettingsResponse "Email domains retrieved successfully"
+// @Header 200 {string} ETag "Version of the email domain settings"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 403 {object} helper.AppError "Forbidden"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/settings/email-domains [get]
+// @Security BearerAuth
+func (h *SettingsHandler) GetEmailDomainSettings(c *gin.Context) {
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	policy, err := h.SettingsDomainService.ReloadEmailDomainPolicy(ctx)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	helper.SetETag(c, policy.Version())
+	helper.WriteSuccessResponse(c, http.StatusOK, h.SettingsMapper.EmailDomainsDomainToDTO(policy), "Email domains retrieved successfully")
+}
+
+// UpdateEmailDomainSettings godoc
+// @Summary Replace the allowed and blocked email domains
+// @Description Replaces both lists. Domains are compared in lower case and must match the part of the email after the "@" exactly; subdomains are not included.
+// @Tags settings
+// @ID updateEmailDomainSettings
+// @Accept json
+// @Produce json
+// @Param settings body dto.EmailDomainSettingsRequest true "Allowed and blocked domains"
+// @Param If-Match header string true "ETag of the email domain settings being changed"
+// @Success 200 {object} dto.EmailDomainSettingsResponse "Email domains updated successfully"
+// @Header 200 {string} ETag "New version of the email domain settings"
+// @Failure 400 {object} helper.AppError "Invalid domains"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 403 {object} helper.AppError "Forbidden"
+// @Failure 412 {object} helper.AppError "The email domains were modified since they were read"
+// @Failure 428 {object} helper.AppError "If-Match header missing"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/settings/email-domains [put]
+// @Security BearerAuth
+func (h *SettingsHandler) UpdateEmailDomainSettings(c *gin.Context) {
+	var request dto.EmailDomainSettingsRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidEmailDomains))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	userID := c.GetString("user_id")
+	policy, err := h.SettingsDomainService.UpdateEmailDomainPolicy(ctx, h.SettingsMapper.EmailDomainsDTOToDomain(&request), userID)
+	if err != nil {
+		if errors.Is(err, constants.ErrInvalidData) {
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("body", constants.MsgInvalidEmailDomains))
+			return
+		}
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("email domain settings"))
+			return
+		}
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	logger.Info(c, "email domains updated", "updated_by", userID, "allowed", len(policy.Allowed), "blocked", len(policy.Blocked))
+	helper.SetETag(c, policy.Version())
+	helper.WriteSuccessResponse(c, http.StatusOK, h.SettingsMapper.EmailDomainsDomainToDTO(policy), "Email domains updated successfully")
+}
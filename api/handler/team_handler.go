@@ -34,9 +34,11 @@ func NewTeamHandler(teamDomainService *domainservice.TeamDomainService) *TeamHan
 // @Accept json
 // @Produce json
 // @Param team body dto.CreateTeamRequest true "Team data"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
 // @Success 201 {object} dto.TeamShort "Created"
 // @Failure 400 {object} helper.AppError "Invalid input"
 // @Failure 409 {object} helper.AppError "Conflict (e.g., team name exists)"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
 // @Failure 500 {object} helper.AppError "Internal server error"
 // @Router /admin/teams [post]
 // @Security BearerAuth
@@ -60,6 +62,9 @@ func (h *TeamHandler) CreateTeam(c *gin.Context) {
 		case errors.Is(err, constants.ErrRecordAlreadyExists):
 			helper.WriteErrorResponse(c, helper.NewConflictError("team", "A team with this name already exists"))
 			return
+		case errors.Is(err, constants.ErrVenueNotFound):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("home_venue_id", "Home venue does not exist"))
+			return
 		default:
 			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
 			return
@@ -75,7 +80,10 @@ func (h *TeamHandler) CreateTeam(c *gin.Context) {
 // @Tags teams
 // @ID getTeamByID
 // @Param id path int true "Team ID"
+// @Param If-None-Match header string false "ETag from a previous response"
 // @Success 200 {object} model.Team "Success"
+// @Success 304 "Not Modified"
+// @Header 200 {string} ETag "Version of the team"
 // @Failure 400 {object} helper.AppError "Invalid input"
 // @Failure 404 {object} helper.AppError "Team not found"
 // @Failure 500 {object} helper.AppError "Internal server error"
@@ -102,6 +110,10 @@ func (h *TeamHandler) GetTeamByID(c *gin.Context) {
 		return
 	}
 
+	if helper.WriteNotModified(c, team.Version) {
+		return
+	}
+
 	teamResponse := h.TeamMapper.DomainToDTO(team)
 	helper.WriteSuccessResponse(c, http.StatusOK, teamResponse, "Team found successfully")
 }
@@ -159,10 +171,14 @@ func (h *TeamHandler) GetPaginatedTeams(c *gin.Context) {
 // @Produce json
 // @Param id path int true "Team ID"
 // @Param team body dto.UpdateTeamRequest true "Updated team data"
+// @Param If-Match header string true "ETag of the team being changed"
 // @Success 200 {object} dto.TeamResponse "Success"
+// @Header 200 {string} ETag "New version of the team"
 // @Failure 400 {object} helper.AppError "Invalid input"
 // @Failure 404 {object} helper.AppError "Team not found"
 // @Failure 409 {object} helper.AppError "Conflict (e.g., team name exists)"
+// @Failure 412 {object} helper.AppError "The team was modified since it was read"
+// @Failure 428 {object} helper.AppError "If-Match header missing"
 // @Failure 500 {object} helper.AppError "Internal server error"
 // @Router /admin/teams/{id} [put]
 // @Security BearerAuth
@@ -189,16 +205,23 @@ func (h *TeamHandler) UpdateTeam(c *gin.Context) {
 
 	updatedTeam, err := h.TeamDomainService.UpdateTeam(ctx, id, updates)
 	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("team"))
+			return
+		}
 		if errors.Is(err, constants.ErrRecordNotFound) {
 			helper.WriteErrorResponse(c, helper.NewNotFoundError("team"))
 		} else if errors.Is(err, constants.ErrRecordAlreadyExists) {
 			helper.WriteErrorResponse(c, helper.NewConflictError("team", "A team with this name already exists"))
+		} else if errors.Is(err, constants.ErrVenueNotFound) {
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("home_venue_id", "Home venue does not exist"))
 		} else {
 			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
 		}
 		return
 	}
 
+	helper.SetETag(c, updatedTeam.Version)
 	teamResponse := h.TeamMapper.DomainToDTO(updatedTeam)
 	helper.WriteSuccessResponse(c, http.StatusOK, teamResponse, "Team updated successfully")
 }
@@ -208,9 +231,12 @@ func (h *TeamHandler) UpdateTeam(c *gin.Context) {
 // @Tags teams
 // @ID deleteTeam
 // @Param id path int true "Team ID"
+// @Param If-Match header string true "ETag of the team being changed"
 // @Success 204 "No Content"
 // @Failure 400 {object} helper.AppError "Invalid input"
 // @Failure 404 {object} helper.AppError "Team not found"
+// @Failure 412 {object} helper.AppError "The team was modified since it was read"
+// @Failure 428 {object} helper.AppError "If-Match header missing"
 // @Failure 500 {object} helper.AppError "Internal server error"
 // @Router /admin/teams/{id} [delete]
 // @Security BearerAuth
@@ -227,6 +253,10 @@ func (h *TeamHandler) DeleteTeam(c *gin.Context) {
 	defer cancel()
 	err = h.TeamDomainService.DeleteTeam(ctx, id)
 	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("team"))
+			return
+		}
 		if errors.Is(err, constants.ErrRecordNotFound) {
 			helper.WriteErrorResponse(c, helper.NewNotFoundError("team"))
 		} else {
@@ -35,10 +35,12 @@ func NewTeamStatsHandler(teamStatsDomainService *domainservice.TeamStatsDomainSe
 // @Accept       json
 // @Produce      json
 // @Param        teamStats  body      dto.CreateTeamStatsRequest  true  "Team stats data"
+// @Param        Idempotency-Key header string false "Key that makes retries of the request safe"
 // @Success      201        {object}  dto.TeamStatsShort "Created"
 // @Failure      400        {object}  helper.AppError "Invalid input"
 // @Failure      404        {object}  helper.AppError "Team or season not found"
 // @Failure      409        {object}  helper.AppError "Conflict (e.g., stats already exist for this team/season)"
+// @Failure      422        {object}  helper.AppError "Idempotency-Key already used for a different request"
 // @Failure      500        {object}  helper.AppError "Internal server error"
 // @Router       /admin/team-stats [post]
 // @Security     BearerAuth
@@ -85,7 +87,10 @@ func (h *TeamStatsHandler) CreateTeamStats(c *gin.Context) {
 // @Tags         team-stats
 // @ID           getTeamStatsByID
 // @Param        id   path      int  true  "Team Stats ID"
+// @Param        If-None-Match header string false "ETag from a previous response"
 // @Success      200  {object}  dto.TeamStatsResponse "Success"
+// @Success      304 "Not Modified"
+// @Header       200 {string} ETag "Version of the team stats"
 // @Failure      400  {object}  helper.AppError "Invalid input"
 // @Failure      404  {object}  helper.AppError "Team stats not found"
 // @Failure      500  {object}  helper.AppError "Internal server error"
@@ -113,6 +118,10 @@ func (h *TeamStatsHandler) GetTeamStatsByID(c *gin.Context) {
 		return
 	}
 
+	if helper.WriteNotModified(c, teamStats.Version) {
+		return
+	}
+
 	response := h.TeamStatsMapper.DomainToDTO(teamStats)
 	helper.WriteSuccessResponse(c, http.StatusOK, response, "Team stats retrieved successfully")
 }
@@ -251,10 +260,14 @@ func (h *TeamStatsHandler) GetPaginatedTeamStats(c *gin.Context) {
 // @Produce      json
 // @Param        id         path      int                        true  "Team Stats ID"
 // @Param        teamStats  body      dto.UpdateTeamStatsRequest  true  "Updated team stats data"
+// @Param        If-Match header string true "ETag of the team stats being changed"
 // @Success      200        {object}  dto.TeamStatsResponse "Success"
+// @Header       200 {string} ETag "New version of the team stats"
 // @Failure      400        {object}  helper.AppError "Invalid input"
 // @Failure      404        {object}  helper.AppError "Team stats, team, or season not found"
 // @Failure      409        {object}  helper.AppError "Conflict (e.g., duplicate team/season combination)"
+// @Failure      412        {object}  helper.AppError "The team stats was modified since it was read"
+// @Failure      428        {object}  helper.AppError "If-Match header missing"
 // @Failure      500        {object}  helper.AppError "Internal server error"
 // @Router       /admin/team-stats/{id} [put]
 // @Security     BearerAuth
@@ -292,6 +305,10 @@ func (h *TeamStatsHandler) UpdateTeamStats(c *gin.Context) {
 
 	updatedResult, err := h.TeamStatsDomainService.UpdateTeamStats(ctx, id, updatedTeamStats)
 	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("team stats"))
+			return
+		}
 		switch {
 		case errors.Is(err, constants.ErrRecordNotFound):
 			helper.WriteErrorResponse(c, helper.NewNotFoundError("team_stats"))
@@ -311,6 +328,7 @@ func (h *TeamStatsHandler) UpdateTeamStats(c *gin.Context) {
 		}
 	}
 
+	helper.SetETag(c, updatedResult.Version)
 	response := h.TeamStatsMapper.DomainToDTO(updatedResult)
 	helper.WriteSuccessResponse(c, http.StatusOK, response, "Team stats updated successfully")
 }
@@ -321,8 +339,11 @@ func (h *TeamStatsHandler) UpdateTeamStats(c *gin.Context) {
 // @Tags         team-stats
 // @ID           deleteTeamStats
 // @Param        id   path      int  true  "Team Stats ID"
+// @Param        If-Match header string true "ETag of the team stats being changed"
 // @Success      204 "No Content"
 // @Failure      400  {object}  helper.AppError "Invalid input"
+// @Failure      412  {object}  helper.AppError "The team stats was modified since it was read"
+// @Failure      428  {object}  helper.AppError "If-Match header missing"
 // @Failure      500  {object}  helper.AppError "Internal server error"
 // @Router       /admin/team-stats/{id} [delete]
 // @Security     BearerAuth
@@ -339,6 +360,10 @@ func (h *TeamStatsHandler) DeleteTeamStats(c *gin.Context) {
 	defer cancel()
 
 	err = h.TeamStatsDomainService.DeleteTeamStats(ctx, id)
+	if errors.Is(err, constants.ErrVersionMismatch) {
+		helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("team stats"))
+		return
+	}
 	if err != nil && !errors.Is(err, constants.ErrRecordNotFound) {
 		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
 		return
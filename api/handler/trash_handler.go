@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	httpMapper "github.com/EdwinRincon/browersfc-api/adapter/http"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/helper"
+	domainservice "github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+type TrashHandler struct {
+	TrashDomainService *domainservice.TrashDomainService
+	TrashMapper        *httpMapper.TrashHTTPMapper
+}
+
+func NewTrashHandler(trashDomainService *domainservice.TrashDomainService) *TrashHandler {
+	return &TrashHandler{
+		TrashDomainService: trashDomainService,
+		TrashMapper:        httpMapper.NewTrashHTTPMapper(),
+	}
+}
+
+// GetPaginatedTrash godoc
+// @Summary Get the trash
+// @Description Lists the deleted matches, players, teams and articles, most recently deleted first, with the time each one will be purged.
+// @Tags trash
+// @ID getPaginatedTrash
+// @Produce json
+// @Param entity query string false "Entity type" Enums(article, match, player, team)
+// @Param page query int false "Page number" default(0)
+// @Param pageSize query int false "Page size" default(10)
+// @Success 200 {object} helper.AppSuccess{data=helper.PaginatedResponse{items=[]dto.TrashItemResponse, totalCount=int}}
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 403 {object} helper.AppError "Forbidden"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/trash [get]
+// @Security BearerAuth
+func (h *TrashHandler) GetPaginatedTrash(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "0"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+
+	if page < 0 {
+		page = 0
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	filter := domain.TrashFilter{EntityType: c.Query("entity")}
+	items, total, err := h.TrashDomainService.GetPaginatedTrash(ctx, filter, page, pageSize)
+	if err != nil {
+		if errors.Is(err, constants.ErrInvalidData) {
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("entity", "Unknown entity type"))
+		} else {
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	response := helper.PaginatedResponse{
+		Items:      h.TrashMapper.DomainListToDTO(items),
+		TotalCount: total,
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, response, "Trash retrieved successfully")
+}
+
+// RestoreMatch godoc
+// @Summary Restore a deleted match
+// @Description Restores a match from the trash together with its lineups, statistics and reports.
+// @Tags trash
+// @ID restoreMatch
+// @Param id path int true "Match ID"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
+// @Success 204 "No Content"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 404 {object} helper.AppError "Match not in the trash"
+// @Failure 409 {object} helper.AppError "Request with the same Idempotency-Key still in progress"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/matches/{id}/restore [post]
+// @Security BearerAuth
+func (h *TrashHandler) RestoreMatch(c *gin.Context) {
+	h.restore(c, domain.AuditEntityMatch)
+}
+
+// RestorePlayer godoc
+// @Summary Restore a deleted player
+// @Description Restores a player from the trash.
+// @Tags trash
+// @ID restorePlayer
+// @Param id path int true "Player ID"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
+// @Success 204 "No Content"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 404 {object} helper.AppError "Player not in the trash"
+// @Failure 409 {object} helper.AppError "Request with the same Idempotency-Key still in progress"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/players/{id}/restore [post]
+// @Security BearerAuth
+func (h *TrashHandler) RestorePlayer(c *gin.Context) {
+	h.restore(c, domain.AuditEntityPlayer)
+}
+
+// RestoreTeam godoc
+// @Summary Restore a deleted team
+// @Description Restores a team from the trash. Fails when another team took its name in the meantime.
+// @Tags trash
+// @ID restoreTeam
+// @Param id path int true "Team ID"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
+// @Success 204 "No Content"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 404 {object} helper.AppError "Team not in the trash"
+// @Failure 409 {object} helper.AppError "A team with this name already exists"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/teams/{id}/restore [post]
+// @Security BearerAuth
+func (h *TrashHandler) RestoreTeam(c *gin.Context) {
+	h.restore(c, domain.AuditEntityTeam)
+}
+
+// RestoreArticle godoc
+// @Summary Restore a deleted article
+// @Description Restores an article from the trash. Fails when another article took its title in the meantime.
+// @Tags trash
+// @ID restoreArticle
+// @Param id path int true "Article ID"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
+// @Success 204 "No Content"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 404 {object} helper.AppError "Article not in the trash"
+// @Failure 409 {object} helper.AppError "An article with this title already exists"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/articles/{id}/restore [post]
+// @Security BearerAuth
+func (h *TrashHandler) RestoreArticle(c *gin.Context) {
+	h.restore(c, domain.AuditEntityArticle)
+}
+
+// restore takes the record of the entity type with the ID in the path out of the trash.
+func (h *TrashHandler) restore(c *gin.Context, entityType string) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || id == 0 {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", "Invalid "+entityType+" ID"))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	err = h.TrashDomainService.RestoreTrashItem(ctx, entityType, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrRecordNotFound):
+			helper.WriteErrorResponse(c, helper.NewNotFoundError(entityType))
+		case errors.Is(err, constants.ErrRecordAlreadyExists):
+			helper.WriteErrorResponse(c, helper.NewConflictError(entityType, "Another "+entityType+" with the same name was created since this one was deleted"))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -2,10 +2,9 @@ package handler
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
-	"runtime"
 	"strconv"
 	"time"
 
@@ -13,15 +12,16 @@ import (
 	"github.com/EdwinRincon/browersfc-api/api/dto"
 	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/EdwinRincon/browersfc-api/pkg/logger"
+	"github.com/EdwinRincon/browersfc-api/pkg/oidc"
 	"github.com/EdwinRincon/browersfc-api/pkg/security"
 
 	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/middleware"
 	"github.com/EdwinRincon/browersfc-api/config"
 	"github.com/EdwinRincon/browersfc-api/helper"
 	domainservice "github.com/EdwinRincon/browersfc-api/internal/domain/service"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
-	"golang.org/x/oauth2"
 )
 
 // UserHandler handles user-related HTTP requests.
@@ -29,214 +29,461 @@ type UserHandler struct {
 	AuthenticationDomainService *domainservice.AuthenticationDomainService
 	UserDomainService           *domainservice.UserDomainService
 	RoleDomainService           *domainservice.RoleDomainService
+	IdentityDomainService       *domainservice.IdentityDomainService
+	CredentialDomainService     *domainservice.CredentialDomainService
+	InvitationDomainService     *domainservice.InvitationDomainService
 	UserMapper                  *httpMapper.UserHTTPMapper
-	googleClient                *http.Client // Pre-initialized Google API client for OAuth.
+	SessionMapper               *httpMapper.SessionHTTPMapper
+	oauthProviders              *oidc.Registry   // OpenID Connect providers users can sign in with.
+	pkceStore                   domain.PKCEStore // PKCE parameters of the logins waiting for their callback.
 }
 
-// GoogleUserInfo represents the user information returned by the Google API.
-type GoogleUserInfo struct {
-	Email      string `json:"email"`
-	Name       string `json:"name"`
-	Picture    string `json:"picture"`
-	FamilyName string `json:"family_name"`
-}
-
-// NewUserHandler creates a new UserHandler with a pre-configured HTTP client.
-func NewUserHandler(authService *domainservice.AuthenticationDomainService, userDomainService *domainservice.UserDomainService, roleDomainService *domainservice.RoleDomainService) *UserHandler {
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-		Transport: &http.Transport{
-			MaxIdleConns:          100,
-			IdleConnTimeout:       90 * time.Second,
-			TLSHandshakeTimeout:   10 * time.Second,
-			ExpectContinueTimeout: 1 * time.Second,
-			MaxIdleConnsPerHost:   runtime.GOMAXPROCS(0) + 1,
-		},
-	}
-
+// NewUserHandler creates a new UserHandler.
+func NewUserHandler(authService *domainservice.AuthenticationDomainService, userDomainService *domainservice.UserDomainService, roleDomainService *domainservice.RoleDomainService, identityDomainService *domainservice.IdentityDomainService, credentialDomainService *domainservice.CredentialDomainService, invitationDomainService *domainservice.InvitationDomainService, oauthProviders *oidc.Registry, pkceStore domain.PKCEStore) *UserHandler {
 	return &UserHandler{
 		AuthenticationDomainService: authService,
 		UserDomainService:           userDomainService,
 		RoleDomainService:           roleDomainService,
+		IdentityDomainService:       identityDomainService,
+		CredentialDomainService:     credentialDomainService,
+		InvitationDomainService:     invitationDomainService,
 		UserMapper:                  httpMapper.NewUserHTTPMapper(),
-		googleClient:                client,
+		SessionMapper:               httpMapper.NewSessionHTTPMapper(),
+		oauthProviders:              oauthProviders,
+		pkceStore:                   pkceStore,
 	}
 }
 
-// setAuthenticationCookie generates a JWT token and sets it as a secure HTTP-only cookie.
-// This is used for OAuth callbacks where we only need to set the cookie without sending a JSON response.
-func (h *UserHandler) setAuthenticationCookie(c *gin.Context, user *domain.User) {
-	jwtToken, err := h.AuthenticationDomainService.GenerateToken(c.Request.Context(), user)
+// Cookies holding the session tokens
+const (
+	accessTokenCookie  = "token"
+	refreshTokenCookie = "refresh_token"
+)
+
+// setAuthenticationCookies stores the access and refresh tokens as secure HTTP-only cookies.
+// This is used for OAuth callbacks where we only need to set the cookies without sending a JSON response.
+func (h *UserHandler) setAuthenticationCookies(c *gin.Context, tokens *domain.AuthTokens) {
+	security.SetSecureCookie(c, accessTokenCookie, tokens.AccessToken, int(config.GetAccessTokenTTL()/time.Second))
+	security.SetSecureCookie(c, refreshTokenCookie, tokens.RefreshToken, int(time.Until(tokens.RefreshTokenExpiresAt)/time.Second))
+}
+
+// clearAuthenticationCookies removes the session cookies from the client.
+func (h *UserHandler) clearAuthenticationCookies(c *gin.Context) {
+	security.SetSecureCookie(c, accessTokenCookie, "", -1)
+	security.SetSecureCookie(c, refreshTokenCookie, "", -1)
+}
+
+// getOAuthProvider returns the named provider, discovering it on first use.
+func (h *UserHandler) getOAuthProvider(c *gin.Context, name string) (*oidc.Provider, bool) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
+	defer cancel()
+
+	provider, err := h.oauthProviders.Provider(ctx, name)
 	if err != nil {
+		if errors.Is(err, oidc.ErrUnknownProvider) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("provider"))
+			return nil, false
+		}
+		logger.Error(c, "OAuth provider discovery failed", "provider", name, "error", err)
 		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
-		return
+		return nil, false
 	}
-
-	// Set secure HTTP-only cookie for session management
-	security.SetSecureCookie(c, "token", jwtToken, int(time.Hour/time.Second))
+	return provider, true
 }
 
-// fetchGoogleUserInfo retrieves user information from Google using the provided OAuth2 token.
-func (h *UserHandler) fetchGoogleUserInfo(ctx context.Context, token *oauth2.Token) (*GoogleUserInfo, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", "https://www.googleapis.com/oauth2/v3/userinfo", nil)
+// validateOAuthState validates the OAuth state parameter and retrieves the PKCE parameters
+// stored when the login was started with the same provider.
+func (h *UserHandler) validateOAuthState(c *gin.Context, providerName string) (*domain.PKCEParams, error) {
+	state := c.Query("state")
+	storedState, _ := c.Cookie("oauth_state")
+
+	logger.Info(c, "OAuth state validation", "query_state", state, "cookie_state", storedState)
+
+	if state == "" || state != storedState {
+		return nil, errors.New("invalid OAuth state")
+	}
+
+	pkceParams, err := h.pkceStore.TakePKCE(c.Request.Context(), state)
 	if err != nil {
 		return nil, err
 	}
-	token.SetAuthHeader(req)
+	if pkceParams == nil || pkceParams.Provider != providerName {
+		return nil, errors.New("unknown OAuth state")
+	}
+
+	// Clear the state cookie immediately after successful validation
+	//TODO: modify the cookie to be secure and HttpOnly when in production
+	c.SetCookie("oauth_state", "", -1, "/", "", false, true)
+	return pkceParams, nil
+}
+
+// startOAuthLogin stores the PKCE parameters of a new login under a random state, sets the
+// state cookie and returns the consent page URL of the provider. A non-zero invitationID is
+// accepted by the callback of the login.
+func (h *UserHandler) startOAuthLogin(c *gin.Context, provider *oidc.Provider, invitationID uint64) (string, error) {
+	state, err := helper.GenerateRandomState()
+	if err != nil {
+		return "", err
+	}
 
-	resp, err := h.googleClient.Do(req)
+	nonce, err := helper.GenerateRandomState()
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New("Google API error: " + resp.Status)
+	pkceParams, err := config.GeneratePKCE()
+	if err != nil {
+		return "", err
 	}
+	pkceParams.Provider = provider.Name
+	pkceParams.Nonce = nonce
+	pkceParams.InvitationID = invitationID
 
-	var googleUser GoogleUserInfo
-	if err := json.NewDecoder(resp.Body).Decode(&googleUser); err != nil {
-		return nil, err
+	if err := h.pkceStore.SavePKCE(c.Request.Context(), state, pkceParams); err != nil {
+		return "", err
 	}
 
-	return &googleUser, nil
+	security.SetSecureCookie(c, "oauth_state", state, security.OAuthStateDuration*60)
+	return provider.AuthCodeURL(state, nonce, pkceParams.Challenge), nil
 }
 
-// exchangeCodeForToken exchanges the authorization code for an OAuth2 token.
-func (h *UserHandler) exchangeCodeForToken(c *gin.Context) (*oauth2.Token, error) {
-	code := c.Query("code")
-	pkceParams := c.MustGet("pkce_params").(*config.PKCEParams)
+// performOAuth orchestrates the OAuth flow: state validation, code exchange, and ID token verification.
+// It also returns the PKCE parameters stored when the login was started.
+func (h *UserHandler) performOAuth(c *gin.Context, provider *oidc.Provider) (*domain.ExternalIdentity, *domain.PKCEParams, error) {
+	pkceParams, err := h.validateOAuthState(c, provider.Name)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 10*time.Second)
 	defer cancel()
 
-	token, err := config.OAuthConfig.Exchange(ctx, code,
-		oauth2.SetAuthURLParam("code_verifier", pkceParams.Verifier))
+	rawIDToken, err := provider.Exchange(ctx, c.Query("code"), pkceParams.Verifier)
 	if err != nil {
-		logger.Error(c, "code exchange failed", "error", err)
-		return nil, err
+		return nil, nil, fmt.Errorf("code exchange failed: %w", err)
 	}
 
-	if !token.Valid() {
-		return nil, errors.New("invalid token received")
+	claims, err := provider.VerifyIDToken(ctx, rawIDToken, pkceParams.Nonce)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return token, nil
+	name := claims.GivenName
+	if name == "" {
+		name = claims.Name
+	}
+	return &domain.ExternalIdentity{
+		Provider:      provider.Name,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          name,
+		LastName:      claims.FamilyName,
+		Picture:       claims.Picture,
+	}, pkceParams, nil
 }
 
-// validateOAuthState validates the OAuth state parameter and retrieves PKCE parameters.
-func (h *UserHandler) validateOAuthState(c *gin.Context) bool {
-	state := c.Query("state")
-	storedState, _ := c.Cookie("oauth_state")
+// ProviderCallback godoc
+// @Summary OAuth2/OpenID Connect callback
+// @Description Verifies the ID token returned by the provider and signs the user in. An identity seen for the first time is linked to the user with the same verified email, or a new user is created.
+// @Description When the login was started by accepting an invitation, the invited user is created with the invitation role and team and the identity is bound to it.
+// @Tags users
+// @ID providerCallback
+// @Produce json
+// @Param provider path string true "Provider name, for example google"
+// @Success 302 "Redirect to the web app with the session cookies set"
+// @Failure 401 {object} helper.AppError "Authentication failed or invalid state"
+// @Failure 400 {object} helper.AppError "Invitation already used, revoked or expired"
+// @Failure 403 {object} helper.AppError "Email not verified or email domain not allowed"
+// @Failure 404 {object} helper.AppError "Provider not found"
+// @Failure 409 {object} helper.AppError "Identity or invited email already has an account"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/auth/{provider}/callback [get]
+func (h *UserHandler) ProviderCallback(c *gin.Context) {
+	provider, ok := h.getOAuthProvider(c, c.Param("provider"))
+	if !ok {
+		return
+	}
 
-	logger.Info(c, "OAuth state validation", "query_state", state, "cookie_state", storedState)
+	externalIdentity, pkceParams, err := h.performOAuth(c, provider)
+	if err != nil {
+		logger.Warn(c, "OAuth authentication failed", "provider", provider.Name, "error", err)
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication failed"))
+		return
+	}
 
-	if state == "" || state != storedState {
-		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Invalid OAuth state"))
-		return false
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	// Note: Rate limiting for new accounts is now handled by middleware.RateLimitNewAccounts
+	var signIn *domain.IdentitySignIn
+	if pkceParams.InvitationID != 0 {
+		signIn, err = h.InvitationDomainService.AcceptInvitation(ctx, pkceParams.InvitationID, externalIdentity)
+	} else {
+		signIn, err = h.IdentityDomainService.SignIn(ctx, externalIdentity)
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrEmailNotVerified):
+			helper.WriteErrorResponse(c, helper.NewForbiddenError("Email address is not verified by the provider"))
+		case errors.Is(err, constants.ErrEmailDomainNotAllowed):
+			helper.WriteErrorResponse(c, helper.NewForbiddenError("Email domain not allowed"))
+		case errors.Is(err, constants.ErrInvitationNotUsable):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("invitation", "The invitation was already used, revoked or has expired"))
+		case errors.Is(err, constants.ErrIdentityAlreadyLinked):
+			helper.WriteErrorResponse(c, helper.NewConflictError("identity", "This account is already linked to a user; sign in instead"))
+		case errors.Is(err, constants.ErrRecordAlreadyExists):
+			helper.WriteErrorResponse(c, helper.NewConflictError("user", "The invited email already has an account; sign in instead"))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
 	}
 
-	pkceParams, ok := config.GetAndDeletePKCE(state)
-	if !ok {
-		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Invalid request"))
-		return false
+	user := signIn.User
+	switch {
+	case signIn.Created && pkceParams.InvitationID != 0:
+		middleware.MarkNewAccount(c)
+		logger.Info(c, "invitation accepted via OAuth", "username", user.Username, "provider", provider.Name, "invitation_id", pkceParams.InvitationID)
+	case signIn.Created:
+		middleware.MarkNewAccount(c)
+		logger.Info(c, "new user created via OAuth", "username", user.Username, "provider", provider.Name)
+	case signIn.Linked:
+		logger.Info(c, "OAuth identity linked to existing user", "username", user.Username, "provider", provider.Name)
 	}
 
-	// Clear the state cookie immediately after successful validation
-	//TODO: modify the cookie to be secure and HttpOnly when in production
-	c.SetCookie("oauth_state", "", -1, "/", "", false, true)
-	c.Set("pkce_params", pkceParams)
-	return true
+	// Start a session and set the authentication cookies before redirecting to Angular app
+	tokens, err := h.AuthenticationDomainService.StartSession(ctx, user, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+	h.setAuthenticationCookies(c, tokens)
+
+	// Redirect to Angular app after successful authentication
+	// The cookie is already set, so Angular will detect the authenticated state
+	redirectURL := "https://app.browersfc.com/admin"
+	if config.Config.IsDevelopment {
+		redirectURL = "http://localhost:4200/admin"
+	}
+	c.Redirect(http.StatusFound, redirectURL)
 }
 
-// performGoogleOAuth orchestrates the OAuth flow: state validation, token exchange, and user info retrieval.
-func (h *UserHandler) performGoogleOAuth(c *gin.Context) (*GoogleUserInfo, error) {
-	if !h.validateOAuthState(c) {
-		return nil, errors.New("invalid OAuth state")
+// Register godoc
+// @Summary Register an account with email and password
+// @Description Creates a user with the default role that signs in with a local password instead of an external provider.
+// @Tags users
+// @ID register
+// @Accept json
+// @Produce json
+// @Param user body dto.RegisterRequest true "Registration data"
+// @Success 201 {object} dto.UserShort "User registered successfully"
+// @Failure 400 {object} helper.AppError "Invalid input or password policy not met"
+// @Failure 403 {object} helper.AppError "Email domain not allowed"
+// @Failure 409 {object} helper.AppError "Username already exists"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/auth/register [post]
+func (h *UserHandler) Register(c *gin.Context) {
+	var registerRequest dto.RegisterRequest
+	if err := c.ShouldBindJSON(&registerRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidUserData))
+		return
 	}
 
-	token, err := h.exchangeCodeForToken(c)
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	user, err := h.CredentialDomainService.Register(ctx, h.UserMapper.RegisterDTOToDomain(&registerRequest), registerRequest.Password)
 	if err != nil {
-		return nil, err
+		switch {
+		case errors.Is(err, constants.ErrInvalidPassword):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("password", "Password does not meet the password policy"))
+		case errors.Is(err, constants.ErrInvalidData):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("body", constants.MsgInvalidUserData))
+		case errors.Is(err, constants.ErrEmailDomainNotAllowed):
+			helper.WriteErrorResponse(c, helper.NewForbiddenError("Email domain not allowed"))
+		case errors.Is(err, constants.ErrRecordAlreadyExists):
+			helper.WriteErrorResponse(c, helper.NewConflictError("username", "Username already exists"))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
 	}
 
-	return h.fetchGoogleUserInfo(c.Request.Context(), token)
+	middleware.MarkNewAccount(c)
+	logger.Info(c, "new user registered with password", "username", user.Username)
+	helper.WriteSuccessResponse(c, http.StatusCreated, h.UserMapper.DomainToShortDTO(user), "User registered successfully")
 }
 
-// GoogleCallback godoc
-// @Summary Google OAuth2 callback
+// Login godoc
+// @Summary Log in with email and password
+// @Description Checks the credentials, starts a session and sets the session cookies. After too many failed attempts from the same IP address further attempts are rejected until the lockout ends.
 // @Tags users
-// @ID googleCallback
+// @ID login
+// @Accept json
 // @Produce json
-// @Success 200 {object} dto.UserResponse "Successful authentication and user data"
-// @Failure 401 {object} helper.AppError "Authentication failed or invalid state"
+// @Param credentials body dto.LoginRequest true "Credentials"
+// @Success 200 {object} dto.AuthUserResponse "Logged in successfully"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 401 {object} helper.AppError "Invalid username or password"
+// @Failure 429 {object} helper.AppError "Too many failed login attempts"
 // @Failure 500 {object} helper.AppError "Internal server error"
-// @Router /users/auth/google/callback [get]
-func (h *UserHandler) GoogleCallback(c *gin.Context) {
-	googleUser, err := h.performGoogleOAuth(c)
-	if err != nil {
-		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication failed"))
+// @Router /users/auth/login [post]
+func (h *UserHandler) Login(c *gin.Context) {
+	var loginRequest dto.LoginRequest
+	if err := c.ShouldBindJSON(&loginRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidData))
 		return
 	}
 
 	// Wrap context with timeout for DB/service calls
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
-	user, err := h.UserDomainService.GetUserByUsername(ctx, googleUser.Email)
-	if err != nil && !errors.Is(err, constants.ErrRecordNotFound) {
+
+	user, err := h.CredentialDomainService.Login(ctx, loginRequest.Username, loginRequest.Password, c.ClientIP())
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrTooManyLoginAttempts):
+			logger.Warn(c, "login rejected, IP address locked out", "ip", c.ClientIP())
+			c.Header("Retry-After", strconv.Itoa(int(h.CredentialDomainService.LockoutDuration()/time.Second)))
+			helper.WriteErrorResponse(c, helper.NewTooManyRequestsError("Too many failed login attempts, try again later"))
+		case errors.Is(err, constants.ErrInvalidCredentials):
+			helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Invalid username or password"))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	tokens, err := h.AuthenticationDomainService.StartSession(ctx, user, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
 		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
 		return
 	}
+	h.setAuthenticationCookies(c, tokens)
 
-	if user == nil {
-		// Validate email domain
-		if !security.ValidateEmailDomain(googleUser.Email) {
-			helper.WriteErrorResponse(c, helper.NewForbiddenError("Email domain not allowed"))
-			return
-		}
+	helper.WriteSuccessResponse(c, http.StatusOK, h.UserMapper.DomainToAuthDTO(user), "Logged in successfully")
+}
 
-		// Note: Rate limiting for new accounts is now handled by middleware.RateLimitNewAccounts
+// ResetPassword godoc
+// @Summary Set a new password with a reset token
+// @Description Redeems a single-use password reset token and ends every session of the user.
+// @Tags users
+// @ID resetPassword
+// @Accept json
+// @Produce json
+// @Param reset body dto.ResetPasswordRequest true "Reset token and new password"
+// @Success 200 {object} helper.AppSuccess "Password reset successfully"
+// @Failure 400 {object} helper.AppError "Invalid, expired or used token, or password policy not met"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/auth/password/reset [post]
+func (h *UserHandler) ResetPassword(c *gin.Context) {
+	var resetRequest dto.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&resetRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidData))
+		return
+	}
 
-		// Get the default role for new Google users
-		defaultRole, err := h.RoleDomainService.GetRoleByName(ctx, constants.RoleDefault)
-		if err != nil {
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	userID, err := h.CredentialDomainService.ResetPassword(ctx, resetRequest.Token, resetRequest.Password)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrInvalidResetToken):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("token", "Invalid or expired password reset token"))
+		case errors.Is(err, constants.ErrInvalidPassword):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("password", "Password does not meet the password policy"))
+		default:
 			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
-			return
 		}
+		return
+	}
 
-		newUser := &domain.User{
-			Username:   googleUser.Email,
-			Name:       googleUser.Name,
-			ImgProfile: googleUser.Picture,
-			LastName:   googleUser.FamilyName,
-			RoleID:     defaultRole.ID,
-		}
+	// Sessions opened with the old password must not outlive it
+	if err := h.AuthenticationDomainService.RevokeUserSessions(ctx, userID); err != nil {
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
 
-		_, err = h.UserDomainService.CreateUser(ctx, newUser)
-		if err != nil {
-			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
-			return
-		}
+	logger.Info(c, "password reset", "user_id", userID)
+	helper.WriteSuccessResponse(c, http.StatusOK, nil, "Password reset successfully")
+}
 
-		logger.Info(c, "new user created via OAuth", "username", newUser.Username)
+// RefreshSession godoc
+// @Summary Renew the session using the refresh token cookie
+// @Description Exchanges the refresh_token cookie for a new access token cookie and rotates the refresh token. Presenting a refresh token that was already used revokes every token issued from the same login.
+// @Tags users
+// @ID refreshSession
+// @Produce json
+// @Success 200 {object} helper.AppSuccess "Session refreshed successfully"
+// @Failure 401 {object} helper.AppError "Missing, invalid, expired or reused refresh token"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/auth/refresh [post]
+func (h *UserHandler) RefreshSession(c *gin.Context) {
+	refreshToken, err := c.Cookie(refreshTokenCookie)
+	if err != nil || refreshToken == "" {
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Refresh token required"))
+		return
+	}
 
-		user, err = h.UserDomainService.GetUserByUsername(ctx, newUser.Username)
-		if err != nil {
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	tokens, err := h.AuthenticationDomainService.RefreshSession(ctx, refreshToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrRefreshTokenReused):
+			logger.Warn(c, "refresh token reuse detected, token family revoked", "ip", c.ClientIP())
+			h.clearAuthenticationCookies(c)
+			helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Invalid or expired refresh token"))
+		case errors.Is(err, constants.ErrInvalidRefreshToken):
+			h.clearAuthenticationCookies(c)
+			helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Invalid or expired refresh token"))
+		default:
 			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
-			return
 		}
+		return
 	}
 
-	// Set authentication cookie and redirect to Angular app
-	h.setAuthenticationCookie(c, user)
+	h.setAuthenticationCookies(c, tokens)
+	helper.WriteSuccessResponse(c, http.StatusOK, nil, "Session refreshed successfully")
+}
 
-	// Redirect to Angular app after successful authentication
-	// The cookie is already set, so Angular will detect the authenticated state
-	redirectURL := "https://app.browersfc.com/admin"
-	if config.Config.IsDevelopment {
-		redirectURL = "http://localhost:4200/admin"
+// Logout godoc
+// @Summary Log out of the current session
+// @Description Revokes the access token and the session it belongs to, including its refresh token, and clears the session cookies.
+// @Tags users
+// @ID logout
+// @Produce json
+// @Success 200 {object} helper.AppSuccess "Logged out successfully"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/auth/logout [post]
+// @Security BearerAuth
+func (h *UserHandler) Logout(c *gin.Context) {
+	claims := &domain.AuthenticationClaims{
+		TokenID:   c.GetString("token_id"),
+		SessionID: c.GetString("session_id"),
+		UserID:    c.GetString("user_id"),
+		ExpiresAt: c.GetTime("token_expires_at"),
 	}
-	c.Redirect(http.StatusFound, redirectURL)
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	if err := h.AuthenticationDomainService.Logout(ctx, claims); err != nil {
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	h.clearAuthenticationCookies(c)
+	helper.WriteSuccessResponse(c, http.StatusOK, nil, "Logged out successfully")
 }
 
 // GetCurrentUser godoc
@@ -295,35 +542,141 @@ func (h *UserHandler) GetCurrentUser(c *gin.Context) {
 	helper.WriteSuccessResponse(c, http.StatusOK, userResponse, "Current user retrieved successfully")
 }
 
-// LoginWithGoogle godoc
-// @Summary Initiate Google OAuth2 login
+// GetMySessions godoc
+// @Summary List the current user's active sessions
+// @Description Returns the signed-in devices of the current user, oldest first. The session of the request is flagged as current.
+// @Tags users
+// @ID getMySessions
+// @Produce json
+// @Success 200 {array} dto.SessionResponse "Sessions retrieved successfully"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/me/sessions [get]
+// @Security BearerAuth
+func (h *UserHandler) GetMySessions(c *gin.Context) {
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	sessions, err := h.AuthenticationDomainService.GetActiveSessions(ctx, c.GetString("user_id"))
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	response := h.SessionMapper.ToDTOList(sessions, c.GetString("session_id"))
+	helper.WriteSuccessResponse(c, http.StatusOK, response, "Sessions retrieved successfully")
+}
+
+// RevokeMySession godoc
+// @Summary Sign out one of the current user's sessions
+// @Description Revokes the session and its tokens. Revoking the session of the request also clears the session cookies.
+// @Tags users
+// @ID revokeMySession
+// @Param id path string true "Session ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 400 {object} helper.AppError "Invalid UUID format"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 404 {object} helper.AppError "Session not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/me/sessions/{id} [delete]
+// @Security BearerAuth
+func (h *UserHandler) RevokeMySession(c *gin.Context) {
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", "Invalid UUID format"))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	if err := h.AuthenticationDomainService.RevokeSession(ctx, c.GetString("user_id"), sessionID.String()); err != nil {
+		if errors.Is(err, constants.ErrSessionNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("session"))
+			return
+		}
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	if sessionID.String() == c.GetString("session_id") {
+		h.clearAuthenticationCookies(c)
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// LoginWithProvider godoc
+// @Summary Initiate OAuth2/OpenID Connect login
+// @Description Returns the consent page URL of the provider. Providers are enabled with OAUTH_PROVIDERS; google is enabled by default.
 // @Tags users
-// @ID loginWithGoogle
+// @ID loginWithProvider
 // @Produce json
+// @Param provider path string true "Provider name, for example google"
 // @Success 200 {object} map[string]string "Authorization URL generated"
+// @Failure 404 {object} helper.AppError "Provider not found"
 // @Failure 500 {object} helper.AppError "Internal server error"
-// @Router /users/auth/google [get]
-func (h *UserHandler) LoginWithGoogle(c *gin.Context) {
-	state, err := helper.GenerateRandomState()
+// @Router /users/auth/{provider} [get]
+func (h *UserHandler) LoginWithProvider(c *gin.Context) {
+	provider, ok := h.getOAuthProvider(c, c.Param("provider"))
+	if !ok {
+		return
+	}
+
+	url, err := h.startOAuthLogin(c, provider, 0)
 	if err != nil {
 		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
 		return
 	}
 
-	pkceParams, err := config.GeneratePKCE()
+	helper.WriteSuccessResponse(c, http.StatusOK, gin.H{"url": url}, "OAuth URL generated")
+}
+
+// AcceptInvitation godoc
+// @Summary Accept an invitation
+// @Description Starts a login with the chosen provider that accepts the invitation. The returned consent page URL is used like the one of GET /users/auth/{provider}; on the callback the invited user is created with the invitation role and team and the provider account is bound to it, whatever its email.
+// @Tags users
+// @ID acceptInvitation
+// @Accept json
+// @Produce json
+// @Param invitation body dto.AcceptInvitationRequest true "Invitation token and provider"
+// @Success 200 {object} map[string]string "Authorization URL generated"
+// @Failure 400 {object} helper.AppError "Invalid input, or invitation already used, revoked or expired"
+// @Failure 404 {object} helper.AppError "Provider not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/auth/invitations/accept [post]
+func (h *UserHandler) AcceptInvitation(c *gin.Context) {
+	var acceptRequest dto.AcceptInvitationRequest
+	if err := c.ShouldBindJSON(&acceptRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidInvitationData))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	invitation, err := h.InvitationDomainService.GetUsableInvitation(ctx, acceptRequest.Token)
 	if err != nil {
+		if errors.Is(err, constants.ErrInvitationNotUsable) {
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("token", "The invitation was already used, revoked or has expired"))
+			return
+		}
 		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
 		return
 	}
 
-	config.StorePKCE(state, pkceParams)
+	provider, ok := h.getOAuthProvider(c, acceptRequest.Provider)
+	if !ok {
+		return
+	}
 
-	security.SetSecureCookie(c, "oauth_state", state, int(10*time.Minute/time.Second))
-	opts := []oauth2.AuthCodeOption{
-		oauth2.SetAuthURLParam("code_challenge", pkceParams.Challenge),
-		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	url, err := h.startOAuthLogin(c, provider, invitation.ID)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
 	}
-	url := config.OAuthConfig.AuthCodeURL(state, opts...)
 
 	helper.WriteSuccessResponse(c, http.StatusOK, gin.H{"url": url}, "OAuth URL generated")
 }
@@ -335,9 +688,12 @@ func (h *UserHandler) LoginWithGoogle(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param user body dto.CreateUserRequest true "User data"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
 // @Success 201 {object} dto.UserShort "User created successfully"
 // @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 403 {object} helper.AppError "Email domain not allowed"
 // @Failure 409 {object} helper.AppError "Conflict (e.g., username exists)"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
 // @Failure 500 {object} helper.AppError "Internal server error"
 // @Router /admin/users [post]
 // @Security BearerAuth
@@ -377,6 +733,12 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 	createdUser, err := h.UserDomainService.CreateUser(ctx, domainUser)
 	if err != nil {
 		switch {
+		case errors.Is(err, constants.ErrInvalidData):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("body", constants.MsgInvalidUserData))
+			return
+		case errors.Is(err, constants.ErrEmailDomainNotAllowed):
+			helper.WriteErrorResponse(c, helper.NewForbiddenError("Email domain not allowed"))
+			return
 		case errors.Is(err, constants.ErrRecordAlreadyExists):
 			helper.WriteErrorResponse(c, helper.NewConflictError("username", "Username already exists"))
 			return
@@ -396,7 +758,10 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 // @Tags users
 // @ID getUserByUsername
 // @Param username path string true "Username"
+// @Param If-None-Match header string false "ETag from a previous response"
 // @Success 200 {object} dto.UserResponse "User retrieved successfully"
+// @Success 304 "Not Modified"
+// @Header 200 {string} ETag "Version of the user"
 // @Failure 400 {object} helper.AppError "Invalid input"
 // @Failure 404 {object} helper.AppError "User not found"
 // @Failure 500 {object} helper.AppError "Internal server error"
@@ -419,6 +784,10 @@ func (h *UserHandler) GetUserByUsername(c *gin.Context) {
 		return
 	}
 
+	if helper.WriteNotModified(c, user.Version) {
+		return
+	}
+
 	// Map domain user to DTO response
 	userResponse := h.UserMapper.DomainToDTO(user, nil)
 	helper.WriteSuccessResponse(c, http.StatusOK, userResponse, "User retrieved successfully")
@@ -474,15 +843,20 @@ func (h *UserHandler) GetPaginatedUsers(c *gin.Context) {
 
 // UpdateUser godoc
 // @Summary Update an existing user
+// @Description Changing the role revokes all the user's sessions so the new permissions apply at the next sign-in.
 // @Tags users
 // @ID updateUser
 // @Accept json
 // @Produce json
 // @Param id path string true "User ID (UUID)"
 // @Param user body dto.UpdateUserRequest true "Updated user data"
+// @Param If-Match header string true "ETag of the user being changed"
 // @Success 200 {object} dto.UserShort "User updated successfully"
+// @Header 200 {string} ETag "New version of the user"
 // @Failure 400 {object} helper.AppError "Invalid input or UUID format"
 // @Failure 404 {object} helper.AppError "User not found"
+// @Failure 412 {object} helper.AppError "The user was modified since it was read"
+// @Failure 428 {object} helper.AppError "If-Match header missing"
 // @Failure 500 {object} helper.AppError "Internal server error"
 // @Router /admin/users/{id} [put]
 // @Security BearerAuth
@@ -506,8 +880,23 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 	// Map DTO to domain entity
 	domainUpdates := h.UserMapper.UpdateDTOToDomain(&userUpdateDTO)
 
+	existingUser, err := h.UserDomainService.GetUserByID(ctx, userIDStr)
+	if err != nil {
+		if errors.Is(err, constants.ErrRecordNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("user"))
+			return
+		}
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+	previousRoleID := existingUser.RoleID
+
 	updatedUser, err := h.UserDomainService.UpdateUser(ctx, userIDStr, domainUpdates)
 	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("user"))
+			return
+		}
 		if errors.Is(err, constants.ErrRecordNotFound) {
 			helper.WriteErrorResponse(c, helper.NewNotFoundError("user"))
 			return
@@ -520,18 +909,315 @@ func (h *UserHandler) UpdateUser(c *gin.Context) {
 		return
 	}
 
+	// Tokens carry the role permissions, so a role change must end the user's current sessions
+	if updatedUser.RoleID != previousRoleID {
+		if err := h.AuthenticationDomainService.RevokeUserSessions(ctx, updatedUser.ID); err != nil {
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+			return
+		}
+		logger.Info(c, "user sessions revoked after role change", "user_id", updatedUser.ID)
+	}
+
+	helper.SetETag(c, updatedUser.Version)
 	// Map domain user to DTO response
 	response := h.UserMapper.DomainToShortDTO(updatedUser)
 	helper.WriteSuccessResponse(c, http.StatusOK, response, "User updated successfully")
 }
 
+// AssignUserTeam godoc
+// @Summary Link a coach to a team
+// @Description Sets the team a coach, or any user whose role grants a team-scoped permission, manages. Without the team:manage_any permission they can only manage lineups, squad availability and player stats of that team. A null team_id removes the link.
+// @Tags users
+// @ID assignUserTeam
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID (UUID)"
+// @Param team body dto.AssignUserTeamRequest true "Managed team"
+// @Param If-Match header string true "ETag of the user being changed"
+// @Success 200 {object} dto.UserResponse "User team updated successfully"
+// @Header 200 {string} ETag "New version of the user"
+// @Failure 400 {object} helper.AppError "Invalid input, UUID format or the user role grants no team-scoped permission"
+// @Failure 404 {object} helper.AppError "User or team not found"
+// @Failure 412 {object} helper.AppError "The user was modified since it was read"
+// @Failure 428 {object} helper.AppError "If-Match header missing"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/users/{id}/team [put]
+// @Security BearerAuth
+func (h *UserHandler) AssignUserTeam(c *gin.Context) {
+	userIDStr := c.Param("id")
+	if _, err := uuid.Parse(userIDStr); err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", "Invalid user ID format"))
+		return
+	}
+
+	var assignRequest dto.AssignUserTeamRequest
+	if err := c.ShouldBindJSON(&assignRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidUserData))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	updatedUser, err := h.UserDomainService.AssignTeam(ctx, userIDStr, assignRequest.TeamID)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrRecordNotFound):
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("user"))
+		case errors.Is(err, constants.ErrTeamNotFound):
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("team"))
+		case errors.Is(err, constants.ErrUserCannotManageTeam):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("id", "Only users whose role grants lineup, player stats or availability permissions can be linked to a team"))
+		case errors.Is(err, constants.ErrVersionMismatch):
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("user"))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	helper.SetETag(c, updatedUser.Version)
+	response := h.UserMapper.DomainToDTO(updatedUser, nil)
+	helper.WriteSuccessResponse(c, http.StatusOK, response, "User team updated successfully")
+}
+
+// RevokeUserSessions godoc
+// @Summary Revoke all sessions of a user
+// @Description Invalidates every access and refresh token issued to the user, forcing them to sign in again.
+// @Tags users
+// @ID revokeUserSessions
+// @Param id path string true "User ID (UUID)"
+// @Success 204 "No Content"
+// @Failure 400 {object} helper.AppError "Invalid UUID format"
+// @Failure 404 {object} helper.AppError "User not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/users/{id}/sessions [delete]
+// @Security BearerAuth
+func (h *UserHandler) RevokeUserSessions(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", "Invalid UUID format"))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	if err := h.AuthenticationDomainService.RevokeUserSessions(ctx, id.String()); err != nil {
+		if errors.Is(err, constants.ErrRecordNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("user"))
+			return
+		}
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	logger.Info(c, "user sessions revoked", "user_id", id.String(), "revoked_by", c.GetString("user_id"))
+	c.Status(http.StatusNoContent)
+}
+
+// ChangeMyPassword godoc
+// @Summary Change the password of the current user
+// @Tags users
+// @ID changeMyPassword
+// @Accept json
+// @Produce json
+// @Param password body dto.ChangePasswordRequest true "Current and new password"
+// @Success 204 "No Content"
+// @Failure 400 {object} helper.AppError "Invalid input or password policy not met"
+// @Failure 401 {object} helper.AppError "Current password is incorrect"
+// @Failure 429 {object} helper.AppError "Too many failed login attempts"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/me/password [put]
+// @Security BearerAuth
+func (h *UserHandler) ChangeMyPassword(c *gin.Context) {
+	var changeRequest dto.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&changeRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidData))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	userID := c.GetString("user_id")
+	err := h.CredentialDomainService.ChangePassword(ctx, userID, changeRequest.CurrentPassword, changeRequest.NewPassword, c.ClientIP())
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrTooManyLoginAttempts):
+			logger.Warn(c, "password change rejected, IP address locked out", "ip", c.ClientIP())
+			c.Header("Retry-After", strconv.Itoa(int(h.CredentialDomainService.LockoutDuration()/time.Second)))
+			helper.WriteErrorResponse(c, helper.NewTooManyRequestsError("Too many failed login attempts, try again later"))
+		case errors.Is(err, constants.ErrInvalidPassword):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("new_password", "Password does not meet the password policy"))
+		case errors.Is(err, constants.ErrInvalidCredentials):
+			helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Current password is incorrect"))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	logger.Info(c, "password changed", "user_id", userID)
+	c.Status(http.StatusNoContent)
+}
+
+// UpdateMyProfile godoc
+// @Summary Update the profile of the current user
+// @Description Changes the name, last name, birthdate and images of the current user. Omitted fields are left unchanged and an empty image removes it. The username and role can only be changed by an admin. Every changed field is recorded in the profile history.
+// @Tags users
+// @ID updateMyProfile
+// @Accept json
+// @Produce json
+// @Param profile body dto.UpdateProfileRequest true "Profile fields to change"
+// @Success 200 {object} dto.UserResponse "Profile updated successfully"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 404 {object} helper.AppError "User not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/me [patch]
+// @Security BearerAuth
+func (h *UserHandler) UpdateMyProfile(c *gin.Context) {
+	var profileDTO dto.UpdateProfileRequest
+	if err := c.ShouldBindJSON(&profileDTO); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidUserData))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	userID := c.GetString("user_id")
+	user, err := h.UserDomainService.UpdateProfile(ctx, userID, h.UserMapper.ProfileDTOToDomain(&profileDTO), c.ClientIP())
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrRecordNotFound):
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("user"))
+		case errors.Is(err, constants.ErrInvalidData):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("body", constants.MsgInvalidUserData))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	logger.Info(c, "profile updated", "user_id", userID)
+	helper.WriteSuccessResponse(c, http.StatusOK, h.UserMapper.DomainToDTO(user, nil), "Profile updated successfully")
+}
+
+// GetMyProfileChanges godoc
+// @Summary List the profile changes of the current user
+// @Tags users
+// @ID getMyProfileChanges
+// @Produce json
+// @Success 200 {array} dto.UserProfileChangeResponse "Profile changes retrieved successfully"
+// @Failure 401 {object} helper.AppError "Unauthorized"
+// @Failure 404 {object} helper.AppError "User not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /users/me/profile-changes [get]
+// @Security BearerAuth
+func (h *UserHandler) GetMyProfileChanges(c *gin.Context) {
+	h.writeProfileChanges(c, c.GetString("user_id"))
+}
+
+// GetUserProfileChanges godoc
+// @Summary List the profile changes of a user
+// @Description Returns the changes the user made to their own profile, newest first.
+// @Tags users
+// @ID getUserProfileChanges
+// @Produce json
+// @Param id path string true "User ID (UUID)"
+// @Success 200 {array} dto.UserProfileChangeResponse "Profile changes retrieved successfully"
+// @Failure 400 {object} helper.AppError "Invalid UUID format"
+// @Failure 404 {object} helper.AppError "User not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/users/{id}/profile-changes [get]
+// @Security BearerAuth
+func (h *UserHandler) GetUserProfileChanges(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", "Invalid UUID format"))
+		return
+	}
+	h.writeProfileChanges(c, id.String())
+}
+
+// writeProfileChanges responds with the profile changes of a user.
+func (h *UserHandler) writeProfileChanges(c *gin.Context, userID string) {
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	changes, err := h.UserDomainService.GetProfileChanges(ctx, userID)
+	if err != nil {
+		if errors.Is(err, constants.ErrRecordNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("user"))
+			return
+		}
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, h.UserMapper.ProfileChangesToDTO(changes), "Profile changes retrieved successfully")
+}
+
+// IssuePasswordReset godoc
+// @Summary Issue a password reset token for a user
+// @Description Returns a single-use token the user can redeem at /users/auth/password/reset. The token is only shown once. Users who only sign in with a provider can use it to set a local password.
+// @Tags users
+// @ID issuePasswordReset
+// @Produce json
+// @Param id path string true "User ID (UUID)"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
+// @Success 201 {object} dto.PasswordResetTokenResponse "Password reset token issued"
+// @Failure 400 {object} helper.AppError "Invalid UUID format"
+// @Failure 404 {object} helper.AppError "User not found"
+// @Failure 409 {object} helper.AppError "Request with the same Idempotency-Key still in progress"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/users/{id}/password-reset [post]
+// @Security BearerAuth
+func (h *UserHandler) IssuePasswordReset(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", "Invalid UUID format"))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	token, expiresAt, err := h.CredentialDomainService.IssuePasswordReset(ctx, id.String())
+	if err != nil {
+		if errors.Is(err, constants.ErrRecordNotFound) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("user"))
+			return
+		}
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	logger.Info(c, "password reset token issued", "user_id", id.String(), "issued_by", c.GetString("user_id"))
+	middleware.MarkSecretResponse(c)
+	helper.WriteSuccessResponse(c, http.StatusCreated, dto.PasswordResetTokenResponse{Token: token, ExpiresAt: expiresAt}, "Password reset token issued")
+}
+
 // DeleteUser godoc
 // @Summary Delete a user
 // @Tags users
 // @ID deleteUser
 // @Param id path string true "User ID (UUID)"
+// @Param If-Match header string true "ETag of the user being changed"
 // @Success 204 "No Content"
 // @Failure 400 {object} helper.AppError "Invalid UUID format"
+// @Failure 409 {object} helper.AppError "User submitted match reports"
+// @Failure 412 {object} helper.AppError "The user was modified since it was read"
+// @Failure 428 {object} helper.AppError "If-Match header missing"
 // @Router /admin/users/{id} [delete]
 // @Security BearerAuth
 func (h *UserHandler) DeleteUser(c *gin.Context) {
@@ -547,6 +1233,14 @@ func (h *UserHandler) DeleteUser(c *gin.Context) {
 	defer cancel()
 
 	err = h.UserDomainService.DeleteUser(ctx, id.String())
+	if errors.Is(err, constants.ErrVersionMismatch) {
+		helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("user"))
+		return
+	}
+	if errors.Is(err, constants.ErrRecordInUse) {
+		helper.WriteErrorResponse(c, helper.NewConflictError("user", "The user submitted match reports and cannot be deleted"))
+		return
+	}
 	if err != nil && !errors.Is(err, constants.ErrRecordNotFound) {
 		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
 		return
@@ -0,0 +1,268 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	httpMapper "github.com/EdwinRincon/browersfc-api/adapter/http"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/dto"
+	"github.com/EdwinRincon/browersfc-api/helper"
+	domainservice "github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+type VenueHandler struct {
+	VenueDomainService *domainservice.VenueDomainService
+	VenueMapper        *httpMapper.VenueHTTPMapper
+}
+
+func NewVenueHandler(venueDomainService *domainservice.VenueDomainService) *VenueHandler {
+	return &VenueHandler{
+		VenueDomainService: venueDomainService,
+		VenueMapper:        httpMapper.NewVenueHTTPMapper(),
+	}
+}
+
+// CreateVenue godoc
+// @Summary Create a new venue
+// @Tags venues
+// @ID createVenue
+// @Accept json
+// @Produce json
+// @Param venue body dto.CreateVenueRequest true "Venue data"
+// @Param Idempotency-Key header string false "Key that makes retries of the request safe"
+// @Success 201 {object} dto.VenueResponse "Created"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 409 {object} helper.AppError "Conflict (e.g., venue name exists)"
+// @Failure 422 {object} helper.AppError "Idempotency-Key already used for a different request"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/venues [post]
+// @Security BearerAuth
+func (h *VenueHandler) CreateVenue(c *gin.Context) {
+	var createRequest dto.CreateVenueRequest
+	if err := c.ShouldBindJSON(&createRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidVenueData))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	venue := h.VenueMapper.DTOToDomain(&createRequest)
+
+	createdVenue, err := h.VenueDomainService.CreateVenue(ctx, venue)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrRecordAlreadyExists):
+			helper.WriteErrorResponse(c, helper.NewConflictError("venue", "A venue with this name already exists"))
+		case errors.Is(err, constants.ErrInvalidData):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("body", constants.MsgInvalidVenueData))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusCreated, h.VenueMapper.DomainToDTO(createdVenue), "Venue created successfully")
+}
+
+// GetVenueByID godoc
+// @Summary Get a venue by ID
+// @Tags venues
+// @ID getVenueByID
+// @Produce json
+// @Param id path int true "Venue ID"
+// @Param If-None-Match header string false "ETag from a previous response"
+// @Success 200 {object} dto.VenueResponse "Success"
+// @Success 304 "Not Modified"
+// @Header 200 {string} ETag "Version of the venue"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 404 {object} helper.AppError "Venue not found"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /venues/{id} [get]
+func (h *VenueHandler) GetVenueByID(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidVenueID))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	venue, err := h.VenueDomainService.GetVenueByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, constants.ErrRecordNotFound) || errors.Is(err, constants.ErrInvalidID) {
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("venue"))
+		} else {
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	if helper.WriteNotModified(c, venue.Version) {
+		return
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, h.VenueMapper.DomainToDTO(venue), "Venue found successfully")
+}
+
+// GetPaginatedVenues godoc
+// @Summary Get paginated venues
+// @Tags venues
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(0)
+// @Param pageSize query int false "Page size" default(10)
+// @Param sort query string false "Sort field"
+// @Param order query string false "Sort order" Enums(asc, desc) default(asc)
+// @Success 200 {object} helper.AppSuccess{data=helper.PaginatedResponse{items=[]dto.VenueResponse, totalCount=int}}
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /venues [get]
+func (h *VenueHandler) GetPaginatedVenues(c *gin.Context) {
+	sort := c.DefaultQuery("sort", "name")
+	order := c.DefaultQuery("order", "asc")
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "0"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("pageSize", "10"))
+
+	if page < 0 {
+		page = 0
+	}
+	if pageSize < 1 || pageSize > 200 {
+		pageSize = 10
+	}
+	if order != "asc" && order != "desc" {
+		order = "asc"
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	venues, total, err := h.VenueDomainService.GetPaginatedVenues(ctx, sort, order, page, pageSize)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		return
+	}
+
+	response := helper.PaginatedResponse{
+		Items:      h.VenueMapper.DomainListToDTO(venues),
+		TotalCount: total,
+	}
+
+	helper.WriteSuccessResponse(c, http.StatusOK, response, "Venues retrieved successfully")
+}
+
+// UpdateVenue godoc
+// @Summary Update a venue
+// @Tags venues
+// @ID updateVenue
+// @Accept json
+// @Produce json
+// @Param id path int true "Venue ID"
+// @Param venue body dto.UpdateVenueRequest true "Updated venue data"
+// @Param If-Match header string true "ETag of the venue being changed"
+// @Success 200 {object} dto.VenueResponse "Updated"
+// @Header 200 {string} ETag "New version of the venue"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 404 {object} helper.AppError "Venue not found"
+// @Failure 409 {object} helper.AppError "Conflict (e.g., venue name exists)"
+// @Failure 412 {object} helper.AppError "The venue was modified since it was read"
+// @Failure 428 {object} helper.AppError "If-Match header missing"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/venues/{id} [put]
+// @Security BearerAuth
+func (h *VenueHandler) UpdateVenue(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidVenueID))
+		return
+	}
+
+	var updateRequest dto.UpdateVenueRequest
+	if err = c.ShouldBindJSON(&updateRequest); err != nil {
+		helper.WriteErrorResponse(c, helper.BuildValidationErrorFromBinding(err, "body", constants.MsgInvalidVenueData))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	updates := h.VenueMapper.UpdateDTOToDomain(&updateRequest)
+
+	updatedVenue, err := h.VenueDomainService.UpdateVenue(ctx, id, updates)
+	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("venue"))
+			return
+		}
+		switch {
+		case errors.Is(err, constants.ErrRecordNotFound):
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("venue"))
+		case errors.Is(err, constants.ErrRecordAlreadyExists):
+			helper.WriteErrorResponse(c, helper.NewConflictError("venue", "A venue with this name already exists"))
+		case errors.Is(err, constants.ErrInvalidData):
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("body", constants.MsgInvalidVenueData))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	helper.SetETag(c, updatedVenue.Version)
+	helper.WriteSuccessResponse(c, http.StatusOK, h.VenueMapper.DomainToDTO(updatedVenue), "Venue updated successfully")
+}
+
+// DeleteVenue godoc
+// @Summary Delete a venue
+// @Tags venues
+// @ID deleteVenue
+// @Param id path int true "Venue ID"
+// @Param If-Match header string true "ETag of the venue being changed"
+// @Success 204 "No Content"
+// @Failure 400 {object} helper.AppError "Invalid input"
+// @Failure 404 {object} helper.AppError "Venue not found"
+// @Failure 409 {object} helper.AppError "Venue is used by matches, including matches in the trash"
+// @Failure 412 {object} helper.AppError "The venue was modified since it was read"
+// @Failure 428 {object} helper.AppError "If-Match header missing"
+// @Failure 500 {object} helper.AppError "Internal server error"
+// @Router /admin/venues/{id} [delete]
+// @Security BearerAuth
+func (h *VenueHandler) DeleteVenue(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		helper.WriteErrorResponse(c, helper.NewBadRequestError("id", constants.MsgInvalidVenueID))
+		return
+	}
+
+	// Wrap context with timeout for DB/service calls
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+
+	err = h.VenueDomainService.DeleteVenue(ctx, id)
+	if err != nil {
+		if errors.Is(err, constants.ErrVersionMismatch) {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("venue"))
+			return
+		}
+		switch {
+		case errors.Is(err, constants.ErrRecordNotFound):
+			helper.WriteErrorResponse(c, helper.NewNotFoundError("venue"))
+		case errors.Is(err, constants.ErrVenueInUse):
+			helper.WriteErrorResponse(c, helper.NewConflictError("venue", "The venue is referenced by existing matches"))
+		default:
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(err))
+		}
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
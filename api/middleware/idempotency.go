@@ -0,0 +1,194 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/helper"
+	"github.com/EdwinRincon/browersfc-api/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	idempotencyKeyHeader     = "Idempotency-Key"
+	idempotentReplayedHeader = "Idempotent-Replayed"
+	maxIdempotencyKeyLength  = 255
+
+	// secretResponseKey is set by the handlers whose response holds a secret, see MarkSecretResponse.
+	secretResponseKey = "secret_response"
+)
+
+// IdempotencyKeys replays the response of admin requests retried with the same Idempotency-Key.
+type IdempotencyKeys struct {
+	store domain.IdempotencyStore
+	ttl   time.Duration
+}
+
+// NewIdempotencyKeys creates the idempotency keys backed by the given store. A response is
+// replayed for ttl after the first request was received.
+func NewIdempotencyKeys(store domain.IdempotencyStore, ttl time.Duration) *IdempotencyKeys {
+	return &IdempotencyKeys{store: store, ttl: ttl}
+}
+
+// PurgeExpired removes the requests whose keys can no longer be replayed.
+func (k *IdempotencyKeys) PurgeExpired(ctx context.Context) (int64, error) {
+	return k.store.DeleteExpiredIdempotencyKeys(ctx, time.Now())
+}
+
+// idempotencyWriter keeps a copy of the response body so that it can be replayed.
+type idempotencyWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *idempotencyWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *idempotencyWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// Idempotency makes the POST requests of an admin route group safe to retry. The response
+// of a request sent with an Idempotency-Key header is stored, and a later request from the
+// same API key or user with the same key gets that response again without being handled.
+// Reusing a key for a different request is rejected with 422, and a retry sent while the
+// first request is still being handled with 409. Server errors are not stored, so the
+// request can be retried with the same key. The body of a response marked with
+// MarkSecretResponse is not stored, and a retry of that request is rejected with 409.
+// It must run after JwtAuthMiddleware.
+// Store failures are logged and the request is handled without the key.
+func Idempotency(keys *IdempotencyKeys) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader(idempotencyKeyHeader)
+		if c.Request.Method != http.MethodPost || header == "" {
+			c.Next()
+			return
+		}
+		if len(header) > maxIdempotencyKeyLength {
+			helper.WriteErrorResponse(c, helper.NewBadRequestError(idempotencyKeyHeader, "Idempotency-Key is too long"))
+			c.Abort()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			helper.WriteErrorResponse(c, helper.NewBadRequestError("body", "Invalid request body"))
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		now := time.Now()
+		request := &domain.IdempotentRequest{
+			Key:         hashParts(rateLimitKeyByClient(c), header),
+			Fingerprint: hashParts(c.Request.Method, c.Request.URL.RequestURI(), string(body)),
+			ExpiresAt:   now.Add(keys.ttl),
+		}
+
+		existing, err := keys.store.ClaimIdempotencyKey(c.Request.Context(), request, now)
+		if err != nil {
+			logger.Error(c, "idempotency key check failed", "error", err)
+			c.Next()
+			return
+		}
+		if existing != nil {
+			replayIdempotentRequest(c, request, existing)
+			return
+		}
+
+		writer := &idempotencyWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		// The key is released if the handler panics, so that the request can be retried
+		ctx := context.WithoutCancel(c.Request.Context())
+		completed := false
+		defer func() {
+			if !completed {
+				keys.release(ctx, c, request.Key)
+			}
+		}()
+
+		c.Next()
+		completed = true
+
+		status := writer.Status()
+		if status >= http.StatusInternalServerError {
+			keys.release(ctx, c, request.Key)
+			return
+		}
+		request.StatusCode = status
+		request.ContentType = writer.Header().Get("Content-Type")
+		request.Location = writer.Header().Get("Location")
+		request.ETag = writer.Header().Get("ETag")
+		if c.GetBool(secretResponseKey) {
+			request.BodyWithheld = true
+		} else {
+			request.Body = writer.body.Bytes()
+		}
+		if err := keys.store.CompleteIdempotentRequest(ctx, request); err != nil {
+			logger.Error(c, "failed to store idempotent response", "error", err)
+		}
+	}
+}
+
+// replayIdempotentRequest answers a request whose key was already used.
+func replayIdempotentRequest(c *gin.Context, request, existing *domain.IdempotentRequest) {
+	if existing.Fingerprint != request.Fingerprint {
+		helper.WriteErrorResponse(c, helper.NewUnprocessableEntityError("The Idempotency-Key was already used for a different request"))
+		c.Abort()
+		return
+	}
+	if !existing.IsCompleted() {
+		helper.WriteErrorResponse(c, helper.NewConflictError("Request", "A request with this Idempotency-Key is still being processed, try again later"))
+		c.Abort()
+		return
+	}
+
+	if existing.BodyWithheld {
+		helper.WriteErrorResponse(c, helper.NewConflictError("Request", "The response of the request with this Idempotency-Key held a secret and cannot be replayed"))
+		c.Abort()
+		return
+	}
+
+	c.Header(idempotentReplayedHeader, "true")
+	if existing.Location != "" {
+		c.Header("Location", existing.Location)
+	}
+	if existing.ETag != "" {
+		c.Header("ETag", existing.ETag)
+	}
+	c.Data(existing.StatusCode, existing.ContentType, existing.Body)
+	c.Abort()
+}
+
+// MarkSecretResponse reports to Idempotency that the response of the handler holds a
+// secret, such as a new API key, so that its body is never stored.
+func MarkSecretResponse(c *gin.Context) {
+	c.Set(secretResponseKey, true)
+}
+
+// release deletes a claimed key. When that fails the key stays pending until it expires.
+func (k *IdempotencyKeys) release(ctx context.Context, c *gin.Context, key string) {
+	if err := k.store.ReleaseIdempotencyKey(ctx, key); err != nil {
+		logger.Error(c, "failed to release idempotency key", "error", err)
+	}
+}
+
+// hashParts returns the hex encoded SHA-256 of the parts separated by newlines.
+func hashParts(parts ...string) string {
+	hash := sha256.New()
+	for _, part := range parts {
+		hash.Write([]byte(part))
+		hash.Write([]byte{'\n'})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
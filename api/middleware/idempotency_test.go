@@ -0,0 +1,263 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// fakeIdempotencyStore keeps the requests by key, or fails every call when err is set.
+type fakeIdempotencyStore struct {
+	mu       sync.Mutex
+	requests map[string]*domain.IdempotentRequest
+	err      error
+}
+
+func (s *fakeIdempotencyStore) ClaimIdempotencyKey(_ context.Context, request *domain.IdempotentRequest, now time.Time) (*domain.IdempotentRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	if existing, ok := s.requests[request.Key]; ok && !existing.IsExpired(now) {
+		copied := *existing
+		return &copied, nil
+	}
+	copied := *request
+	s.requests[request.Key] = &copied
+	return nil, nil
+}
+
+func (s *fakeIdempotencyStore) CompleteIdempotentRequest(_ context.Context, request *domain.IdempotentRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	copied := *request
+	copied.Body = append([]byte(nil), request.Body...)
+	s.requests[request.Key] = &copied
+	return nil
+}
+
+func (s *fakeIdempotencyStore) ReleaseIdempotencyKey(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.requests, key)
+	return nil
+}
+
+func (s *fakeIdempotencyStore) DeleteExpiredIdempotencyKeys(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+type idempotentRequest struct {
+	method       string // POST when empty
+	path         string // "/teams" when empty
+	user         string // "admin-1" when empty
+	key          string
+	body         string
+	wantStatus   int
+	wantReplayed bool
+	wantBody     string // Not checked when empty
+	wantLocation string // Not checked when empty
+}
+
+func TestIdempotency(t *testing.T) {
+	const firstKey = "key-1"
+	firstBody := `{"name":"Browers"}`
+
+	tests := []struct {
+		name string
+		// seeded is stored for firstKey and firstBody sent by admin-1 to /teams before the requests
+		seeded    *domain.IdempotentRequest
+		storeErr  error
+		requests  []idempotentRequest
+		wantCalls int // Requests that reached the handler
+	}{
+		{
+			name: "retry replays the response",
+			requests: []idempotentRequest{
+				{key: firstKey, body: firstBody, wantStatus: http.StatusCreated, wantBody: `{"id":1}`, wantLocation: "/teams/1"},
+				{key: firstKey, body: firstBody, wantStatus: http.StatusCreated, wantReplayed: true, wantBody: `{"id":1}`, wantLocation: "/teams/1"},
+			},
+			wantCalls: 1,
+		},
+		{
+			name: "key reused with a different body",
+			requests: []idempotentRequest{
+				{key: firstKey, body: firstBody, wantStatus: http.StatusCreated},
+				{key: firstKey, body: `{"name":"Other"}`, wantStatus: http.StatusUnprocessableEntity},
+			},
+			wantCalls: 1,
+		},
+		{
+			name: "key reused on a different route",
+			requests: []idempotentRequest{
+				{key: firstKey, body: firstBody, wantStatus: http.StatusCreated},
+				{path: "/players", key: firstKey, body: firstBody, wantStatus: http.StatusUnprocessableEntity},
+			},
+			wantCalls: 1,
+		},
+		{
+			name: "keys are scoped to the client",
+			requests: []idempotentRequest{
+				{key: firstKey, body: firstBody, wantStatus: http.StatusCreated},
+				{user: "admin-2", key: firstKey, body: firstBody, wantStatus: http.StatusCreated, wantBody: `{"id":2}`},
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "requests without a key are handled every time",
+			requests: []idempotentRequest{
+				{body: firstBody, wantStatus: http.StatusCreated},
+				{body: firstBody, wantStatus: http.StatusCreated},
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "only POST requests are replayed",
+			requests: []idempotentRequest{
+				{method: http.MethodPut, key: firstKey, body: firstBody, wantStatus: http.StatusCreated},
+				{method: http.MethodPut, key: firstKey, body: firstBody, wantStatus: http.StatusCreated},
+			},
+			wantCalls: 2,
+		},
+		{
+			name:   "retry while the first request is pending",
+			seeded: &domain.IdempotentRequest{ExpiresAt: time.Now().Add(time.Hour)},
+			requests: []idempotentRequest{
+				{key: firstKey, body: firstBody, wantStatus: http.StatusConflict},
+			},
+		},
+		{
+			name: "expired key is claimed again",
+			seeded: &domain.IdempotentRequest{
+				StatusCode: http.StatusCreated,
+				Body:       []byte(`{"id":99}`),
+				ExpiresAt:  time.Now().Add(-time.Second),
+			},
+			requests: []idempotentRequest{
+				{key: firstKey, body: firstBody, wantStatus: http.StatusCreated, wantBody: `{"id":1}`},
+			},
+			wantCalls: 1,
+		},
+		{
+			name: "server error releases the key",
+			requests: []idempotentRequest{
+				{path: "/flaky", key: firstKey, body: firstBody, wantStatus: http.StatusInternalServerError},
+				{path: "/flaky", key: firstKey, body: firstBody, wantStatus: http.StatusCreated, wantBody: `{"id":2}`},
+				{path: "/flaky", key: firstKey, body: firstBody, wantStatus: http.StatusCreated, wantReplayed: true, wantBody: `{"id":2}`},
+			},
+			wantCalls: 2,
+		},
+		{
+			name: "secret response is not replayed",
+			requests: []idempotentRequest{
+				{path: "/api-keys", key: firstKey, body: firstBody, wantStatus: http.StatusCreated, wantBody: `{"key":"secret-1"}`},
+				{path: "/api-keys", key: firstKey, body: firstBody, wantStatus: http.StatusConflict},
+			},
+			wantCalls: 1,
+		},
+		{
+			name: "key too long",
+			requests: []idempotentRequest{
+				{key: strings.Repeat("k", maxIdempotencyKeyLength+1), body: firstBody, wantStatus: http.StatusBadRequest},
+			},
+		},
+		{
+			name:     "store failures handle the request without the key",
+			storeErr: errors.New("connection refused"),
+			requests: []idempotentRequest{
+				{key: firstKey, body: firstBody, wantStatus: http.StatusCreated},
+				{key: firstKey, body: firstBody, wantStatus: http.StatusCreated, wantBody: `{"id":2}`},
+			},
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &fakeIdempotencyStore{requests: make(map[string]*domain.IdempotentRequest), err: tt.storeErr}
+			if tt.seeded != nil {
+				tt.seeded.Key = hashParts("user:admin-1", firstKey)
+				tt.seeded.Fingerprint = hashParts(http.MethodPost, "/teams", firstBody)
+				store.requests[tt.seeded.Key] = tt.seeded
+			}
+
+			calls := 0
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				c.Set(userIDKey, c.GetHeader("X-Test-User"))
+			}, Idempotency(NewIdempotencyKeys(store, time.Hour)))
+			router.Any("/*path", func(c *gin.Context) {
+				calls++
+				switch c.Param("path") {
+				case "/flaky":
+					if calls == 1 {
+						c.JSON(http.StatusInternalServerError, gin.H{"error": "database unavailable"})
+						return
+					}
+				case "/api-keys":
+					MarkSecretResponse(c)
+					c.JSON(http.StatusCreated, gin.H{"key": fmt.Sprintf("secret-%d", calls)})
+					return
+				}
+				c.Header("Location", fmt.Sprintf("/teams/%d", calls))
+				c.Header("ETag", fmt.Sprintf(`"%d"`, calls))
+				c.JSON(http.StatusCreated, gin.H{"id": calls})
+			})
+
+			for i, r := range tt.requests {
+				method, path, user := r.method, r.path, r.user
+				if method == "" {
+					method = http.MethodPost
+				}
+				if path == "" {
+					path = "/teams"
+				}
+				if user == "" {
+					user = "admin-1"
+				}
+				req := httptest.NewRequest(method, path, strings.NewReader(r.body))
+				req.Header.Set("X-Test-User", user)
+				if r.key != "" {
+					req.Header.Set(idempotencyKeyHeader, r.key)
+				}
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+
+				if w.Code != r.wantStatus {
+					t.Fatalf("request %d: status = %d, want %d: %s", i, w.Code, r.wantStatus, w.Body.String())
+				}
+				if replayed := w.Header().Get(idempotentReplayedHeader) == "true"; replayed != r.wantReplayed {
+					t.Errorf("request %d: replayed = %v, want %v", i, replayed, r.wantReplayed)
+				}
+				if r.wantBody != "" && w.Body.String() != r.wantBody {
+					t.Errorf("request %d: body = %s, want %s", i, w.Body.String(), r.wantBody)
+				}
+				if r.wantLocation != "" && w.Header().Get("Location") != r.wantLocation {
+					t.Errorf("request %d: Location = %q, want %q", i, w.Header().Get("Location"), r.wantLocation)
+				}
+				if r.wantReplayed && w.Header().Get("ETag") == "" {
+					t.Errorf("request %d: ETag was not replayed", i)
+				}
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("handler called %d times, want %d", calls, tt.wantCalls)
+			}
+			for _, request := range store.requests {
+				if request.BodyWithheld && request.Body != nil {
+					t.Errorf("stored the body %s of a secret response", request.Body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/EdwinRincon/browersfc-api/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	logger.Setup(logger.LogConfig{Format: logger.TextFormat, Output: io.Discard})
+	os.Exit(m.Run())
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/EdwinRincon/browersfc-api/config"
+	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/EdwinRincon/browersfc-api/helper"
 	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
 	"github.com/EdwinRincon/browersfc-api/pkg/logger"
@@ -17,15 +18,28 @@ import (
 
 // Context keys for storing user information in Gin context
 const (
-	usernameKey  = "username"
-	roleKey      = "role"
-	bearerPrefix = "Bearer "
+	userIDKey         = "user_id"
+	usernameKey       = "username"
+	roleKey           = "role"
+	permissionsKey    = "permissions"
+	tokenIDKey        = "token_id"
+	sessionIDKey      = "session_id"
+	apiKeyIDKey       = "api_key_id"
+	tokenExpiresAtKey = "token_expires_at"
+	bearerPrefix      = "Bearer "
+	apiKeyHeader      = "X-API-Key"
 )
 
 // JwtAuthMiddleware authenticates requests using JWT tokens through the domain service.
 // It supports both cookie-based authentication (preferred) and Authorization header for API calls.
+// Machine clients may instead send a personal API key in the X-API-Key header.
 func JwtAuthMiddleware(authService *service.AuthenticationDomainService) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if apiKey := c.GetHeader(apiKeyHeader); apiKey != "" {
+			authenticateAPIKey(c, authService, apiKey)
+			return
+		}
+
 		var tokenString string
 
 		// First, try to get token from cookie (preferred method)
@@ -64,9 +78,7 @@ func JwtAuthMiddleware(authService *service.AuthenticationDomainService) gin.Han
 			return
 		}
 
-		// Store claims in context using string keys
-		c.Set(usernameKey, authClaims.Username)
-		c.Set(roleKey, authClaims.Role)
+		setAuthenticationClaims(c, authClaims)
 
 		logger.Debug(c, "Successfully authenticated request",
 			"username", authClaims.Username,
@@ -76,31 +88,74 @@ func JwtAuthMiddleware(authService *service.AuthenticationDomainService) gin.Han
 	}
 }
 
-// RBACMiddleware implements Role-Based Access Control by checking if the
-// authenticated user's role is allowed to access the requested resource.
-func RBACMiddleware(allowedRoles ...string) gin.HandlerFunc {
+// authenticateAPIKey authenticates the request with a personal API key.
+func authenticateAPIKey(c *gin.Context, authService *service.AuthenticationDomainService, apiKey string) {
+	authClaims, err := authService.AuthenticateAPIKey(c.Request.Context(), apiKey)
+	if err != nil {
+		logger.Debug(c, "API key validation failed",
+			"error", err.Error(),
+			"ip", c.ClientIP(),
+			"user_agent", c.Request.UserAgent())
+
+		helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Invalid, expired or revoked API key"))
+		c.Abort()
+		return
+	}
+
+	setAuthenticationClaims(c, authClaims)
+
+	logger.Debug(c, "Successfully authenticated request with API key",
+		"username", authClaims.Username,
+		"api_key_id", authClaims.APIKeyID)
+
+	c.Next()
+}
+
+// setAuthenticationClaims stores the claims in the context using string keys.
+func setAuthenticationClaims(c *gin.Context, authClaims *domain.AuthenticationClaims) {
+	c.Set(userIDKey, authClaims.UserID)
+	c.Set(usernameKey, authClaims.Username)
+	c.Set(roleKey, authClaims.Role)
+	c.Set(permissionsKey, authClaims.Permissions)
+	c.Set(tokenIDKey, authClaims.TokenID)
+	c.Set(sessionIDKey, authClaims.SessionID)
+	c.Set(apiKeyIDKey, authClaims.APIKeyID)
+	c.Set(tokenExpiresAtKey, authClaims.ExpiresAt)
+
+	// Domain services attribute the changes of the request to the actor in the audit log
+	c.Request = c.Request.WithContext(domain.WithAuditActor(c.Request.Context(), domain.AuditActor{
+		UserID:    authClaims.UserID,
+		APIKeyID:  authClaims.APIKeyID,
+		RequestID: c.GetString(RequestIDKey),
+		IPAddress: c.ClientIP(),
+	}))
+}
+
+// RequirePermission authorizes requests whose token carries the given permission.
+// Permissions are granted to roles and embedded in the JWT claims at login, so
+// custom roles work without changing the routes.
+func RequirePermission(permission string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		role, exists := c.Get(roleKey)
+		value, exists := c.Get(permissionsKey)
 		if !exists {
 			helper.WriteErrorResponse(c, helper.NewUnauthorizedError("Authentication required"))
 			c.Abort()
 			return
 		}
 
-		userRole, ok := role.(string)
+		permissions, ok := value.([]string)
 		if !ok {
-			helper.WriteErrorResponse(c, helper.NewInternalServerError(errors.New("invalid role format")))
+			helper.WriteErrorResponse(c, helper.NewInternalServerError(errors.New("invalid permissions format")))
 			c.Abort()
 			return
 		}
 
 		username, _ := c.Get(usernameKey)
 
-		// Check if user's role is allowed
-		if slices.Contains(allowedRoles, userRole) {
+		if slices.Contains(permissions, permission) {
 			logger.Info(c, "Access granted",
 				"username", username,
-				"role", userRole,
+				"permission", permission,
 				"path", c.Request.URL.Path,
 				"method", c.Request.Method)
 			c.Next()
@@ -110,8 +165,8 @@ func RBACMiddleware(allowedRoles ...string) gin.HandlerFunc {
 		// Log access denied event as warning since it may indicate security issues
 		logger.Warn(c, "Access denied",
 			"username", username,
-			"role", userRole,
-			"allowedRoles", allowedRoles,
+			"role", c.GetString(roleKey),
+			"requiredPermission", permission,
 			"path", c.Request.URL.Path,
 			"method", c.Request.Method,
 			"ip", c.ClientIP())
@@ -121,6 +176,35 @@ func RBACMiddleware(allowedRoles ...string) gin.HandlerFunc {
 	}
 }
 
+// RequireIfMatch makes writes conditional on the version the client last read. Requests
+// without an If-Match header are rejected with 428 Precondition Required; the versions it
+// names are passed to the domain services, which fail with 412 Precondition Failed when the
+// record has changed since. "If-Match: *" applies the write to any version.
+func RequireIfMatch() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := strings.TrimSpace(c.GetHeader("If-Match"))
+		if header == "" {
+			helper.WriteErrorResponse(c, helper.NewPreconditionRequiredError("Send the ETag of the resource in the If-Match header"))
+			c.Abort()
+			return
+		}
+		if header == "*" {
+			c.Next()
+			return
+		}
+
+		versions := helper.ParseETags(header, false)
+		if len(versions) == 0 {
+			helper.WriteErrorResponse(c, helper.NewPreconditionFailedError("resource"))
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(domain.WithExpectedVersions(c.Request.Context(), versions))
+		c.Next()
+	}
+}
+
 // SecurityHeadersMiddleware returns a middleware function that sets various security headers.
 //
 // X-Frame-Options: Prevents clickjacking attacks.
@@ -198,8 +282,8 @@ func CORSMiddleware() gin.HandlerFunc {
 	return cors.New(cors.Config{
 		AllowOrigins:     allowedOrigins,
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With"},
-		ExposeHeaders:    []string{"Content-Length", "Content-Type"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With", "X-API-Key", "If-Match", "If-None-Match", "Idempotency-Key"},
+		ExposeHeaders:    []string{"Content-Length", "Content-Type", "ETag", "Idempotent-Replayed", "RateLimit-Policy", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "Retry-After"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 		AllowWildcard:    false, // Explicitly disable wildcard matching for security
@@ -0,0 +1,173 @@
+package middleware
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/helper"
+	"github.com/EdwinRincon/browersfc-api/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// newAccountKey is set by the handlers that created an account, see MarkNewAccount.
+const newAccountKey = "new_account"
+
+// RateLimitPolicies holds the token bucket policy of each route group.
+type RateLimitPolicies struct {
+	API         domain.RateLimitPolicy // Every request, per IP address
+	Auth        domain.RateLimitPolicy // /users/auth/*, per IP address
+	AdminWrites domain.RateLimitPolicy // Admin writes, per API key or user
+	NewAccounts domain.RateLimitPolicy // Accounts created, per IP address
+}
+
+// RateLimiter enforces the rate limit policies with the buckets of a store.
+type RateLimiter struct {
+	store    domain.RateLimitStore
+	policies RateLimitPolicies
+}
+
+// rateLimitKeyFunc returns the client a request is counted for.
+type rateLimitKeyFunc func(c *gin.Context) string
+
+// NewRateLimiter creates a rate limiter backed by the given store.
+func NewRateLimiter(store domain.RateLimitStore, policies RateLimitPolicies) *RateLimiter {
+	return &RateLimiter{store: store, policies: policies}
+}
+
+// PurgeIdleBuckets removes the buckets that have been full for a whole window, since
+// they hold no more information than a new bucket.
+func (l *RateLimiter) PurgeIdleBuckets(ctx context.Context) (int64, error) {
+	window := max(l.policies.API.Window, l.policies.Auth.Window, l.policies.AdminWrites.Window, l.policies.NewAccounts.Window)
+	return l.store.DeleteIdleRateLimitBuckets(ctx, time.Now().Add(-window))
+}
+
+// RateLimitAPI limits every request per IP address. It runs before authentication,
+// so it cannot tell users behind the same address apart.
+func RateLimitAPI(limiter *RateLimiter) gin.HandlerFunc {
+	return limiter.limit(limiter.policies.API, rateLimitKeyByIP)
+}
+
+// RateLimitAuth applies the stricter limit of the login and registration routes per IP address.
+func RateLimitAuth(limiter *RateLimiter) gin.HandlerFunc {
+	return limiter.limit(limiter.policies.Auth, rateLimitKeyByIP)
+}
+
+// RateLimitAdminWrites limits the write requests of an admin route group per API key or
+// user. It must run after JwtAuthMiddleware; reads are not counted.
+func RateLimitAdminWrites(limiter *RateLimiter) gin.HandlerFunc {
+	limit := limiter.limit(limiter.policies.AdminWrites, rateLimitKeyByClient)
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			c.Next()
+		default:
+			limit(c)
+		}
+	}
+}
+
+// RateLimitNewAccounts limits how many accounts can be created from an IP address.
+// Requests are rejected once the limit is reached, but a token is only taken when the
+// handler reports that it created an account, so returning users can keep signing in.
+// Concurrent sign ups may exceed the limit by the number of requests in flight.
+func RateLimitNewAccounts(limiter *RateLimiter) gin.HandlerFunc {
+	policy := limiter.policies.NewAccounts
+	return func(c *gin.Context) {
+		key := policy.Name + ":" + rateLimitKeyByIP(c)
+
+		decision, err := limiter.store.TakeRateLimitToken(c.Request.Context(), key, policy, 0, time.Now())
+		if err != nil {
+			logger.Error(c, "rate limit check failed", "policy", policy.Name, "error", err)
+			c.Next()
+			return
+		}
+		if !decision.Allowed {
+			rejectRateLimited(c, policy, decision, "Too many accounts created from this address, try again later")
+			return
+		}
+
+		c.Next()
+
+		if c.GetBool(newAccountKey) {
+			if _, err := limiter.store.TakeRateLimitToken(context.WithoutCancel(c.Request.Context()), key, policy, 1, time.Now()); err != nil {
+				logger.Error(c, "failed to count new account", "policy", policy.Name, "error", err)
+			}
+		}
+	}
+}
+
+// MarkNewAccount reports to RateLimitNewAccounts that the handler created an account, so
+// it is counted against the limit of the client.
+func MarkNewAccount(c *gin.Context) {
+	c.Set(newAccountKey, true)
+}
+
+// limit takes a token from the client's bucket and rejects the request when it is empty.
+// Store failures are logged and the request is let through rather than failing the API.
+func (l *RateLimiter) limit(policy domain.RateLimitPolicy, keyFunc rateLimitKeyFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := policy.Name + ":" + keyFunc(c)
+
+		decision, err := l.store.TakeRateLimitToken(c.Request.Context(), key, policy, 1, time.Now())
+		if err != nil {
+			logger.Error(c, "rate limit check failed", "policy", policy.Name, "error", err)
+			c.Next()
+			return
+		}
+
+		if !decision.Allowed {
+			rejectRateLimited(c, policy, decision, "Rate limit exceeded, try again later")
+			return
+		}
+
+		setRateLimitHeaders(c, policy, decision)
+		c.Next()
+	}
+}
+
+// rejectRateLimited answers 429 with the rate limit headers and Retry-After.
+func rejectRateLimited(c *gin.Context, policy domain.RateLimitPolicy, decision domain.RateLimitDecision, detail string) {
+	logger.Warn(c, "rate limit exceeded",
+		"policy", policy.Name,
+		"ip", c.ClientIP(),
+		"path", c.Request.URL.Path)
+
+	setRateLimitHeaders(c, policy, decision)
+	c.Header("Retry-After", strconv.Itoa(max(ceilSeconds(decision.RetryAfter), 1)))
+	helper.WriteErrorResponse(c, helper.NewTooManyRequestsError(detail))
+	c.Abort()
+}
+
+// setRateLimitHeaders sets the RateLimit-* headers of the IETF RateLimit header fields draft.
+func setRateLimitHeaders(c *gin.Context, policy domain.RateLimitPolicy, decision domain.RateLimitDecision) {
+	c.Header("RateLimit-Policy", strconv.Itoa(policy.Limit)+";w="+strconv.Itoa(ceilSeconds(policy.Window)))
+	c.Header("RateLimit-Limit", strconv.Itoa(decision.Limit))
+	c.Header("RateLimit-Remaining", strconv.Itoa(decision.Remaining))
+	c.Header("RateLimit-Reset", strconv.Itoa(ceilSeconds(decision.ResetAfter)))
+}
+
+// rateLimitKeyByIP counts requests per client IP address. Forwarded headers are only used
+// when they come from a proxy listed in TRUSTED_PROXIES, so clients cannot rotate the key.
+func rateLimitKeyByIP(c *gin.Context) string {
+	return "ip:" + c.ClientIP()
+}
+
+// rateLimitKeyByClient counts requests per API key or user, falling back to the IP address.
+func rateLimitKeyByClient(c *gin.Context) string {
+	if apiKeyID := c.GetString(apiKeyIDKey); apiKeyID != "" {
+		return "key:" + apiKeyID
+	}
+	if userID := c.GetString(userIDKey); userID != "" {
+		return "user:" + userID
+	}
+	return rateLimitKeyByIP(c)
+}
+
+// ceilSeconds rounds a duration up to whole seconds.
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,179 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// fakeRateLimitStore keeps the buckets in a map, or fails every call when err is set.
+type fakeRateLimitStore struct {
+	mu      sync.Mutex
+	buckets map[string]domain.RateLimitBucket
+	err     error
+}
+
+func (s *fakeRateLimitStore) TakeRateLimitToken(_ context.Context, key string, policy domain.RateLimitPolicy, cost int, now time.Time) (domain.RateLimitDecision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return domain.RateLimitDecision{}, s.err
+	}
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = policy.NewBucket(now)
+	}
+	decision := policy.Take(&bucket, cost, now)
+	s.buckets[key] = bucket
+	return decision, nil
+}
+
+func (s *fakeRateLimitStore) DeleteIdleRateLimitBuckets(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+type rateLimitRequest struct {
+	method        string
+	path          string // "/signup" reports a new account, any other path does not
+	ip            string
+	user          string // Authenticated user, none when empty
+	wantStatus    int
+	wantRemaining string // RateLimit-Remaining header, not checked when empty
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	// Two requests per hour, so buckets do not refill during the test
+	policy := func(name string) domain.RateLimitPolicy {
+		return domain.RateLimitPolicy{Name: name, Limit: 2, Window: time.Hour}
+	}
+	policies := RateLimitPolicies{
+		API:         policy("api"),
+		Auth:        policy("auth"),
+		AdminWrites: policy("admin_writes"),
+		NewAccounts: policy("new_accounts"),
+	}
+
+	tests := []struct {
+		name       string
+		middleware func(*RateLimiter) gin.HandlerFunc
+		storeErr   error
+		requests   []rateLimitRequest
+	}{
+		{
+			name:       "rejects once the bucket is empty",
+			middleware: RateLimitAPI,
+			requests: []rateLimitRequest{
+				{method: http.MethodGet, ip: "192.0.2.1", wantStatus: http.StatusOK, wantRemaining: "1"},
+				{method: http.MethodGet, ip: "192.0.2.1", wantStatus: http.StatusOK, wantRemaining: "0"},
+				{method: http.MethodGet, ip: "192.0.2.1", wantStatus: http.StatusTooManyRequests, wantRemaining: "0"},
+			},
+		},
+		{
+			name:       "counts each address on its own",
+			middleware: RateLimitAuth,
+			requests: []rateLimitRequest{
+				{method: http.MethodPost, ip: "192.0.2.1", wantStatus: http.StatusOK},
+				{method: http.MethodPost, ip: "192.0.2.1", wantStatus: http.StatusOK},
+				{method: http.MethodPost, ip: "192.0.2.1", wantStatus: http.StatusTooManyRequests},
+				{method: http.MethodPost, ip: "198.51.100.7", wantStatus: http.StatusOK, wantRemaining: "1"},
+			},
+		},
+		{
+			name:       "store failures let requests through",
+			middleware: RateLimitAPI,
+			storeErr:   errors.New("connection refused"),
+			requests: []rateLimitRequest{
+				{method: http.MethodGet, ip: "192.0.2.1", wantStatus: http.StatusOK},
+				{method: http.MethodGet, ip: "192.0.2.1", wantStatus: http.StatusOK},
+				{method: http.MethodGet, ip: "192.0.2.1", wantStatus: http.StatusOK},
+			},
+		},
+		{
+			name:       "admin reads are not counted",
+			middleware: RateLimitAdminWrites,
+			requests: []rateLimitRequest{
+				{method: http.MethodGet, ip: "192.0.2.1", user: "admin-1", wantStatus: http.StatusOK},
+				{method: http.MethodGet, ip: "192.0.2.1", user: "admin-1", wantStatus: http.StatusOK},
+				{method: http.MethodGet, ip: "192.0.2.1", user: "admin-1", wantStatus: http.StatusOK},
+				{method: http.MethodPut, ip: "192.0.2.1", user: "admin-1", wantStatus: http.StatusOK, wantRemaining: "1"},
+				{method: http.MethodDelete, ip: "192.0.2.1", user: "admin-1", wantStatus: http.StatusOK, wantRemaining: "0"},
+				{method: http.MethodPost, ip: "192.0.2.1", user: "admin-1", wantStatus: http.StatusTooManyRequests},
+			},
+		},
+		{
+			name:       "admin writes are counted per user",
+			middleware: RateLimitAdminWrites,
+			requests: []rateLimitRequest{
+				{method: http.MethodPost, ip: "192.0.2.1", user: "admin-1", wantStatus: http.StatusOK},
+				{method: http.MethodPost, ip: "192.0.2.1", user: "admin-1", wantStatus: http.StatusOK},
+				{method: http.MethodPost, ip: "192.0.2.1", user: "admin-1", wantStatus: http.StatusTooManyRequests},
+				{method: http.MethodPost, ip: "192.0.2.1", user: "admin-2", wantStatus: http.StatusOK},
+			},
+		},
+		{
+			name:       "only new accounts are counted",
+			middleware: RateLimitNewAccounts,
+			requests: []rateLimitRequest{
+				{method: http.MethodPost, path: "/login", ip: "192.0.2.1", wantStatus: http.StatusOK},
+				{method: http.MethodPost, path: "/login", ip: "192.0.2.1", wantStatus: http.StatusOK},
+				{method: http.MethodPost, path: "/login", ip: "192.0.2.1", wantStatus: http.StatusOK},
+				{method: http.MethodPost, path: "/signup", ip: "192.0.2.1", wantStatus: http.StatusOK},
+				{method: http.MethodPost, path: "/signup", ip: "192.0.2.1", wantStatus: http.StatusOK},
+				{method: http.MethodPost, path: "/login", ip: "192.0.2.1", wantStatus: http.StatusTooManyRequests},
+				{method: http.MethodPost, path: "/signup", ip: "198.51.100.7", wantStatus: http.StatusOK},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &fakeRateLimitStore{buckets: make(map[string]domain.RateLimitBucket), err: tt.storeErr}
+			limiter := NewRateLimiter(store, policies)
+
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				if user := c.GetHeader("X-Test-User"); user != "" {
+					c.Set(userIDKey, user)
+				}
+			}, tt.middleware(limiter))
+			router.Any("/*path", func(c *gin.Context) {
+				if c.Param("path") == "/signup" {
+					MarkNewAccount(c)
+				}
+				c.Status(http.StatusOK)
+			})
+
+			for i, r := range tt.requests {
+				path := r.path
+				if path == "" {
+					path = "/"
+				}
+				req := httptest.NewRequest(r.method, path, nil)
+				req.RemoteAddr = r.ip + ":40000"
+				if r.user != "" {
+					req.Header.Set("X-Test-User", r.user)
+				}
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+
+				if w.Code != r.wantStatus {
+					t.Fatalf("request %d: status = %d, want %d", i, w.Code, r.wantStatus)
+				}
+				if r.wantRemaining != "" && w.Header().Get("RateLimit-Remaining") != r.wantRemaining {
+					t.Errorf("request %d: RateLimit-Remaining = %q, want %q", i, w.Header().Get("RateLimit-Remaining"), r.wantRemaining)
+				}
+				if w.Code == http.StatusTooManyRequests && w.Header().Get("Retry-After") == "" {
+					t.Errorf("request %d: Retry-After is missing", i)
+				}
+			}
+		})
+	}
+}
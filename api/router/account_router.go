@@ -0,0 +1,24 @@
+package api
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/handler"
+	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+func InitializeAccountRoutes(r *gin.Engine, accountHandler *handler.AccountHandler, authService *service.AuthenticationDomainService) {
+	api := r.Group(constants.APIBasePath)
+	{
+		// Data-subject requests of the current user
+		me := api.Group("/users/me")
+		me.Use(middleware.JwtAuthMiddleware(authService))
+		{
+			me.GET("/export", accountHandler.ExportMyData)                 // GET /users/me/export
+			me.DELETE("", accountHandler.RequestMyAccountDeletion)         // DELETE /users/me
+			me.GET("/deletion", accountHandler.GetMyAccountDeletion)       // GET /users/me/deletion
+			me.DELETE("/deletion", accountHandler.CancelMyAccountDeletion) // DELETE /users/me/deletion
+		}
+	}
+}
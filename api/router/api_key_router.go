@@ -0,0 +1,34 @@
+package api
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/handler"
+	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+func InitializeAPIKeyRoutes(r *gin.Engine, apiKeyHandler *handler.APIKeyHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
+	api := r.Group(constants.APIBasePath)
+	{
+		// Every user manages their own keys
+		myKeys := api.Group("/users/me/api-keys")
+		myKeys.Use(middleware.JwtAuthMiddleware(authService))
+		{
+			myKeys.POST("", apiKeyHandler.CreateMyAPIKey)       // POST /users/me/api-keys
+			myKeys.GET("", apiKeyHandler.GetMyAPIKeys)          // GET /users/me/api-keys
+			myKeys.DELETE("/:id", apiKeyHandler.RevokeMyAPIKey) // DELETE /users/me/api-keys/:id
+		}
+
+		// Administrators manage the keys of any user, such as service accounts
+		userKeys := api.Group("/admin/users")
+		userKeys.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionUserManage), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
+		{
+			userKeys.POST("/:id/api-keys", apiKeyHandler.CreateUserAPIKey) // POST /admin/users/:id/api-keys
+			userKeys.GET("/:id/api-keys", apiKeyHandler.GetUserAPIKeys)    // GET /admin/users/:id/api-keys
+			// Revoking a key only removes access and keys are never edited, so it needs no If-Match
+			userKeys.DELETE("/:id/api-keys/:keyId", apiKeyHandler.RevokeUserAPIKey) // DELETE /admin/users/:id/api-keys/:keyId
+		}
+	}
+}
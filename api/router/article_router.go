@@ -4,11 +4,12 @@ import (
 	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/api/handler"
 	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
 	"github.com/gin-gonic/gin"
 )
 
-func InitializeArticleRoutes(r *gin.Engine, articleHandler *handler.ArticleHandler, authService *service.AuthenticationDomainService) {
+func InitializeArticleRoutes(r *gin.Engine, articleHandler *handler.ArticleHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
 	api := r.Group(constants.APIBasePath)
 	{
 
@@ -25,11 +26,11 @@ func InitializeArticleRoutes(r *gin.Engine, articleHandler *handler.ArticleHandl
 
 		// Articles routes requiring authentication and role-based access control
 		protected := api.Group("/admin/articles")
-		protected.Use(middleware.JwtAuthMiddleware(authService), middleware.RBACMiddleware(constants.RoleAdmin))
+		protected.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionArticlePublish), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
 		{
 			protected.POST("", articleHandler.CreateArticle)
-			protected.PUT("/:id", articleHandler.UpdateArticle)
-			protected.DELETE("/:id", articleHandler.DeleteArticle)
+			protected.PUT("/:id", middleware.RequireIfMatch(), articleHandler.UpdateArticle)
+			protected.DELETE("/:id", middleware.RequireIfMatch(), articleHandler.DeleteArticle)
 		}
 	}
 }
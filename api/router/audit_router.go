@@ -0,0 +1,22 @@
+package api
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/handler"
+	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+func InitializeAuditRoutes(r *gin.Engine, auditHandler *handler.AuditHandler, authService *service.AuthenticationDomainService) {
+	api := r.Group(constants.APIBasePath)
+	{
+		// Admin routes; entries are written by the domain services, never through the API
+		admin := api.Group("/admin/audit")
+		admin.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionAuditRead))
+		{
+			admin.GET("", auditHandler.GetPaginatedAuditEntries) // GET /admin/audit
+		}
+	}
+}
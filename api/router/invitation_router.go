@@ -0,0 +1,26 @@
+package api
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/handler"
+	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+func InitializeInvitationRoutes(r *gin.Engine, invitationHandler *handler.InvitationHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
+	api := r.Group(constants.APIBasePath)
+	{
+		// Admin routes; invitations are accepted through /users/auth/invitations/accept
+		admin := api.Group("/admin/invitations")
+		admin.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionUserManage), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
+		{
+			admin.POST("", invitationHandler.CreateInvitation)       // POST /admin/invitations
+			admin.GET("", invitationHandler.GetPaginatedInvitations) // GET /admin/invitations
+			admin.GET("/:id", invitationHandler.GetInvitationByID)   // GET /admin/invitations/:id
+			// Revoking is refused once the invitation is accepted or revoked, so it needs no If-Match
+			admin.DELETE("/:id", invitationHandler.RevokeInvitation) // DELETE /admin/invitations/:id
+		}
+	}
+}
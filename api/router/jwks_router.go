@@ -0,0 +1,11 @@
+package api
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/handler"
+	"github.com/gin-gonic/gin"
+)
+
+func InitializeJWKSRoutes(r *gin.Engine, jwksHandler *handler.JWKSHandler) {
+	// Public route - served outside the API base path at the well-known location
+	r.GET("/.well-known/jwks.json", jwksHandler.GetJWKS)
+}
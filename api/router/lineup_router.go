@@ -4,11 +4,12 @@ import (
 	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/api/handler"
 	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
 	"github.com/gin-gonic/gin"
 )
 
-func InitializeLineupRoutes(r *gin.Engine, lineupHandler *handler.LineupHandler, authService *service.AuthenticationDomainService) {
+func InitializeLineupRoutes(r *gin.Engine, lineupHandler *handler.LineupHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
 	api := r.Group(constants.APIBasePath)
 
 	authRequired := middleware.JwtAuthMiddleware(authService)
@@ -17,6 +18,12 @@ func InitializeLineupRoutes(r *gin.Engine, lineupHandler *handler.LineupHandler,
 	{
 		lineups.GET("", lineupHandler.GetPaginatedLineups)
 		lineups.GET("/:id", lineupHandler.GetLineupByID)
+
+		// Team-scoped management: admins and the coaches of the teams involved
+		canWriteLineups := middleware.RequirePermission(domain.PermissionLineupWrite)
+		lineups.POST("", canWriteLineups, lineupHandler.CreateLineup)
+		lineups.PUT("/:id", canWriteLineups, middleware.RequireIfMatch(), lineupHandler.UpdateLineup)
+		lineups.DELETE("/:id", canWriteLineups, middleware.RequireIfMatch(), lineupHandler.DeleteLineup)
 	}
 
 	// Specific lineup queries by match type
@@ -37,10 +44,10 @@ func InitializeLineupRoutes(r *gin.Engine, lineupHandler *handler.LineupHandler,
 	}
 
 	// --- Admin-only lineup management ---
-	adminLineups := api.Group("/admin/lineups", authRequired, middleware.RBACMiddleware(constants.RoleAdmin))
+	adminLineups := api.Group("/admin/lineups", authRequired, middleware.RequirePermission(domain.PermissionLineupWrite), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
 	{
 		adminLineups.POST("", lineupHandler.CreateLineup)
-		adminLineups.PUT("/:id", lineupHandler.UpdateLineup)
-		adminLineups.DELETE("/:id", lineupHandler.DeleteLineup)
+		adminLineups.PUT("/:id", middleware.RequireIfMatch(), lineupHandler.UpdateLineup)
+		adminLineups.DELETE("/:id", middleware.RequireIfMatch(), lineupHandler.DeleteLineup)
 	}
 }
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/handler"
+	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+func InitializeMatchReportRoutes(r *gin.Engine, matchReportHandler *handler.MatchReportHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
+	api := r.Group(constants.APIBasePath)
+	{
+		// Match officials submit and follow up on their reports
+		officials := api.Group("")
+		officials.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionMatchReportSubmit))
+		{
+			officials.POST("/matches/:id/reports", matchReportHandler.SubmitMatchReport)       // POST /matches/:id/reports
+			officials.GET("/matches/:id/reports", matchReportHandler.GetMatchReportsByMatchID) // GET /matches/:id/reports
+			officials.GET("/match-reports", matchReportHandler.GetMyMatchReports)              // GET /match-reports
+			officials.GET("/match-reports/:id", matchReportHandler.GetMatchReportByID)         // GET /match-reports/:id
+			officials.PUT("/match-reports/:id", matchReportHandler.ResubmitMatchReport)        // PUT /match-reports/:id
+		}
+
+		// Admin review queue
+		admin := api.Group("/admin/match-reports")
+		admin.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionMatchReportReview), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
+		{
+			admin.GET("", matchReportHandler.GetMatchReportsForReview)        // GET /admin/match-reports
+			admin.POST("/:id/approve", matchReportHandler.ApproveMatchReport) // POST /admin/match-reports/:id/approve
+			admin.POST("/:id/reject", matchReportHandler.RejectMatchReport)   // POST /admin/match-reports/:id/reject
+		}
+	}
+}
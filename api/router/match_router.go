@@ -5,10 +5,11 @@ import (
 	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/api/handler"
 	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/gin-gonic/gin"
 )
 
-func InitializeMatchRoutes(r *gin.Engine, matchHandler *handler.MatchHandler, authService *service.AuthenticationDomainService) {
+func InitializeMatchRoutes(r *gin.Engine, matchHandler *handler.MatchHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
 	api := r.Group(constants.APIBasePath)
 	{
 		// Public match routes
@@ -32,15 +33,21 @@ func InitializeMatchRoutes(r *gin.Engine, matchHandler *handler.MatchHandler, au
 			teams.GET("/:id/next-match", matchHandler.GetNextMatchByTeamID) // GET /teams/:id/next-match
 		}
 
+		// Venue-related match routes
+		venues := api.Group("/venues")
+		{
+			venues.GET("/:id/matches", matchHandler.GetMatchesByVenueID) // GET /venues/:id/matches
+		}
+
 		// Admin-only match routes
 		admin := api.Group("/admin")
-		admin.Use(middleware.JwtAuthMiddleware(authService), middleware.RBACMiddleware(constants.RoleAdmin))
+		admin.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionMatchWrite), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
 		{
 			adminMatches := admin.Group("/matches")
 			{
-				adminMatches.POST("", matchHandler.CreateMatch)       // POST /admin/matches
-				adminMatches.PUT("/:id", matchHandler.UpdateMatch)    // PUT /admin/matches/:id
-				adminMatches.DELETE("/:id", matchHandler.DeleteMatch) // DELETE /admin/matches/:id
+				adminMatches.POST("", matchHandler.CreateMatch)                                    // POST /admin/matches
+				adminMatches.PUT("/:id", middleware.RequireIfMatch(), matchHandler.UpdateMatch)    // PUT /admin/matches/:id
+				adminMatches.DELETE("/:id", middleware.RequireIfMatch(), matchHandler.DeleteMatch) // DELETE /admin/matches/:id
 			}
 		}
 	}
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/handler"
+	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+func InitializeMVPVoteRoutes(r *gin.Engine, mvpVoteHandler *handler.MVPVoteHandler, authService *service.AuthenticationDomainService) {
+	api := r.Group(constants.APIBasePath)
+	{
+		// Any authenticated user can take part in the MVP vote
+		matches := api.Group("/matches")
+		matches.Use(middleware.JwtAuthMiddleware(authService))
+		{
+			matches.GET("/:id/mvp-poll", mvpVoteHandler.GetMVPPoll)    // GET /matches/:id/mvp-poll
+			matches.POST("/:id/mvp-votes", mvpVoteHandler.CastMVPVote) // POST /matches/:id/mvp-votes
+		}
+	}
+}
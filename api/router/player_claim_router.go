@@ -0,0 +1,33 @@
+package api
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/handler"
+	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+func InitializePlayerClaimRoutes(r *gin.Engine, playerClaimHandler *handler.PlayerClaimHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
+	api := r.Group(constants.APIBasePath)
+	{
+		// Users claim their player profile and follow their stats once linked
+		me := api.Group("/users/me")
+		me.Use(middleware.JwtAuthMiddleware(authService))
+		{
+			me.POST("/player-claims", playerClaimHandler.SubmitPlayerClaim) // POST /users/me/player-claims
+			me.GET("/player-claims", playerClaimHandler.GetMyPlayerClaims)  // GET /users/me/player-claims
+			me.GET("/player", playerClaimHandler.GetMyPlayerDashboard)      // GET /users/me/player
+		}
+
+		// Admin review queue
+		admin := api.Group("/admin/player-claims")
+		admin.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionUserManage), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
+		{
+			admin.GET("", playerClaimHandler.GetPlayerClaimsForReview)        // GET /admin/player-claims
+			admin.POST("/:id/approve", playerClaimHandler.ApprovePlayerClaim) // POST /admin/player-claims/:id/approve
+			admin.POST("/:id/reject", playerClaimHandler.RejectPlayerClaim)   // POST /admin/player-claims/:id/reject
+		}
+	}
+}
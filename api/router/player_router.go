@@ -4,11 +4,12 @@ import (
 	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/api/handler"
 	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
 	"github.com/gin-gonic/gin"
 )
 
-func InitializePlayerRoutes(r *gin.Engine, playerHandler *handler.PlayerHandler, authService *service.AuthenticationDomainService) {
+func InitializePlayerRoutes(r *gin.Engine, playerHandler *handler.PlayerHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
 	api := r.Group(constants.APIBasePath)
 	{
 		// Public/Authenticated player routes
@@ -18,15 +19,18 @@ func InitializePlayerRoutes(r *gin.Engine, playerHandler *handler.PlayerHandler,
 			players.GET("", playerHandler.GetPaginatedPlayers)
 			players.GET("/nickname/:nickname", playerHandler.GetPlayerByNickName) // placed before :id
 			players.GET("/:id", playerHandler.GetPlayerByID)
+
+			// Access is checked against the player's squad, see TeamAccessDomainService
+			players.PUT("/:id/availability", middleware.RequirePermission(domain.PermissionPlayerAvailability), playerHandler.UpdatePlayerAvailability)
 		}
 
 		// Admin routes
 		adminPlayers := api.Group("/admin/players")
-		adminPlayers.Use(middleware.JwtAuthMiddleware(authService), middleware.RBACMiddleware(constants.RoleAdmin))
+		adminPlayers.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionPlayerWrite), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
 		{
 			adminPlayers.POST("", playerHandler.CreatePlayer)
-			adminPlayers.PUT("/:id", playerHandler.UpdatePlayer)
-			adminPlayers.DELETE("/:id", playerHandler.DeletePlayer)
+			adminPlayers.PUT("/:id", middleware.RequireIfMatch(), playerHandler.UpdatePlayer)
+			adminPlayers.DELETE("/:id", middleware.RequireIfMatch(), playerHandler.DeletePlayer)
 		}
 	}
 }
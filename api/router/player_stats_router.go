@@ -5,10 +5,11 @@ import (
 	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/api/handler"
 	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/gin-gonic/gin"
 )
 
-func InitializePlayerStatsRoutes(r *gin.Engine, playerStatsHandler *handler.PlayerStatsHandler, authService *service.AuthenticationDomainService) {
+func InitializePlayerStatsRoutes(r *gin.Engine, playerStatsHandler *handler.PlayerStatsHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
 	api := r.Group(constants.APIBasePath)
 	{
 		// Public routes
@@ -36,13 +37,22 @@ func InitializePlayerStatsRoutes(r *gin.Engine, playerStatsHandler *handler.Play
 			seasons.GET("/:id/stats", playerStatsHandler.GetPlayerStatsBySeasonID)
 		}
 
+		// Team-scoped management: admins and the coaches of the teams involved
+		teamPlayerStats := api.Group("/player-stats")
+		teamPlayerStats.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionPlayerStatsWrite))
+		{
+			teamPlayerStats.POST("", playerStatsHandler.CreatePlayerStat)
+			teamPlayerStats.PUT("/:id", middleware.RequireIfMatch(), playerStatsHandler.UpdatePlayerStat)
+			teamPlayerStats.DELETE("/:id", middleware.RequireIfMatch(), playerStatsHandler.DeletePlayerStat)
+		}
+
 		// Admin routes
 		adminPlayerStats := api.Group("/admin/player-stats")
-		adminPlayerStats.Use(middleware.JwtAuthMiddleware(authService), middleware.RBACMiddleware(constants.RoleAdmin))
+		adminPlayerStats.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionPlayerStatsWrite), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
 		{
 			adminPlayerStats.POST("", playerStatsHandler.CreatePlayerStat)
-			adminPlayerStats.PUT("/:id", playerStatsHandler.UpdatePlayerStat)
-			adminPlayerStats.DELETE("/:id", playerStatsHandler.DeletePlayerStat)
+			adminPlayerStats.PUT("/:id", middleware.RequireIfMatch(), playerStatsHandler.UpdatePlayerStat)
+			adminPlayerStats.DELETE("/:id", middleware.RequireIfMatch(), playerStatsHandler.DeletePlayerStat)
 		}
 	}
 }
@@ -5,10 +5,11 @@ import (
 	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/api/handler"
 	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/gin-gonic/gin"
 )
 
-func InitializePlayerTeamRoutes(r *gin.Engine, playerTeamHandler *handler.PlayerTeamHandler, authService *service.AuthenticationDomainService) {
+func InitializePlayerTeamRoutes(r *gin.Engine, playerTeamHandler *handler.PlayerTeamHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
 	api := r.Group(constants.APIBasePath)
 
 	// All authenticated routes
@@ -30,13 +31,13 @@ func InitializePlayerTeamRoutes(r *gin.Engine, playerTeamHandler *handler.Player
 
 	// Admin-only
 	adminRoutes := api.Group("/admin")
-	adminRoutes.Use(middleware.JwtAuthMiddleware(authService), middleware.RBACMiddleware(constants.RoleAdmin))
+	adminRoutes.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionPlayerTeamWrite), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
 	{
 		admin := adminRoutes.Group("/player-teams")
 		{
 			admin.POST("", playerTeamHandler.CreatePlayerTeam)
-			admin.PUT("/:id", playerTeamHandler.UpdatePlayerTeam)
-			admin.DELETE("/:id", playerTeamHandler.DeletePlayerTeam)
+			admin.PUT("/:id", middleware.RequireIfMatch(), playerTeamHandler.UpdatePlayerTeam)
+			admin.DELETE("/:id", middleware.RequireIfMatch(), playerTeamHandler.DeletePlayerTeam)
 		}
 	}
 }
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/handler"
+	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+func InitializePredictionRoutes(r *gin.Engine, predictionHandler *handler.PredictionHandler, authService *service.AuthenticationDomainService) {
+	api := r.Group(constants.APIBasePath)
+	{
+		// Fans predict match scores
+		fans := api.Group("")
+		fans.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionPredictionWrite))
+		{
+			fans.POST("/matches/:id/predictions", predictionHandler.CreatePrediction) // POST /matches/:id/predictions
+			fans.GET("/predictions", predictionHandler.GetMyPredictions)              // GET /predictions
+			fans.GET("/predictions/:id", predictionHandler.GetPredictionByID)         // GET /predictions/:id
+			fans.PUT("/predictions/:id", predictionHandler.UpdatePrediction)          // PUT /predictions/:id
+			fans.DELETE("/predictions/:id", predictionHandler.DeletePrediction)       // DELETE /predictions/:id
+		}
+
+		// Season leaderboard, visible to roles allowed to read predictions
+		leaderboard := api.Group("/seasons")
+		leaderboard.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionPredictionRead))
+		{
+			leaderboard.GET("/:id/predictions/leaderboard", predictionHandler.GetSeasonLeaderboard) // GET /seasons/:id/predictions/leaderboard
+		}
+	}
+}
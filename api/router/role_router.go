@@ -4,21 +4,30 @@ import (
 	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/api/handler"
 	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
 	"github.com/gin-gonic/gin"
 )
 
-func InitializeRoleRoutes(r *gin.Engine, roleHandler *handler.RoleHandler, authService *service.AuthenticationDomainService) {
+func InitializeRoleRoutes(r *gin.Engine, roleHandler *handler.RoleHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
 	api := r.Group(constants.APIBasePath)
 
 	// Admin-only role management
 	adminRoles := api.Group("/admin/roles")
-	adminRoles.Use(middleware.JwtAuthMiddleware(authService), middleware.RBACMiddleware(constants.RoleAdmin))
+	adminRoles.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionRoleManage), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
 	// Read
 	adminRoles.GET("", roleHandler.GetPaginatedRoles)
 	adminRoles.GET("/:id", roleHandler.GetRoleByID)
 	// Write
 	adminRoles.POST("", roleHandler.CreateRole)
-	adminRoles.PUT("/:id", roleHandler.UpdateRole)
-	adminRoles.DELETE("/:id", roleHandler.DeleteRole)
+	adminRoles.PUT("/:id", middleware.RequireIfMatch(), roleHandler.UpdateRole)
+	adminRoles.DELETE("/:id", middleware.RequireIfMatch(), roleHandler.DeleteRole)
+	// Permissions
+	adminRoles.GET("/:id/permissions", roleHandler.GetRolePermissions)
+	adminRoles.PUT("/:id/permissions", middleware.RequireIfMatch(), roleHandler.UpdateRolePermissions)
+
+	// Catalogue of permissions that can be granted
+	adminPermissions := api.Group("/admin/permissions")
+	adminPermissions.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionRoleManage), middleware.RateLimitAdminWrites(rateLimiter))
+	adminPermissions.GET("", roleHandler.GetPermissions)
 }
@@ -4,11 +4,12 @@ import (
 	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/api/handler"
 	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
 	"github.com/gin-gonic/gin"
 )
 
-func InitializeSeasonRoutes(r *gin.Engine, seasonHandler *handler.SeasonHandler, authService *service.AuthenticationDomainService) {
+func InitializeSeasonRoutes(r *gin.Engine, seasonHandler *handler.SeasonHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
 	api := r.Group(constants.APIBasePath)
 
 	// Seasons endpoints (read-only, no authentication required)
@@ -21,11 +22,11 @@ func InitializeSeasonRoutes(r *gin.Engine, seasonHandler *handler.SeasonHandler,
 
 	// Admin routes (authenticated + role check)
 	adminSeasons := api.Group("/admin/seasons")
-	adminSeasons.Use(middleware.JwtAuthMiddleware(authService), middleware.RBACMiddleware(constants.RoleAdmin))
+	adminSeasons.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionSeasonWrite), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
 	{
 		adminSeasons.POST("", seasonHandler.CreateSeason)
-		adminSeasons.PUT("/:id", seasonHandler.UpdateSeason)
-		adminSeasons.PUT("/:id/set-current", seasonHandler.SetCurrentSeason)
-		adminSeasons.DELETE("/:id", seasonHandler.DeleteSeason)
+		adminSeasons.PUT("/:id", middleware.RequireIfMatch(), seasonHandler.UpdateSeason)
+		adminSeasons.PUT("/:id/set-current", middleware.RequireIfMatch(), seasonHandler.SetCurrentSeason)
+		adminSeasons.DELETE("/:id", middleware.RequireIfMatch(), seasonHandler.DeleteSeason)
 	}
 }
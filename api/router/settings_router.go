@@ -0,0 +1,23 @@
+package api
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/handler"
+	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+func InitializeSettingsRoutes(r *gin.Engine, settingsHandler *handler.SettingsHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter) {
+	api := r.Group(constants.APIBasePath)
+	{
+		// Admin routes; the email domains decide who can create an account
+		admin := api.Group("/admin/settings")
+		admin.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionUserManage), middleware.RateLimitAdminWrites(rateLimiter))
+		{
+			admin.GET("/email-domains", settingsHandler.GetEmailDomainSettings)                                 // GET /admin/settings/email-domains
+			admin.PUT("/email-domains", middleware.RequireIfMatch(), settingsHandler.UpdateEmailDomainSettings) // PUT /admin/settings/email-domains
+		}
+	}
+}
@@ -4,11 +4,12 @@ import (
 	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/api/handler"
 	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
 	"github.com/gin-gonic/gin"
 )
 
-func InitializeTeamRoutes(r *gin.Engine, teamHandler *handler.TeamHandler, authService *service.AuthenticationDomainService) {
+func InitializeTeamRoutes(r *gin.Engine, teamHandler *handler.TeamHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
 	api := r.Group(constants.APIBasePath)
 
 	// Authenticated user routes
@@ -21,10 +22,10 @@ func InitializeTeamRoutes(r *gin.Engine, teamHandler *handler.TeamHandler, authS
 
 	// Admin-only routes
 	adminTeams := api.Group("/admin/teams")
-	adminTeams.Use(middleware.JwtAuthMiddleware(authService), middleware.RBACMiddleware(constants.RoleAdmin))
+	adminTeams.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionTeamWrite), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
 	{
 		adminTeams.POST("", teamHandler.CreateTeam)
-		adminTeams.PUT("/:id", teamHandler.UpdateTeam)
-		adminTeams.DELETE("/:id", teamHandler.DeleteTeam)
+		adminTeams.PUT("/:id", middleware.RequireIfMatch(), teamHandler.UpdateTeam)
+		adminTeams.DELETE("/:id", middleware.RequireIfMatch(), teamHandler.DeleteTeam)
 	}
 }
@@ -5,10 +5,11 @@ import (
 	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/api/handler"
 	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/gin-gonic/gin"
 )
 
-func InitializeTeamStatsRoutes(r *gin.Engine, teamStatsHandler *handler.TeamStatsHandler, authService *service.AuthenticationDomainService) {
+func InitializeTeamStatsRoutes(r *gin.Engine, teamStatsHandler *handler.TeamStatsHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
 	api := r.Group(constants.APIBasePath)
 	auth := api.Group("")
 	auth.Use(middleware.JwtAuthMiddleware(authService))
@@ -28,10 +29,10 @@ func InitializeTeamStatsRoutes(r *gin.Engine, teamStatsHandler *handler.TeamStat
 
 	// Admin-only routes
 	admin := api.Group("/admin/team-stats")
-	admin.Use(middleware.JwtAuthMiddleware(authService), middleware.RBACMiddleware(constants.RoleAdmin))
+	admin.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionTeamStatsWrite), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
 	{
 		admin.POST("", teamStatsHandler.CreateTeamStats)
-		admin.PUT("/:id", teamStatsHandler.UpdateTeamStats)
-		admin.DELETE("/:id", teamStatsHandler.DeleteTeamStats)
+		admin.PUT("/:id", middleware.RequireIfMatch(), teamStatsHandler.UpdateTeamStats)
+		admin.DELETE("/:id", middleware.RequireIfMatch(), teamStatsHandler.DeleteTeamStats)
 	}
 }
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/handler"
+	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+func InitializeTrashRoutes(r *gin.Engine, trashHandler *handler.TrashHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
+	api := r.Group(constants.APIBasePath)
+	{
+		admin := api.Group("/admin")
+		admin.Use(middleware.JwtAuthMiddleware(authService))
+		{
+			// Deleted records stay in the trash until they are purged
+			admin.GET("/trash", middleware.RequirePermission(domain.PermissionTrashRead), trashHandler.GetPaginatedTrash) // GET /admin/trash
+
+			// Restoring needs the permission to write the entity
+			admin.POST("/matches/:id/restore", middleware.RequirePermission(domain.PermissionMatchWrite), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys), trashHandler.RestoreMatch)        // POST /admin/matches/:id/restore
+			admin.POST("/players/:id/restore", middleware.RequirePermission(domain.PermissionPlayerWrite), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys), trashHandler.RestorePlayer)      // POST /admin/players/:id/restore
+			admin.POST("/teams/:id/restore", middleware.RequirePermission(domain.PermissionTeamWrite), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys), trashHandler.RestoreTeam)            // POST /admin/teams/:id/restore
+			admin.POST("/articles/:id/restore", middleware.RequirePermission(domain.PermissionArticlePublish), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys), trashHandler.RestoreArticle) // POST /admin/articles/:id/restore
+		}
+	}
+}
@@ -4,18 +4,26 @@ import (
 	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/api/handler"
 	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
 	"github.com/gin-gonic/gin"
 )
 
-func InitializeUserRoutes(r *gin.Engine, userHandler *handler.UserHandler, authService *service.AuthenticationDomainService) {
+func InitializeUserRoutes(r *gin.Engine, userHandler *handler.UserHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
 	api := r.Group(constants.APIBasePath)
 	{
-		// Public routes - OAuth2 Authentication
+		// Public routes - OAuth2/OpenID Connect Authentication
 		authGroup := api.Group("/users/auth")
+		authGroup.Use(middleware.RateLimitAuth(rateLimiter))
 		{
-			authGroup.GET("/google", userHandler.LoginWithGoogle)
-			authGroup.GET("/google/callback", userHandler.GoogleCallback)
+			authGroup.GET("/:provider", userHandler.LoginWithProvider)
+			authGroup.GET("/:provider/callback", middleware.RateLimitNewAccounts(rateLimiter), userHandler.ProviderCallback)
+			authGroup.POST("/register", middleware.RateLimitNewAccounts(rateLimiter), userHandler.Register)
+			authGroup.POST("/login", userHandler.Login)
+			authGroup.POST("/password/reset", userHandler.ResetPassword)
+			authGroup.POST("/invitations/accept", userHandler.AcceptInvitation)
+			authGroup.POST("/refresh", userHandler.RefreshSession)
+			authGroup.POST("/logout", middleware.JwtAuthMiddleware(authService), userHandler.Logout)
 		}
 
 		// Protected user routes
@@ -23,17 +31,27 @@ func InitializeUserRoutes(r *gin.Engine, userHandler *handler.UserHandler, authS
 		users.Use(middleware.JwtAuthMiddleware(authService))
 		{
 			users.GET("/me", userHandler.GetCurrentUser)
+			users.PATCH("/me", userHandler.UpdateMyProfile)
+			users.GET("/me/profile-changes", userHandler.GetMyProfileChanges)
+			users.PUT("/me/password", middleware.RateLimitAuth(rateLimiter), userHandler.ChangeMyPassword)
+			users.GET("/me/sessions", userHandler.GetMySessions)
+			users.DELETE("/me/sessions/:id", userHandler.RevokeMySession)
 			users.GET("", userHandler.GetPaginatedUsers)
 			users.GET("/:username", userHandler.GetUserByUsername)
 		}
 
 		// Admin routes
 		adminUsers := api.Group("/admin/users")
-		adminUsers.Use(middleware.JwtAuthMiddleware(authService), middleware.RBACMiddleware(constants.RoleAdmin))
+		adminUsers.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionUserManage), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
 		{
 			adminUsers.POST("", userHandler.CreateUser)
-			adminUsers.PUT("/:id", userHandler.UpdateUser)
-			adminUsers.DELETE("/:id", userHandler.DeleteUser)
+			adminUsers.PUT("/:id", middleware.RequireIfMatch(), userHandler.UpdateUser)
+			adminUsers.PUT("/:id/team", middleware.RequireIfMatch(), userHandler.AssignUserTeam)
+			adminUsers.GET("/:id/profile-changes", userHandler.GetUserProfileChanges)
+			adminUsers.POST("/:id/password-reset", userHandler.IssuePasswordReset)
+			// Revoking sessions cuts off access whatever the user looks like now, so it needs no If-Match
+			adminUsers.DELETE("/:id/sessions", userHandler.RevokeUserSessions)
+			adminUsers.DELETE("/:id", middleware.RequireIfMatch(), userHandler.DeleteUser)
 		}
 	}
 }
@@ -0,0 +1,30 @@
+package api
+
+import (
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/api/handler"
+	"github.com/EdwinRincon/browersfc-api/api/middleware"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/gin-gonic/gin"
+)
+
+func InitializeVenueRoutes(r *gin.Engine, venueHandler *handler.VenueHandler, authService *service.AuthenticationDomainService, rateLimiter *middleware.RateLimiter, idempotencyKeys *middleware.IdempotencyKeys) {
+	api := r.Group(constants.APIBasePath)
+
+	// Venues endpoints (read-only, no authentication required)
+	venues := api.Group("/venues")
+	{
+		venues.GET("", venueHandler.GetPaginatedVenues)
+		venues.GET("/:id", venueHandler.GetVenueByID)
+	}
+
+	// Admin routes (authenticated + role check)
+	adminVenues := api.Group("/admin/venues")
+	adminVenues.Use(middleware.JwtAuthMiddleware(authService), middleware.RequirePermission(domain.PermissionVenueWrite), middleware.RateLimitAdminWrites(rateLimiter), middleware.Idempotency(idempotencyKeys))
+	{
+		adminVenues.POST("", venueHandler.CreateVenue)
+		adminVenues.PUT("/:id", middleware.RequireIfMatch(), venueHandler.UpdateVenue)
+		adminVenues.DELETE("/:id", middleware.RequireIfMatch(), venueHandler.DeleteVenue)
+	}
+}
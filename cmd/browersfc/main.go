@@ -41,6 +41,15 @@ func main() {
 		IsDebug: os.Getenv("GIN_MODE") != "release",
 	})
 
+	// Apply, roll back or list the database migrations and exit
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrateCommand(flag.Args()[1:]); err != nil {
+			slog.Error("Migration command failed", slog.String("error", err.Error()))
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Seed database if requested
 	if *seedDB {
 		slog.Info("Seeding database...")
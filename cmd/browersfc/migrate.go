@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/pkg/migrate"
+	"github.com/EdwinRincon/browersfc-api/pkg/orm"
+)
+
+const migrateUsage = "usage: browersfc migrate up | down [-drop-schema] [steps] | status"
+
+// runMigrateCommand applies, rolls back or lists the database migrations.
+func runMigrateCommand(args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	sqlDB, err := orm.OpenSQLDB()
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+
+	migrator, err := migrate.New(sqlDB)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d migration(s) applied\n", applied)
+		return nil
+
+	case "down":
+		flags := flag.NewFlagSet("down", flag.ContinueOnError)
+		dropSchema := flags.Bool("drop-schema", false, "Allow rolling back the baseline schema, which drops every table")
+		if err := flags.Parse(args[1:]); err != nil {
+			return err
+		}
+		steps := 1
+		if flags.NArg() > 0 {
+			if steps, err = strconv.Atoi(flags.Arg(0)); err != nil {
+				return fmt.Errorf("invalid number of steps %q: %w", flags.Arg(0), err)
+			}
+		}
+		rolledBack, err := migrator.Down(ctx, steps, *dropSchema)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d migration(s) rolled back\n", rolledBack)
+		return nil
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		return printMigrationStatus(statuses)
+
+	default:
+		return errors.New(migrateUsage)
+	}
+}
+
+// printMigrationStatus writes the migrations as a table to standard output.
+func printMigrationStatus(statuses []migrate.Status) error {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "VERSION\tNAME\tAPPLIED AT")
+	for _, status := range statuses {
+		appliedAt := "pending"
+		if status.AppliedAt != nil {
+			appliedAt = status.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(writer, "%04d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+	}
+	return writer.Flush()
+}
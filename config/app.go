@@ -86,15 +86,6 @@ func getSecretFromFile(filePath string) (string, error) {
 	return content, nil
 }
 
-// getSecretBytesFromFile reads and returns the content of a secret file as bytes
-func getSecretBytesFromFile(filePath string) ([]byte, error) {
-	data, err := os.ReadFile(filePath)
-	if err != nil {
-		return nil, fmt.Errorf("error reading secret file: %v", err)
-	}
-	return data, nil
-}
-
 func GetDBURL() (string, error) {
 	filePath := os.Getenv("DB_URL_FILE")
 	if filePath == "" {
@@ -110,11 +101,3 @@ func GetPort() string {
 	}
 	return port
 }
-
-func GetJWTSecret() ([]byte, error) {
-	filePath := os.Getenv("JWT_SECRET_FILE")
-	if filePath == "" {
-		return nil, fmt.Errorf("JWT_SECRET_FILE environment variable is not set")
-	}
-	return getSecretBytesFromFile(filePath)
-}
@@ -0,0 +1,17 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// GetIdempotencyKeyTTL returns how long the response of an admin request sent with an
+// Idempotency-Key is replayed, read from IDEMPOTENCY_KEY_TTL_HOURS and defaulting to 24 hours.
+func GetIdempotencyKeyTTL() time.Duration {
+	hours, err := strconv.Atoi(os.Getenv("IDEMPOTENCY_KEY_TTL_HOURS"))
+	if err != nil || hours <= 0 {
+		return 24 * time.Hour
+	}
+	return time.Duration(hours) * time.Hour
+}
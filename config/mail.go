@@ -0,0 +1,75 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Supported values of MAIL_SENDER
+const (
+	MailSenderLog  = "log"  // Emails are written to MAIL_DIR or to the log, for development
+	MailSenderSMTP = "smtp" // Emails are delivered through an SMTP server
+)
+
+// MailConfig holds how emails are delivered.
+type MailConfig struct {
+	Sender       string
+	From         string
+	Dir          string // Directory the log sender writes emails to
+	SMTPHost     string
+	SMTPPort     string
+	SMTPUsername string
+	SMTPPassword string
+}
+
+// GetMailConfig reads MAIL_SENDER ("log" by default or "smtp"), MAIL_FROM and MAIL_DIR.
+// The smtp sender is configured with SMTP_HOST, SMTP_PORT (default 587), SMTP_USERNAME
+// and SMTP_PASSWORD_FILE.
+func GetMailConfig() (MailConfig, error) {
+	mailConfig := MailConfig{
+		Sender:       strings.ToLower(os.Getenv("MAIL_SENDER")),
+		From:         os.Getenv("MAIL_FROM"),
+		Dir:          os.Getenv("MAIL_DIR"),
+		SMTPHost:     os.Getenv("SMTP_HOST"),
+		SMTPPort:     os.Getenv("SMTP_PORT"),
+		SMTPUsername: os.Getenv("SMTP_USERNAME"),
+	}
+	if mailConfig.From == "" {
+		mailConfig.From = "Browers FC <no-reply@browersfc.com>"
+	}
+	if mailConfig.SMTPPort == "" {
+		mailConfig.SMTPPort = "587"
+	}
+
+	switch mailConfig.Sender {
+	case "":
+		mailConfig.Sender = MailSenderLog
+	case MailSenderLog:
+	case MailSenderSMTP:
+		if mailConfig.SMTPHost == "" {
+			return MailConfig{}, fmt.Errorf("SMTP_HOST is required when MAIL_SENDER is %s", MailSenderSMTP)
+		}
+		if passwordFile := os.Getenv("SMTP_PASSWORD_FILE"); passwordFile != "" {
+			password, err := os.ReadFile(passwordFile)
+			if err != nil {
+				return MailConfig{}, fmt.Errorf("failed to read SMTP password file: %w", err)
+			}
+			mailConfig.SMTPPassword = strings.TrimSpace(string(password))
+		}
+	default:
+		return MailConfig{}, fmt.Errorf("invalid MAIL_SENDER %q", mailConfig.Sender)
+	}
+	return mailConfig, nil
+}
+
+// GetAppURL returns the base URL of the web app from APP_URL, used in the links sent to users.
+func GetAppURL() string {
+	if appURL := os.Getenv("APP_URL"); appURL != "" {
+		return strings.TrimRight(appURL, "/")
+	}
+	if Config.IsDevelopment {
+		return "http://localhost:4200"
+	}
+	return "https://app.browersfc.com"
+}
@@ -0,0 +1,17 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// GetMVPVotingWindow returns how long the MVP vote stays open after full time,
+// read from MVP_VOTING_WINDOW_HOURS and defaulting to 24 hours.
+func GetMVPVotingWindow() time.Duration {
+	hours, err := strconv.Atoi(os.Getenv("MVP_VOTING_WINDOW_HOURS"))
+	if err != nil || hours <= 0 {
+		return 24 * time.Hour
+	}
+	return time.Duration(hours) * time.Hour
+}
@@ -5,55 +5,133 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"errors"
+	"fmt"
 	"os"
-	"sync"
+	"regexp"
+	"strings"
 
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
+	"github.com/EdwinRincon/browersfc-api/domain"
 )
 
 var (
-	OAuthConfig *oauth2.Config
-	pkceStore   sync.Map // Thread-safe map for storing PKCE verifiers
+	OAuthProviders  []OAuthProviderConfig
+	OAuthStateStore string // Where the PKCE parameters of pending logins are kept
 )
 
-type PKCEParams struct {
-	Verifier  string
-	Challenge string
+// Supported values of OAUTH_STATE_STORE
+const (
+	OAuthStateStoreMemory   = "memory"   // In process, only valid for a single replica
+	OAuthStateStorePostgres = "postgres" // Shared by every replica through the database
+)
+
+// googleDiscoveryURL is the issuer used for the google provider unless another one is configured.
+const googleDiscoveryURL = "https://accounts.google.com"
+
+// defaultOAuthScopes are requested from providers that do not configure their own scopes.
+var defaultOAuthScopes = []string{"openid", "email", "profile"}
+
+// providerNamePattern restricts provider names to what can be used in routes and environment variables.
+var providerNamePattern = regexp.MustCompile(`^[a-z0-9]+$`)
+
+// OAuthProviderConfig describes an OpenID Connect provider users can sign in with.
+type OAuthProviderConfig struct {
+	Name         string
+	DiscoveryURL string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
 }
 
+// InitOAuth loads the providers listed in OAUTH_PROVIDERS (default "google").
+// Each provider is configured with OAUTH_<NAME>_DISCOVERY_URL, OAUTH_<NAME>_CLIENT_ID,
+// OAUTH_<NAME>_CLIENT_SECRET_FILE, OAUTH_<NAME>_REDIRECT_URL and OAUTH_<NAME>_SCOPES.
+// Google falls back to the original OAUTH_CLIENT_ID, OAUTH_CLIENT_SECRET_FILE and
+// OAUTH_REDIRECT_URL variables and to the Google issuer. OAUTH_STATE_STORE selects where
+// pending logins are kept: "memory" (default) or "postgres" when running several replicas.
 func InitOAuth() error {
-	clientID := os.Getenv("OAUTH_CLIENT_ID")
-	clientSecretFile := os.Getenv("OAUTH_CLIENT_SECRET_FILE")
-	var clientSecret string
-	if clientSecretFile != "" {
-		secretBytes, err := os.ReadFile(clientSecretFile)
+	OAuthStateStore = strings.ToLower(os.Getenv("OAUTH_STATE_STORE"))
+	switch OAuthStateStore {
+	case "":
+		OAuthStateStore = OAuthStateStoreMemory
+	case OAuthStateStoreMemory, OAuthStateStorePostgres:
+	default:
+		return fmt.Errorf("invalid OAUTH_STATE_STORE %q", OAuthStateStore)
+	}
+
+	names := os.Getenv("OAUTH_PROVIDERS")
+	if names == "" {
+		names = "google"
+	}
+
+	providers := make([]OAuthProviderConfig, 0)
+	for _, name := range strings.Split(names, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if !providerNamePattern.MatchString(name) {
+			return fmt.Errorf("invalid OAuth provider name %q", name)
+		}
+
+		provider, err := loadOAuthProvider(name)
 		if err != nil {
-			return errors.New("failed to read OAuth client secret file: " + err.Error())
+			return err
 		}
-		clientSecret = string(secretBytes)
+		providers = append(providers, provider)
 	}
-	redirectURL := os.Getenv("OAUTH_REDIRECT_URL")
 
-	if clientID == "" || clientSecret == "" || redirectURL == "" {
-		return errors.New("missing required OAuth configuration")
+	if len(providers) == 0 {
+		return errors.New("no OAuth provider configured")
 	}
 
-	OAuthConfig = &oauth2.Config{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
-		RedirectURL:  redirectURL,
-		Scopes: []string{
-			"https://www.googleapis.com/auth/userinfo.email",
-			"https://www.googleapis.com/auth/userinfo.profile",
-		},
-		Endpoint: google.Endpoint,
+	OAuthProviders = providers
+	return nil
+}
+
+// loadOAuthProvider reads the configuration of a single provider from the environment.
+func loadOAuthProvider(name string) (OAuthProviderConfig, error) {
+	prefix := "OAUTH_" + strings.ToUpper(name) + "_"
+	getenv := func(key string) string {
+		if value := os.Getenv(prefix + key); value != "" {
+			return value
+		}
+		if name == "google" {
+			return os.Getenv("OAUTH_" + key)
+		}
+		return ""
 	}
 
-	return nil
+	provider := OAuthProviderConfig{
+		Name:         name,
+		DiscoveryURL: getenv("DISCOVERY_URL"),
+		ClientID:     getenv("CLIENT_ID"),
+		RedirectURL:  getenv("REDIRECT_URL"),
+		Scopes:       strings.Fields(strings.ReplaceAll(getenv("SCOPES"), ",", " ")),
+	}
+	if provider.DiscoveryURL == "" && name == "google" {
+		provider.DiscoveryURL = googleDiscoveryURL
+	}
+	if len(provider.Scopes) == 0 {
+		provider.Scopes = defaultOAuthScopes
+	}
+
+	if clientSecretFile := getenv("CLIENT_SECRET_FILE"); clientSecretFile != "" {
+		secretBytes, err := os.ReadFile(clientSecretFile)
+		if err != nil {
+			return OAuthProviderConfig{}, fmt.Errorf("failed to read OAuth client secret file of %s: %w", name, err)
+		}
+		provider.ClientSecret = strings.TrimSpace(string(secretBytes))
+	}
+
+	if provider.DiscoveryURL == "" || provider.ClientID == "" || provider.ClientSecret == "" || provider.RedirectURL == "" {
+		return OAuthProviderConfig{}, fmt.Errorf("missing required OAuth configuration for %s", name)
+	}
+
+	return provider, nil
 }
 
-func GeneratePKCE() (*PKCEParams, error) {
+func GeneratePKCE() (*domain.PKCEParams, error) {
 	verifier, err := generateRandomString(43)
 	if err != nil {
 		return nil, err
@@ -63,23 +141,12 @@ func GeneratePKCE() (*PKCEParams, error) {
 	h.Write([]byte(verifier))
 	challenge := base64.RawURLEncoding.EncodeToString(h.Sum(nil))
 
-	return &PKCEParams{
+	return &domain.PKCEParams{
 		Verifier:  verifier,
 		Challenge: challenge,
 	}, nil
 }
 
-func StorePKCE(state string, params *PKCEParams) {
-	pkceStore.Store(state, params)
-}
-
-func GetAndDeletePKCE(state string) (*PKCEParams, bool) {
-	if value, ok := pkceStore.LoadAndDelete(state); ok {
-		return value.(*PKCEParams), true
-	}
-	return nil, false
-}
-
 // generateRandomString creates a random string of specified length
 func generateRandomString(length int) (string, error) {
 	b := make([]byte, length)
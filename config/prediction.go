@@ -0,0 +1,33 @@
+package config
+
+import (
+	"os"
+	"strconv"
+)
+
+// PredictionScoringConfig holds the points awarded by the prediction game
+type PredictionScoringConfig struct {
+	ExactScore     uint8
+	CorrectOutcome uint8
+	GoalDifference uint8
+}
+
+// GetPredictionScoring reads the prediction points from the environment,
+// falling back to 5 points for an exact score, 3 for the correct outcome
+// and a 1 point bonus when the goal difference is also right.
+func GetPredictionScoring() PredictionScoringConfig {
+	return PredictionScoringConfig{
+		ExactScore:     getUint8Env("PREDICTION_POINTS_EXACT", 5),
+		CorrectOutcome: getUint8Env("PREDICTION_POINTS_OUTCOME", 3),
+		GoalDifference: getUint8Env("PREDICTION_POINTS_GOAL_DIFFERENCE", 1),
+	}
+}
+
+// getUint8Env parses an environment variable as uint8, returning the default when unset or invalid
+func getUint8Env(key string, defaultValue uint8) uint8 {
+	value, err := strconv.ParseUint(os.Getenv(key), 10, 8)
+	if err != nil {
+		return defaultValue
+	}
+	return uint8(value)
+}
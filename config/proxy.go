@@ -0,0 +1,20 @@
+package config
+
+import (
+	"os"
+	"strings"
+)
+
+// GetTrustedProxies returns the addresses or CIDR ranges of the reverse proxies whose
+// X-Forwarded-For and X-Real-IP headers are trusted, read from the comma-separated
+// TRUSTED_PROXIES. When empty no proxy is trusted and the client IP is the peer address,
+// so a client cannot choose the address its rate limits and lockouts are counted for.
+func GetTrustedProxies() []string {
+	var proxies []string
+	for _, proxy := range strings.Split(os.Getenv("TRUSTED_PROXIES"), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+	return proxies
+}
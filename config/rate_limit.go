@@ -0,0 +1,49 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// Supported values of RATE_LIMIT_STORE
+const (
+	RateLimitStoreMemory   = "memory"   // Each replica enforces the limits on its own
+	RateLimitStorePostgres = "postgres" // Limits are shared by every replica through the database
+)
+
+// RateLimitConfig holds the rate limit policy of each route group.
+type RateLimitConfig struct {
+	Store       string
+	API         domain.RateLimitPolicy // Every request, per IP address
+	Auth        domain.RateLimitPolicy // /users/auth/*, per IP address
+	AdminWrites domain.RateLimitPolicy // Admin writes, per user or API key
+}
+
+// GetRateLimitConfig reads the rate limits from RATE_LIMIT_STORE, RATE_LIMIT_API_PER_MINUTE
+// (default 120), RATE_LIMIT_AUTH_PER_MINUTE (default 10) and RATE_LIMIT_ADMIN_WRITES_PER_MINUTE
+// (default 30).
+func GetRateLimitConfig() RateLimitConfig {
+	store := RateLimitStoreMemory
+	if os.Getenv("RATE_LIMIT_STORE") == RateLimitStorePostgres {
+		store = RateLimitStorePostgres
+	}
+
+	return RateLimitConfig{
+		Store:       store,
+		API:         perMinutePolicy("api", "RATE_LIMIT_API_PER_MINUTE", 120),
+		Auth:        perMinutePolicy("auth", "RATE_LIMIT_AUTH_PER_MINUTE", 10),
+		AdminWrites: perMinutePolicy("admin_writes", "RATE_LIMIT_ADMIN_WRITES_PER_MINUTE", 30),
+	}
+}
+
+// perMinutePolicy builds a policy allowing the number of requests per minute read from key.
+func perMinutePolicy(name, key string, defaultLimit int) domain.RateLimitPolicy {
+	limit, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || limit <= 0 {
+		limit = defaultLimit
+	}
+	return domain.RateLimitPolicy{Name: name, Limit: limit, Window: time.Minute}
+}
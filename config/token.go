@@ -0,0 +1,38 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// GetAccessTokenTTL returns the lifetime of the JWT access token,
+// read from ACCESS_TOKEN_TTL_MINUTES and defaulting to 60 minutes.
+func GetAccessTokenTTL() time.Duration {
+	minutes, err := strconv.Atoi(os.Getenv("ACCESS_TOKEN_TTL_MINUTES"))
+	if err != nil || minutes <= 0 {
+		return time.Hour
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// GetRefreshTokenTTL returns the lifetime of a refresh token,
+// read from REFRESH_TOKEN_TTL_HOURS and defaulting to 7 days.
+func GetRefreshTokenTTL() time.Duration {
+	hours, err := strconv.Atoi(os.Getenv("REFRESH_TOKEN_TTL_HOURS"))
+	if err != nil || hours <= 0 {
+		return 7 * 24 * time.Hour
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// GetJWTKeysDir returns the directory holding the JWT signing keys, read from JWT_KEYS_DIR.
+func GetJWTKeysDir() string {
+	return os.Getenv("JWT_KEYS_DIR")
+}
+
+// GetJWTSigningKeyID returns the ID of the key new tokens are signed with, read from
+// JWT_SIGNING_KEY_ID. When empty the last private key of the directory is used.
+func GetJWTSigningKeyID() string {
+	return os.Getenv("JWT_SIGNING_KEY_ID")
+}
@@ -0,0 +1,17 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+// GetTrashRetention returns how long deleted records can be restored before they are purged,
+// read from TRASH_RETENTION_DAYS and defaulting to 30 days.
+func GetTrashRetention() time.Duration {
+	days, err := strconv.Atoi(os.Getenv("TRASH_RETENTION_DAYS"))
+	if err != nil || days <= 0 {
+		return 30 * 24 * time.Hour
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
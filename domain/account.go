@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// Placeholder values of an anonymized account.
+const (
+	AnonymizedUserName     = "Deleted"
+	AnonymizedUserLastName = "User"
+	AnonymizedEmailDomain  = "deleted.invalid"
+)
+
+// UserPersonalFields are the fields of a User that identify the person, which Anonymize
+// replaces and which are redacted from the audit log when the account is erased.
+var UserPersonalFields = []string{"Name", "LastName", "Username", "Birthdate", "ImgProfile", "ImgBanner"}
+
+// AccountDeletion is a user's request to erase their account. The account is anonymized once
+// ScheduledAt has passed, unless the user cancels the request before.
+type AccountDeletion struct {
+	UserID      string
+	RequestedAt time.Time
+	ScheduledAt time.Time
+}
+
+// NewAccountDeletion creates a deletion request carried out after the grace period.
+func NewAccountDeletion(userID string, requestedAt time.Time, gracePeriod time.Duration) *AccountDeletion {
+	return &AccountDeletion{
+		UserID:      userID,
+		RequestedAt: requestedAt,
+		ScheduledAt: requestedAt.Add(gracePeriod),
+	}
+}
+
+// IsDue reports whether the grace period is over.
+func (d *AccountDeletion) IsDue(now time.Time) bool {
+	return !now.Before(d.ScheduledAt)
+}
+
+// UserDataExport gathers the personal data held about a user.
+type UserDataExport struct {
+	GeneratedAt    time.Time
+	User           User
+	Player         *Player // Player profile linked to the user, if any
+	Predictions    []Prediction
+	MVPVotes       []MVPVote
+	Sessions       []Session
+	Identities     []UserIdentity
+	APIKeys        []APIKey
+	ProfileChanges []UserProfileChange
+	AuditEntries   []AuditEntry     // Changes made by the user and changes made to their account
+	Deletion       *AccountDeletion // Pending deletion request, if any
+}
+
+// Anonymize replaces the personal data of the user with placeholders. The ID is kept so
+// predictions and votes stay counted, and the username becomes a unique address that
+// cannot receive mail or match a real sign-in.
+func (u *User) Anonymize() {
+	u.Name = AnonymizedUserName
+	u.LastName = AnonymizedUserLastName
+	u.Username = strings.ReplaceAll(u.ID, "-", "") + "@" + AnonymizedEmailDomain
+	u.Birthdate = nil
+	u.ImgProfile = ""
+	u.ImgBanner = ""
+	u.TeamID = nil
+}
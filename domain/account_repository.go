@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// AccountRepository defines the persistence operations behind data-subject requests:
+// exporting the personal data of a user and erasing it.
+// This port belongs in the domain layer following hexagonal architecture.
+type AccountRepository interface {
+	// GetUserDataExport returns the personal data of the user, or nil when the user does not exist.
+	GetUserDataExport(ctx context.Context, userID string) (*UserDataExport, error)
+
+	CreateAccountDeletion(ctx context.Context, deletion *AccountDeletion) error
+	GetAccountDeletion(ctx context.Context, userID string) (*AccountDeletion, error)
+	// DeleteAccountDeletion cancels a deletion request and reports whether there was one.
+	DeleteAccountDeletion(ctx context.Context, userID string) (bool, error)
+	// GetDueAccountDeletions returns the requests whose grace period is over.
+	GetDueAccountDeletions(ctx context.Context, now time.Time) ([]AccountDeletion, error)
+
+	// AnonymizeUser saves the anonymized user in one transaction with the deletion of their
+	// sign-in methods, sessions, API keys and profile history, and of the deletion request.
+	// The audit entries are kept, but the UserPersonalFields are removed from the snapshots
+	// and changes of their account, and the IP address from the entries they made.
+	// The linked player profile is kept but unlinked, like deleting the user would do.
+	AnonymizeUser(ctx context.Context, user *User) error
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// APIKeyUsageInterval is how often the last-used timestamp of a key is refreshed.
+// Recording every request would turn each authenticated read into a write.
+const APIKeyUsageInterval = time.Minute
+
+// APIKey is a long-lived credential for machine clients such as the scoreboard display.
+// Only the hash of the key is stored; the prefix is kept in clear to look the key up.
+// A key acts on behalf of its owner and is limited to its scopes.
+type APIKey struct {
+	ID         string
+	UserID     string
+	Name       string
+	Prefix     string
+	KeyHash    string
+	Scopes     []string
+	ExpiresAt  *time.Time // Nil for keys that never expire
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// IsActive reports whether the key can still authenticate requests.
+func (k *APIKey) IsActive(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// IsValid performs basic domain validation for the key.
+func (k *APIKey) IsValid() bool {
+	return k.UserID != "" && k.Name != "" && len(k.Name) <= 100
+}
+
+// NeedsUsageUpdate reports whether the last-used timestamp is stale enough to be recorded again.
+func (k *APIKey) NeedsUsageUpdate(now time.Time) bool {
+	return k.LastUsedAt == nil || now.Sub(*k.LastUsedAt) >= APIKeyUsageInterval
+}
+
+// EffectivePermissions returns the scopes of the key that the owner's role still grants,
+// so a key never outlives a downgrade of its owner.
+func (k *APIKey) EffectivePermissions(role *Role) []string {
+	if role == nil {
+		return []string{}
+	}
+
+	granted := role.EffectivePermissions()
+	permissions := make([]string, 0, len(k.Scopes))
+	for _, scope := range k.Scopes {
+		if slices.Contains(granted, scope) {
+			permissions = append(permissions, scope)
+		}
+	}
+	return permissions
+}
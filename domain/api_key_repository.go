@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// APIKeyRepository defines the interface for API key persistence operations.
+// This port belongs in the domain layer following hexagonal architecture.
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, apiKey *APIKey) error
+	GetAPIKeyByID(ctx context.Context, id string) (*APIKey, error)
+
+	// GetAPIKeyByPrefix returns the key with the given public prefix, revoked or not.
+	GetAPIKeyByPrefix(ctx context.Context, prefix string) (*APIKey, error)
+
+	// GetAPIKeysByUserID returns the keys of the user that were not revoked, newest first.
+	GetAPIKeysByUserID(ctx context.Context, userID string) ([]APIKey, error)
+
+	RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) error
+	TouchAPIKey(ctx context.Context, id string, lastUsedAt time.Time) error
+}
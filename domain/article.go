@@ -14,6 +14,7 @@ type Article struct {
 	Date      time.Time
 	SeasonID  uint64
 	Season    *Season
+	Version   uint64
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
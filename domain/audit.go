@@ -0,0 +1,242 @@
+package domain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Audit actions.
+const (
+	AuditActionCreate  = "create"
+	AuditActionUpdate  = "update"
+	AuditActionDelete  = "delete"
+	AuditActionRestore = "restore"
+)
+
+// Audited entity types.
+const (
+	AuditEntityAPIKey       = "api_key"
+	AuditEntityArticle      = "article"
+	AuditEntityEmailDomains = "email_domains"
+	AuditEntityInvitation   = "invitation"
+	AuditEntityLineup       = "lineup"
+	AuditEntityMatch        = "match"
+	AuditEntityMatchReport  = "match_report"
+	AuditEntityPlayer       = "player"
+	AuditEntityPlayerClaim  = "player_claim"
+	AuditEntityPlayerStats  = "player_stats"
+	AuditEntityPlayerTeam   = "player_team"
+	AuditEntityRole         = "role"
+	AuditEntitySeason       = "season"
+	AuditEntityTeam         = "team"
+	AuditEntityTeamStats    = "team_stats"
+	AuditEntityUser         = "user"
+	AuditEntityVenue        = "venue"
+)
+
+// auditRedactedFields hold secrets, even hashed, and are never written to the audit log.
+var auditRedactedFields = []string{"KeyHash", "TokenHash", "PasswordHash"}
+
+// auditUntrackedFields are kept in the snapshots but not reported as changes, since updates
+// may be given without them or they change on every write.
+var auditUntrackedFields = []string{"ID", "Version", "CreatedAt", "UpdatedAt"}
+
+var auditEntityTypes = []string{
+	AuditEntityAPIKey,
+	AuditEntityArticle,
+	AuditEntityEmailDomains,
+	AuditEntityInvitation,
+	AuditEntityLineup,
+	AuditEntityMatch,
+	AuditEntityMatchReport,
+	AuditEntityPlayer,
+	AuditEntityPlayerClaim,
+	AuditEntityPlayerStats,
+	AuditEntityPlayerTeam,
+	AuditEntityRole,
+	AuditEntitySeason,
+	AuditEntityTeam,
+	AuditEntityTeamStats,
+	AuditEntityUser,
+	AuditEntityVenue,
+}
+
+// AuditEntry records a change made to an entity, who made it and from where.
+// Before is empty for creations and After for deletions.
+type AuditEntry struct {
+	ID         uint64
+	ActorID    *string // Nil for changes not made by a user, such as background jobs
+	APIKeyID   *string // Set when the actor authenticated with an API key
+	Action     string
+	EntityType string
+	EntityID   string
+	Before     json.RawMessage
+	After      json.RawMessage
+	Changes    []AuditChange
+	RequestID  string
+	IPAddress  string
+	CreatedAt  time.Time
+	Actor      *User
+}
+
+// AuditChange is the old and new value of a field changed by an audited action.
+type AuditChange struct {
+	Field string          `json:"field"`
+	Old   json.RawMessage `json:"old,omitempty"`
+	New   json.RawMessage `json:"new,omitempty"`
+}
+
+// AuditActor identifies who makes the changes of a request.
+type AuditActor struct {
+	UserID    string
+	APIKeyID  string
+	RequestID string
+	IPAddress string
+}
+
+type auditActorKey struct{}
+
+// WithAuditActor returns a context whose audited changes are attributed to the actor.
+func WithAuditActor(ctx context.Context, actor AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// AuditActorFromContext returns the actor stored in the context, or an empty actor.
+func AuditActorFromContext(ctx context.Context) AuditActor {
+	actor, _ := ctx.Value(auditActorKey{}).(AuditActor)
+	return actor
+}
+
+// Auditor records the changes made by the domain services. Recording never fails the
+// change itself, which has already been saved.
+type Auditor interface {
+	Record(ctx context.Context, action, entityType string, entityID any, before, after any)
+}
+
+// AuditSnapshot captures the state of an entity before it is changed in place.
+// Snapshots can be passed to Auditor.Record as the before or after state.
+func AuditSnapshot(entity any) json.RawMessage {
+	snapshot, err := auditFields(entity)
+	if err != nil || snapshot == nil {
+		return nil
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// NewAuditEntry builds an entry with the before and after state of the entity and the fields
+// that differ between them. Related entities are left out; their IDs are part of the entity.
+func NewAuditEntry(action, entityType string, entityID any, before, after any) (*AuditEntry, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the previous state: %w", err)
+	}
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the new state: %w", err)
+	}
+
+	entry := &AuditEntry{
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   fmt.Sprint(entityID),
+		Changes:    diffAuditFields(beforeFields, afterFields),
+	}
+	if beforeFields != nil {
+		if entry.Before, err = json.Marshal(beforeFields); err != nil {
+			return nil, err
+		}
+	}
+	if afterFields != nil {
+		if entry.After, err = json.Marshal(afterFields); err != nil {
+			return nil, err
+		}
+	}
+	return entry, nil
+}
+
+// IsValidAuditEntityType reports whether the entity type is audited.
+func IsValidAuditEntityType(entityType string) bool {
+	return slices.Contains(auditEntityTypes, entityType)
+}
+
+// IsValidAuditAction reports whether the action is one of the audit actions.
+func IsValidAuditAction(action string) bool {
+	return action == AuditActionCreate || action == AuditActionUpdate || action == AuditActionDelete || action == AuditActionRestore
+}
+
+// auditFields encodes the entity as a JSON object without its nested objects, so related
+// entities that were or were not loaded do not show up as changes, and without secrets.
+func auditFields(entity any) (map[string]json.RawMessage, error) {
+	if entity == nil {
+		return nil, nil
+	}
+	data, ok := entity.(json.RawMessage)
+	if !ok {
+		var err error
+		if data, err = json.Marshal(entity); err != nil {
+			return nil, err
+		}
+	}
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		return nil, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		// Values that are not objects, such as lists, are kept whole
+		return map[string]json.RawMessage{"value": data}, nil
+	}
+	for name, value := range fields {
+		if isNestedAuditValue(value) || slices.Contains(auditRedactedFields, name) {
+			delete(fields, name)
+		}
+	}
+	return fields, nil
+}
+
+// isNestedAuditValue reports whether the value is an object or a list of objects.
+func isNestedAuditValue(value json.RawMessage) bool {
+	trimmed := bytes.TrimLeft(value, " \t\r\n")
+	if len(trimmed) == 0 {
+		return false
+	}
+	if trimmed[0] == '{' {
+		return true
+	}
+	if trimmed[0] == '[' {
+		return bytes.HasPrefix(bytes.TrimLeft(trimmed[1:], " \t\r\n"), []byte("{"))
+	}
+	return false
+}
+
+// diffAuditFields returns the fields whose values differ, sorted by name.
+func diffAuditFields(before, after map[string]json.RawMessage) []AuditChange {
+	names := make([]string, 0, len(before)+len(after))
+	for name := range before {
+		names = append(names, name)
+	}
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	changes := []AuditChange{}
+	for _, name := range names {
+		oldValue, newValue := before[name], after[name]
+		if bytes.Equal(oldValue, newValue) || slices.Contains(auditUntrackedFields, name) {
+			continue
+		}
+		changes = append(changes, AuditChange{Field: name, Old: oldValue, New: newValue})
+	}
+	return changes
+}
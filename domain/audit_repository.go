@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+)
+
+// AuditFilter narrows the audit entries returned by a query. Empty fields match every entry.
+type AuditFilter struct {
+	EntityType string
+	EntityID   string
+	ActorID    string
+	Action     string
+}
+
+// AuditRepository defines the interface for the audit log. Entries are never updated.
+type AuditRepository interface {
+	CreateAuditEntry(ctx context.Context, entry *AuditEntry) error
+
+	// GetPaginatedAuditEntries returns the entries matching the filter, newest first.
+	GetPaginatedAuditEntries(ctx context.Context, filter AuditFilter, page int, pageSize int) ([]AuditEntry, int64, error)
+}
@@ -1,17 +1,29 @@
 package domain
 
 import (
+	"slices"
 	"time"
 )
 
 // AuthenticationClaims represents the authentication information in the domain layer.
 // It encapsulates the user's identity and permissions
 type AuthenticationClaims struct {
-	UserID    string
-	Username  string
-	Role      string
-	IssuedAt  time.Time
-	ExpiresAt time.Time
+	TokenID     string
+	SessionID   string
+	APIKeyID    string // Set instead of TokenID and SessionID when authenticated with an API key
+	UserID      string
+	Username    string
+	Role        string
+	Permissions []string
+	IssuedAt    time.Time
+	ExpiresAt   time.Time
+}
+
+// SessionPolicy holds the lifetimes of the tokens issued for a session and the session limit.
+type SessionPolicy struct {
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	MaxSessions     int // Oldest sessions are evicted beyond this limit; zero disables it
 }
 
 // IsValid checks if the authentication claims are valid and not expired.
@@ -36,6 +48,11 @@ func (a *AuthenticationClaims) TimeUntilExpiry() time.Duration {
 	return time.Until(a.ExpiresAt)
 }
 
+// HasPermission checks if the authenticated user has been granted the permission.
+func (a *AuthenticationClaims) HasPermission(permission string) bool {
+	return slices.Contains(a.Permissions, permission)
+}
+
 // HasRole checks if the authenticated user has the specified role.
 func (a *AuthenticationClaims) HasRole(role string) bool {
 	return a.Role == role
@@ -7,8 +7,39 @@ import (
 // AuthenticationRepository defines the interface for authentication operations.
 // This port belongs in the domain layer
 type AuthenticationRepository interface {
-	// GenerateAccessToken creates a new authentication token for the given user
-	GenerateAccessToken(ctx context.Context, user *User) (string, error)
+	// GenerateAccessToken creates a new authentication token for the given user and session
+	GenerateAccessToken(ctx context.Context, user *User, sessionID string) (string, error)
+
+	// GenerateRefreshToken creates a new opaque refresh token and returns it with the hash to store
+	GenerateRefreshToken() (token string, tokenHash string, err error)
+
+	// HashRefreshToken returns the hash under which a refresh token is stored
+	HashRefreshToken(token string) string
+
+	// GenerateAPIKey creates a new API key and returns it with its lookup prefix and the hash to store
+	GenerateAPIKey() (key string, prefix string, keyHash string, err error)
+
+	// ParseAPIKey returns the lookup prefix and hash of an API key, or false when it is malformed
+	ParseAPIKey(key string) (prefix string, keyHash string, ok bool)
+
+	// GeneratePasswordResetToken creates a new single-use reset token and returns it with the hash to store
+	GeneratePasswordResetToken() (token string, tokenHash string, err error)
+
+	// HashPasswordResetToken returns the hash under which a password reset token is stored
+	HashPasswordResetToken(token string) string
+
+	// GenerateInvitationToken creates a new single-use invitation token and returns it with the hash to store
+	GenerateInvitationToken() (token string, tokenHash string, err error)
+
+	// HashInvitationToken returns the hash under which an invitation token is stored
+	HashInvitationToken(token string) string
+
+	// HashPassword hashes a password for storage
+	HashPassword(password string) (string, error)
+
+	// VerifyPassword checks a password against its stored hash. An empty hash never matches but
+	// takes as long as a real check. needsRehash reports that the hash should be replaced.
+	VerifyPassword(passwordHash string, password string) (match bool, needsRehash bool, err error)
 
 	// ValidateAccessToken validates a token and returns the authentication claims
 	ValidateAccessToken(ctx context.Context, token string) (*AuthenticationClaims, error)
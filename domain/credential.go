@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"time"
+)
+
+// PasswordCredential holds the local password of a user. Users who only sign in
+// through an external provider have none.
+type PasswordCredential struct {
+	UserID       string
+	PasswordHash string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// PasswordResetToken lets a user choose a new password once. Only the hash of the token is stored.
+type PasswordResetToken struct {
+	ID        uint64
+	UserID    string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// LoginAttempt records a password login for the lockout policy.
+type LoginAttempt struct {
+	ID        uint64
+	IPAddress string
+	Username  string
+	Succeeded bool
+	CreatedAt time.Time
+}
+
+// PasswordPolicy holds the password rules and the login lockout policy.
+type PasswordPolicy struct {
+	MinLength        int
+	MaxLength        int
+	MaxLoginAttempts int           // Failed logins allowed from an IP address within the lockout duration
+	LockoutDuration  time.Duration // How long an IP address is locked out after too many failures
+	ResetTokenTTL    time.Duration
+}
+
+// IsValidPassword checks the password length against the policy.
+func (p PasswordPolicy) IsValidPassword(password string) bool {
+	length := len([]rune(password))
+	return length >= p.MinLength && length <= p.MaxLength
+}
+
+// IsUsable reports whether the reset token can still be redeemed.
+func (t *PasswordResetToken) IsUsable(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// CredentialRepository defines the interface for local password persistence operations.
+// This port belongs in the domain layer following hexagonal architecture.
+type CredentialRepository interface {
+	GetPasswordCredential(ctx context.Context, userID string) (*PasswordCredential, error)
+
+	// SavePasswordCredential creates the credential or replaces the password of an existing one.
+	SavePasswordCredential(ctx context.Context, credential *PasswordCredential) error
+
+	DeletePasswordCredential(ctx context.Context, userID string) error
+
+	CreatePasswordResetToken(ctx context.Context, token *PasswordResetToken) error
+	GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*PasswordResetToken, error)
+
+	// ResetPassword marks the token as used and replaces the password in a single transaction.
+	// It returns constants.ErrInvalidResetToken when the token was already used.
+	ResetPassword(ctx context.Context, tokenID uint64, usedAt time.Time, credential *PasswordCredential) error
+
+	DeleteExpiredPasswordResetTokens(ctx context.Context, before time.Time) (int64, error)
+}
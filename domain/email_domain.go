@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Email domain rule kinds.
+const (
+	EmailDomainAllow = "allow"
+	EmailDomainBlock = "block"
+)
+
+// emailDomainRegex matches a host name with at least two labels, such as "example.com".
+var emailDomainRegex = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+
+// EmailDomainRule allows or blocks the creation of accounts for the emails of a domain.
+type EmailDomainRule struct {
+	ID          uint64
+	Domain      string
+	Kind        string
+	CreatedByID *string // Nil for the default rules
+	CreatedAt   time.Time
+}
+
+// EmailDomainPolicy decides which email domains may be used for new accounts. Blocked
+// domains are always rejected; when the allowlist is empty every other domain is allowed.
+type EmailDomainPolicy struct {
+	Allowed     []string
+	Blocked     []string
+	UpdatedByID *string
+	UpdatedAt   time.Time
+}
+
+// NewEmailDomainPolicy builds the policy from its stored rules.
+func NewEmailDomainPolicy(rules []EmailDomainRule) *EmailDomainPolicy {
+	policy := &EmailDomainPolicy{Allowed: []string{}, Blocked: []string{}}
+	for _, rule := range rules {
+		switch rule.Kind {
+		case EmailDomainAllow:
+			policy.Allowed = append(policy.Allowed, rule.Domain)
+		case EmailDomainBlock:
+			policy.Blocked = append(policy.Blocked, rule.Domain)
+		}
+		if rule.CreatedAt.After(policy.UpdatedAt) {
+			policy.UpdatedAt = rule.CreatedAt
+			policy.UpdatedByID = rule.CreatedByID
+		}
+	}
+	slices.Sort(policy.Allowed)
+	slices.Sort(policy.Blocked)
+	return policy
+}
+
+// Version identifies the stored policy for If-Match checks. Every update replaces all the
+// rules at once, so the time of the last update changes whenever the policy does.
+func (p *EmailDomainPolicy) Version() uint64 {
+	if p.UpdatedAt.IsZero() {
+		return 0
+	}
+	return uint64(p.UpdatedAt.UnixMicro())
+}
+
+// Rules returns the rules that store the policy, created by the given user.
+func (p *EmailDomainPolicy) Rules(createdByID *string) []EmailDomainRule {
+	rules := make([]EmailDomainRule, 0, len(p.Allowed)+len(p.Blocked))
+	for _, d := range p.Allowed {
+		rules = append(rules, EmailDomainRule{Domain: d, Kind: EmailDomainAllow, CreatedByID: createdByID})
+	}
+	for _, d := range p.Blocked {
+		rules = append(rules, EmailDomainRule{Domain: d, Kind: EmailDomainBlock, CreatedByID: createdByID})
+	}
+	return rules
+}
+
+// Normalize lowercases the domains and removes duplicates.
+func (p *EmailDomainPolicy) Normalize() {
+	normalize := func(domains []string) []string {
+		result := make([]string, 0, len(domains))
+		for _, d := range domains {
+			result = append(result, NormalizeEmailDomain(d))
+		}
+		slices.Sort(result)
+		return slices.Compact(result)
+	}
+	p.Allowed = normalize(p.Allowed)
+	p.Blocked = normalize(p.Blocked)
+}
+
+// IsValid checks that every domain is a valid host name and none is both allowed and blocked.
+func (p *EmailDomainPolicy) IsValid() bool {
+	for _, d := range p.Allowed {
+		if !emailDomainRegex.MatchString(d) || slices.Contains(p.Blocked, d) {
+			return false
+		}
+	}
+	for _, d := range p.Blocked {
+		if !emailDomainRegex.MatchString(d) {
+			return false
+		}
+	}
+	return true
+}
+
+// AllowsEmail reports whether a new account may be created for the email.
+func (p *EmailDomainPolicy) AllowsEmail(email string) bool {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return false
+	}
+	emailDomain := NormalizeEmailDomain(email[at+1:])
+	if slices.Contains(p.Blocked, emailDomain) {
+		return false
+	}
+	return len(p.Allowed) == 0 || slices.Contains(p.Allowed, emailDomain)
+}
+
+// NormalizeEmailDomain lowercases the domain and removes surrounding spaces and a leading "@".
+func NormalizeEmailDomain(emailDomain string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(emailDomain)), "@")
+}
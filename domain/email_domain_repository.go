@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"context"
+)
+
+// EmailDomainRuleRepository defines the interface for the stored email domain rules.
+type EmailDomainRuleRepository interface {
+	GetEmailDomainRules(ctx context.Context) ([]EmailDomainRule, error)
+
+	// ReplaceEmailDomainRules replaces the previous rules with the given ones in a single transaction.
+	// It returns constants.ErrVersionMismatch when the previous rules were already replaced.
+	ReplaceEmailDomainRules(ctx context.Context, previous []EmailDomainRule, rules []EmailDomainRule) error
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+)
+
+// IdempotentRequest records an admin request sent with an Idempotency-Key header so that a
+// retry with the same key gets the original response instead of repeating the write.
+// It is pending, with a zero StatusCode, while the first request is being handled.
+type IdempotentRequest struct {
+	Key          string // Hash of the client and the Idempotency-Key header
+	Fingerprint  string // Hash of the method, path and body of the request
+	StatusCode   int
+	ContentType  string
+	Location     string
+	ETag         string
+	Body         []byte
+	BodyWithheld bool // The response held a secret, so its body was not stored and is not replayed
+	ExpiresAt    time.Time
+}
+
+// IsCompleted reports whether the response of the request has been stored.
+func (r *IdempotentRequest) IsCompleted() bool {
+	return r.StatusCode != 0
+}
+
+// IsExpired reports whether the key can be reused for a new request.
+func (r *IdempotentRequest) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
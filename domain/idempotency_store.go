@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// IdempotencyStore defines the interface for the requests sent with an Idempotency-Key.
+// Implementations shared by every API replica catch retries sent to another replica.
+type IdempotencyStore interface {
+	// ClaimIdempotencyKey stores the pending request unless a request with the same key and
+	// not yet expired exists, in which case that request is returned instead. It returns nil
+	// when the key was claimed.
+	ClaimIdempotencyKey(ctx context.Context, request *IdempotentRequest, now time.Time) (*IdempotentRequest, error)
+
+	// CompleteIdempotentRequest stores the response fields of a claimed request.
+	CompleteIdempotentRequest(ctx context.Context, request *IdempotentRequest) error
+
+	// ReleaseIdempotencyKey deletes a claimed request so that the key can be retried.
+	ReleaseIdempotencyKey(ctx context.Context, key string) error
+
+	// DeleteExpiredIdempotencyKeys removes the requests whose keys have expired.
+	DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error)
+}
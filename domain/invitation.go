@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// Invitation statuses, derived from the invitation timestamps
+const (
+	InvitationStatusPending  = "pending"
+	InvitationStatusAccepted = "accepted"
+	InvitationStatusExpired  = "expired"
+	InvitationStatusRevoked  = "revoked"
+)
+
+// Invitation lets an administrator create an account with a role and team ahead of the
+// first login. It is sent by email with a single-use token; only the hash of the token is
+// stored. The invitee accepts it by signing in with any provider, whose identity is then
+// bound to the new account whatever its email.
+type Invitation struct {
+	ID           uint64
+	Email        string
+	RoleID       uint64
+	TeamID       *uint64 // Team managed by the invitee when they are a coach
+	TokenHash    string
+	InvitedByID  string
+	ExpiresAt    time.Time
+	AcceptedAt   *time.Time
+	AcceptedByID *string // User created when the invitation was accepted
+	RevokedAt    *time.Time
+	CreatedAt    time.Time
+
+	// Related entities
+	Role *Role
+	Team *Team
+}
+
+// NormalizedEmail returns the email in the form used as username.
+func (i *Invitation) NormalizedEmail() string {
+	return strings.ToLower(strings.TrimSpace(i.Email))
+}
+
+// IsValid performs basic domain validation for the invitation.
+func (i *Invitation) IsValid() bool {
+	user := User{Username: i.NormalizedEmail()}
+	return user.isValidEmail() && i.RoleID > 0 && i.TokenHash != "" && i.InvitedByID != ""
+}
+
+// IsUsable reports whether the invitation can still be accepted.
+func (i *Invitation) IsUsable(now time.Time) bool {
+	return i.AcceptedAt == nil && i.RevokedAt == nil && now.Before(i.ExpiresAt)
+}
+
+// Status returns the status of the invitation at the given time.
+func (i *Invitation) Status(now time.Time) string {
+	switch {
+	case i.AcceptedAt != nil:
+		return InvitationStatusAccepted
+	case i.RevokedAt != nil:
+		return InvitationStatusRevoked
+	case !now.Before(i.ExpiresAt):
+		return InvitationStatusExpired
+	}
+	return InvitationStatusPending
+}
+
+// IsValidInvitationStatus reports whether the status is a known invitation status.
+func IsValidInvitationStatus(status string) bool {
+	switch status {
+	case InvitationStatusPending, InvitationStatusAccepted, InvitationStatusExpired, InvitationStatusRevoked:
+		return true
+	}
+	return false
+}
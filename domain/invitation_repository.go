@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// InvitationFilter narrows the invitations listed. Empty fields match every invitation.
+type InvitationFilter struct {
+	Status string
+	Email  string
+}
+
+// InvitationRepository defines the interface for invitation persistence operations.
+// This port belongs in the domain layer following hexagonal architecture.
+type InvitationRepository interface {
+	// CreateInvitation stores the invitation and revokes the pending invitations for the same
+	// email in a single transaction, so only the latest link can be accepted.
+	CreateInvitation(ctx context.Context, invitation *Invitation) error
+
+	GetInvitationByID(ctx context.Context, id uint64) (*Invitation, error)
+	GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*Invitation, error)
+	GetPaginatedInvitations(ctx context.Context, filter InvitationFilter, now time.Time, page int, pageSize int) ([]Invitation, int64, error)
+
+	// RevokeInvitation marks a pending invitation as revoked.
+	// It returns constants.ErrInvitationNotUsable when it was already accepted or revoked.
+	RevokeInvitation(ctx context.Context, id uint64, revokedAt time.Time) error
+
+	// DeleteInvitation removes an invitation, used when its email could not be sent.
+	DeleteInvitation(ctx context.Context, id uint64) error
+
+	// AcceptInvitation creates the user and their identity and marks the invitation as accepted
+	// in a single transaction. It returns constants.ErrInvitationNotUsable when the invitation
+	// was accepted, revoked or expired in the meantime.
+	AcceptInvitation(ctx context.Context, invitationID uint64, user *User, identity *UserIdentity, acceptedAt time.Time) error
+}
@@ -10,6 +10,7 @@ type Lineup struct {
 	PlayerID  uint64
 	MatchID   uint64
 	Starting  bool
+	Version   uint64
 	CreatedAt time.Time
 	UpdatedAt time.Time
 
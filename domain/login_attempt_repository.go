@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// LoginAttemptRepository defines the interface for login attempt persistence operations.
+type LoginAttemptRepository interface {
+	// StartLoginAttempt records the attempt as failed before its password is checked. Attempts
+	// from the same IP address are recorded one at a time, so parallel requests cannot all pass
+	// the limit. When the failures from the address since the given time, this one included,
+	// exceed maxFailures nothing is recorded and constants.ErrTooManyLoginAttempts is returned.
+	StartLoginAttempt(ctx context.Context, attempt *LoginAttempt, since time.Time, maxFailures int) error
+
+	// MarkLoginAttemptSucceeded records that the password of a started attempt was correct.
+	// Earlier failures from the address are kept until they age out of the lockout duration.
+	MarkLoginAttemptSucceeded(ctx context.Context, id uint64) error
+
+	DeleteLoginAttemptsBefore(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"context"
+)
+
+// MailMessage is a plain text email.
+type MailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// MailSender defines the interface for delivering emails.
+// Implementations may deliver them through SMTP or write them somewhere for development.
+type MailSender interface {
+	Send(ctx context.Context, message *MailMessage) error
+}
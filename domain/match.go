@@ -4,19 +4,29 @@ import (
 	"time"
 )
 
+// Match statuses
+const (
+	MatchStatusScheduled  = "scheduled"
+	MatchStatusInProgress = "in_progress"
+	MatchStatusCompleted  = "completed"
+	MatchStatusPostponed  = "postponed"
+	MatchStatusCancelled  = "cancelled"
+)
+
 // Match represents the core Match entity in the domain layer.
 // This entity contains only business-relevant fields
 type Match struct {
 	ID          uint64
 	Status      string
 	Kickoff     time.Time
-	Location    string
+	VenueID     *uint64
 	HomeGoals   uint8
 	AwayGoals   uint8
 	HomeTeamID  uint64
 	AwayTeamID  uint64
 	SeasonID    uint64
 	MVPPlayerID *uint64
+	Version     uint64
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 
@@ -25,4 +35,19 @@ type Match struct {
 	AwayTeam  *Team
 	Season    *Season
 	MVPPlayer *Player
+	Venue     *Venue
+}
+
+// IsCompleted reports whether the match has reached full time.
+func (m *Match) IsCompleted() bool {
+	return m.Status == MatchStatusCompleted
+}
+
+// AcceptsPredictionsAt reports whether score predictions are still accepted at the given time.
+// Predictions close at kickoff and are not taken for matches already played or cancelled.
+func (m *Match) AcceptsPredictionsAt(now time.Time) bool {
+	if m.Status != MatchStatusScheduled && m.Status != MatchStatusPostponed {
+		return false
+	}
+	return now.Before(m.Kickoff)
 }
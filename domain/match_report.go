@@ -0,0 +1,259 @@
+package domain
+
+import (
+	"time"
+)
+
+// Match report statuses
+const (
+	MatchReportStatusPending  = "pending"
+	MatchReportStatusApproved = "approved"
+	MatchReportStatusRejected = "rejected"
+)
+
+// Match report event types
+const (
+	MatchEventGoal       = "goal"
+	MatchEventOwnGoal    = "own_goal"
+	MatchEventYellowCard = "yellow_card"
+	MatchEventRedCard    = "red_card"
+)
+
+// Points awarded in the standings for each result
+const (
+	PointsForWin  int16 = 3
+	PointsForDraw int16 = 1
+	PointsForLoss int16 = 0
+)
+
+// MatchReportEvent is a single scorer or card entry of a match report.
+// TeamID is the team the player belongs to, also for own goals.
+type MatchReportEvent struct {
+	ID             uint64
+	ReportID       uint64
+	Type           string
+	PlayerID       uint64
+	TeamID         uint64
+	AssistPlayerID *uint64
+	Minute         uint8
+
+	Player *Player
+}
+
+// MatchReport is the official post-match report submitted by a referee or coach.
+// It stays pending until an administrator approves or rejects it.
+type MatchReport struct {
+	ID             uint64
+	MatchID        uint64
+	SubmittedByID  string
+	Status         string
+	HomeGoals      uint8
+	AwayGoals      uint8
+	Attendance     uint32
+	Incidents      string
+	ReviewComments string
+	ReviewedByID   *string
+	ReviewedAt     *time.Time
+	Events         []MatchReportEvent
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	// Related entities
+	Match       *Match
+	SubmittedBy *User
+}
+
+// IsValid performs basic domain validation for the report.
+func (r *MatchReport) IsValid() bool {
+	if r.MatchID == 0 || r.SubmittedByID == "" || len(r.Incidents) > 2000 {
+		return false
+	}
+
+	for _, event := range r.Events {
+		if !event.isValid() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsValidMatchReportStatus reports whether the status is a known report status.
+func IsValidMatchReportStatus(status string) bool {
+	switch status {
+	case MatchReportStatusPending, MatchReportStatusApproved, MatchReportStatusRejected:
+		return true
+	}
+	return false
+}
+
+// IsPending reports whether the report is waiting for review.
+func (r *MatchReport) IsPending() bool {
+	return r.Status == MatchReportStatusPending
+}
+
+// CanBeEditedBy reports whether the given user may edit and resubmit the report.
+func (r *MatchReport) CanBeEditedBy(userID string) bool {
+	return r.SubmittedByID == userID && r.Status != MatchReportStatusApproved
+}
+
+// ConsistentWith verifies that the events reference the match teams and that,
+// when scorers are listed, they add up to the reported final score.
+func (r *MatchReport) ConsistentWith(match *Match) bool {
+	var homeGoals, awayGoals, goalEvents int
+	for _, event := range r.Events {
+		if event.TeamID != match.HomeTeamID && event.TeamID != match.AwayTeamID {
+			return false
+		}
+
+		scoredForHome := event.TeamID == match.HomeTeamID
+		switch event.Type {
+		case MatchEventGoal:
+			goalEvents++
+		case MatchEventOwnGoal:
+			goalEvents++
+			scoredForHome = !scoredForHome
+		default:
+			continue
+		}
+
+		if scoredForHome {
+			homeGoals++
+		} else {
+			awayGoals++
+		}
+	}
+
+	if goalEvents == 0 {
+		return true
+	}
+	return homeGoals == int(r.HomeGoals) && awayGoals == int(r.AwayGoals)
+}
+
+func (e *MatchReportEvent) isValid() bool {
+	if e.PlayerID == 0 || e.TeamID == 0 || e.Minute > 130 {
+		return false
+	}
+
+	switch e.Type {
+	case MatchEventGoal:
+		return e.AssistPlayerID == nil || *e.AssistPlayerID != e.PlayerID
+	case MatchEventOwnGoal, MatchEventYellowCard, MatchEventRedCard:
+		return e.AssistPlayerID == nil
+	}
+	return false
+}
+
+// StandingChange is the increment applied to a team's season standings.
+type StandingChange struct {
+	SeasonID     uint64
+	TeamID       uint64
+	Wins         uint16
+	Draws        uint16
+	Losses       uint16
+	GoalsFor     uint16
+	GoalsAgainst uint16
+	Points       int16
+}
+
+// MatchReportApproval groups every change produced by approving a report
+// so that it can be persisted atomically.
+type MatchReportApproval struct {
+	Report      *MatchReport
+	Match       *Match
+	PlayerStats []PlayerStat
+	Standings   []StandingChange
+}
+
+// BuildApproval computes the match result, per-player statistics and standings
+// changes resulting from approving the report for the given match.
+func (r *MatchReport) BuildApproval(match *Match, reviewerID string, reviewedAt time.Time) *MatchReportApproval {
+	report := *r
+	report.Status = MatchReportStatusApproved
+	report.ReviewedByID = &reviewerID
+	report.ReviewedAt = &reviewedAt
+
+	updatedMatch := *match
+	updatedMatch.HomeGoals = r.HomeGoals
+	updatedMatch.AwayGoals = r.AwayGoals
+
+	return &MatchReportApproval{
+		Report:      &report,
+		Match:       &updatedMatch,
+		PlayerStats: r.playerStatsFor(match),
+		Standings:   r.StandingsFor(match),
+	}
+}
+
+// playerStatsFor aggregates the report events into one stat line per player.
+func (r *MatchReport) playerStatsFor(match *Match) []PlayerStat {
+	statsByPlayer := make(map[uint64]*PlayerStat)
+	order := make([]uint64, 0)
+
+	statFor := func(playerID, teamID uint64) *PlayerStat {
+		stat, ok := statsByPlayer[playerID]
+		if !ok {
+			team := teamID
+			stat = &PlayerStat{
+				PlayerID: playerID,
+				MatchID:  match.ID,
+				SeasonID: match.SeasonID,
+				TeamID:   &team,
+			}
+			statsByPlayer[playerID] = stat
+			order = append(order, playerID)
+		}
+		return stat
+	}
+
+	for _, event := range r.Events {
+		stat := statFor(event.PlayerID, event.TeamID)
+		switch event.Type {
+		case MatchEventGoal:
+			stat.Goals++
+			if event.AssistPlayerID != nil {
+				statFor(*event.AssistPlayerID, event.TeamID).Assists++
+			}
+		case MatchEventYellowCard:
+			stat.YellowCards++
+		case MatchEventRedCard:
+			stat.RedCards++
+		}
+	}
+
+	stats := make([]PlayerStat, 0, len(order))
+	for _, playerID := range order {
+		stats = append(stats, *statsByPlayer[playerID])
+	}
+	return stats
+}
+
+// StandingsFor returns the standings increments the report result adds for both teams.
+func (r *MatchReport) StandingsFor(match *Match) []StandingChange {
+	home := StandingChange{
+		SeasonID:     match.SeasonID,
+		TeamID:       match.HomeTeamID,
+		GoalsFor:     uint16(r.HomeGoals),
+		GoalsAgainst: uint16(r.AwayGoals),
+	}
+	away := StandingChange{
+		SeasonID:     match.SeasonID,
+		TeamID:       match.AwayTeamID,
+		GoalsFor:     uint16(r.AwayGoals),
+		GoalsAgainst: uint16(r.HomeGoals),
+	}
+
+	switch {
+	case r.HomeGoals > r.AwayGoals:
+		home.Wins, home.Points = 1, PointsForWin
+		away.Losses, away.Points = 1, PointsForLoss
+	case r.HomeGoals < r.AwayGoals:
+		home.Losses, home.Points = 1, PointsForLoss
+		away.Wins, away.Points = 1, PointsForWin
+	default:
+		home.Draws, home.Points = 1, PointsForDraw
+		away.Draws, away.Points = 1, PointsForDraw
+	}
+
+	return []StandingChange{home, away}
+}
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"context"
+)
+
+// MatchReportFilter narrows paginated match report listings.
+// Empty fields are ignored.
+type MatchReportFilter struct {
+	Status        string
+	MatchID       uint64
+	SubmittedByID string
+}
+
+// MatchReportRepository defines the interface for match report persistence operations.
+// This port belongs in the domain layer following hexagonal architecture.
+type MatchReportRepository interface {
+	CreateMatchReport(ctx context.Context, report *MatchReport) error
+	GetMatchReportByID(ctx context.Context, id uint64) (*MatchReport, error)
+	GetPaginatedMatchReports(ctx context.Context, filter MatchReportFilter, sort string, order string, page int, pageSize int) ([]MatchReport, int64, error)
+	HasApprovedReport(ctx context.Context, matchID uint64) (bool, error)
+	// UpdateMatchReport replaces the report content and its events.
+	UpdateMatchReport(ctx context.Context, report *MatchReport) error
+	// RejectMatchReport stores the review of a pending report.
+	RejectMatchReport(ctx context.Context, report *MatchReport) error
+	// ApproveMatchReport persists the approval, match result, player stats and
+	// standings in a single transaction.
+	ApproveMatchReport(ctx context.Context, approval *MatchReportApproval) error
+}
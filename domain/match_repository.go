@@ -12,6 +12,7 @@ type MatchRepository interface {
 	GetPaginatedMatches(ctx context.Context, sort string, order string, page int, pageSize int) ([]Match, int64, error)
 	GetMatchesBySeasonID(ctx context.Context, seasonID uint64, sort string, order string, page int, pageSize int) ([]Match, int64, error)
 	GetMatchesByTeamID(ctx context.Context, teamID uint64, sort string, order string, page int, pageSize int) ([]Match, int64, error)
+	GetMatchesByVenueID(ctx context.Context, venueID uint64, sort string, order string, page int, pageSize int) ([]Match, int64, error)
 	GetNextMatchByTeamID(ctx context.Context, teamID uint64) (*Match, error)
 	GetDetailedMatchByID(ctx context.Context, id uint64) (*Match, error)
 	UpdateMatch(ctx context.Context, id uint64, match *Match) error
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"time"
+)
+
+// MVPPoll is the fan vote for the most valuable player of a completed match.
+// It opens at full time and closes automatically once ClosesAt has passed.
+type MVPPoll struct {
+	ID             uint64
+	MatchID        uint64
+	OpensAt        time.Time
+	ClosesAt       time.Time
+	ClosedAt       *time.Time
+	WinnerPlayerID *uint64
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// NewMVPPoll creates a poll for the match that stays open for the given window.
+func NewMVPPoll(matchID uint64, opensAt time.Time, window time.Duration) *MVPPoll {
+	return &MVPPoll{
+		MatchID:  matchID,
+		OpensAt:  opensAt,
+		ClosesAt: opensAt.Add(window),
+	}
+}
+
+// IsOpenAt reports whether votes are accepted at the given time.
+func (p *MVPPoll) IsOpenAt(now time.Time) bool {
+	return p.ClosedAt == nil && !now.Before(p.OpensAt) && now.Before(p.ClosesAt)
+}
+
+// IsClosed reports whether the result of the poll has been applied.
+func (p *MVPPoll) IsClosed() bool {
+	return p.ClosedAt != nil
+}
+
+// MVPVote is a single user's vote. Each user votes at most once per match.
+type MVPVote struct {
+	ID        uint64
+	MatchID   uint64
+	UserID    string
+	PlayerID  uint64
+	CreatedAt time.Time
+}
+
+// MVPTally is the number of votes received by a candidate.
+type MVPTally struct {
+	PlayerID    uint64
+	Votes       uint64
+	FirstVoteAt time.Time
+}
+
+// MVPWinner returns the candidate with the most votes, or nil when nobody voted.
+// Ties go to the player who received a vote first.
+func MVPWinner(tallies []MVPTally) *uint64 {
+	var winner *MVPTally
+	for i := range tallies {
+		tally := &tallies[i]
+		if tally.Votes == 0 {
+			continue
+		}
+		if winner == nil ||
+			tally.Votes > winner.Votes ||
+			(tally.Votes == winner.Votes && tally.FirstVoteAt.Before(winner.FirstVoteAt)) {
+			winner = tally
+		}
+	}
+
+	if winner == nil {
+		return nil
+	}
+	playerID := winner.PlayerID
+	return &playerID
+}
+
+// MVPCandidate is a player eligible for the MVP vote with the votes received so far.
+type MVPCandidate struct {
+	Player *Player
+	Votes  uint64
+}
+
+// MVPPollResults describes a poll from the point of view of a voter.
+// Vote counts are only revealed once the poll is closed.
+type MVPPollResults struct {
+	Poll          *MVPPoll
+	Candidates    []MVPCandidate
+	UserVote      *MVPVote
+	VotesRevealed bool
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// MVPVoteRepository defines the interface for MVP poll and vote persistence operations.
+// This port belongs in the domain layer following hexagonal architecture.
+type MVPVoteRepository interface {
+	// CreatePoll stores the poll unless the match already has one.
+	CreatePoll(ctx context.Context, poll *MVPPoll) error
+	GetPollByMatchID(ctx context.Context, matchID uint64) (*MVPPoll, error)
+	GetPollsToClose(ctx context.Context, now time.Time) ([]MVPPoll, error)
+
+	CreateVote(ctx context.Context, vote *MVPVote) error
+	GetVoteByUserAndMatch(ctx context.Context, userID string, matchID uint64) (*MVPVote, error)
+	GetTalliesByMatchID(ctx context.Context, matchID uint64) ([]MVPTally, error)
+
+	// ClosePoll marks the poll closed and, when there is a winner, sets the match MVP,
+	// flags the player's stats and increments the player's MVP count in a single transaction.
+	// An MVP an admin already set is replaced, and their MVP count is decremented unless they
+	// won the poll, in which case the count is left as it was.
+	ClosePoll(ctx context.Context, poll *MVPPoll, seasonID uint64) error
+}
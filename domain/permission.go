@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"slices"
+)
+
+// Permissions follow the "resource:action" naming convention.
+const (
+	PermissionUserManage         = "user:manage"
+	PermissionRoleManage         = "role:manage"
+	PermissionTeamWrite          = "team:write"
+	PermissionTeamManageAny      = "team:manage_any"
+	PermissionPlayerWrite        = "player:write"
+	PermissionPlayerAvailability = "player:availability"
+	PermissionPlayerTeamWrite    = "player_team:write"
+	PermissionSeasonWrite        = "season:write"
+	PermissionVenueWrite         = "venue:write"
+	PermissionMatchWrite         = "match:write"
+	PermissionLineupWrite        = "lineup:write"
+	PermissionTeamStatsWrite     = "team_stats:write"
+	PermissionPlayerStatsWrite   = "player_stats:write"
+	PermissionArticlePublish     = "article:publish"
+	PermissionMatchReportSubmit  = "match_report:submit"
+	PermissionMatchReportReview  = "match_report:review"
+	PermissionPredictionWrite    = "prediction:write"
+	PermissionPredictionRead     = "prediction:read"
+	PermissionAuditRead          = "audit:read"
+	PermissionTrashRead          = "trash:read"
+)
+
+// allPermissions is the catalogue of permissions that can be granted to a role.
+var allPermissions = []string{
+	PermissionUserManage,
+	PermissionRoleManage,
+	PermissionTeamWrite,
+	PermissionTeamManageAny,
+	PermissionPlayerWrite,
+	PermissionPlayerAvailability,
+	PermissionPlayerTeamWrite,
+	PermissionSeasonWrite,
+	PermissionVenueWrite,
+	PermissionMatchWrite,
+	PermissionLineupWrite,
+	PermissionTeamStatsWrite,
+	PermissionPlayerStatsWrite,
+	PermissionArticlePublish,
+	PermissionMatchReportSubmit,
+	PermissionMatchReportReview,
+	PermissionPredictionWrite,
+	PermissionPredictionRead,
+	PermissionAuditRead,
+	PermissionTrashRead,
+}
+
+// teamScopedPermissions only apply to the team the user is linked to, unless the user is
+// also granted PermissionTeamManageAny.
+var teamScopedPermissions = []string{
+	PermissionLineupWrite,
+	PermissionPlayerStatsWrite,
+	PermissionPlayerAvailability,
+}
+
+// defaultRolePermissions are granted to the built-in roles by the seed and, once, by the
+// 0006_default_role_permissions migration.
+var defaultRolePermissions = map[string][]string{
+	RoleCoach: {
+		PermissionLineupWrite,
+		PermissionPlayerStatsWrite,
+		PermissionPlayerAvailability,
+		PermissionMatchReportSubmit,
+	},
+	RoleReferee: {
+		PermissionMatchReportSubmit,
+	},
+	RoleFan: {
+		PermissionPredictionWrite,
+		PermissionPredictionRead,
+	},
+}
+
+// AllPermissions returns every permission that can be granted.
+func AllPermissions() []string {
+	return slices.Clone(allPermissions)
+}
+
+// IsValidPermission reports whether the permission is part of the catalogue.
+func IsValidPermission(permission string) bool {
+	return slices.Contains(allPermissions, permission)
+}
+
+// IsTeamScopedPermission reports whether the permission only applies to the user's own team.
+func IsTeamScopedPermission(permission string) bool {
+	return slices.Contains(teamScopedPermissions, permission)
+}
+
+// DefaultPermissionsForRole returns the permissions granted by default to a built-in role.
+func DefaultPermissionsForRole(roleName string) []string {
+	return slices.Clone(defaultRolePermissions[roleName])
+}
+
+// NormalizePermissions sorts the permissions and removes duplicates.
+// It returns false when one of them is not part of the catalogue.
+func NormalizePermissions(permissions []string) ([]string, bool) {
+	normalized := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		if !IsValidPermission(permission) {
+			return nil, false
+		}
+		normalized = append(normalized, permission)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized), true
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"time"
+)
+
+// PKCEParams holds what the callback of an OAuth login needs to finish it: the PKCE
+// verifier, the provider the login was started with and the expected ID token nonce.
+// They are stored under the OAuth state until the callback consumes them or they expire.
+type PKCEParams struct {
+	Verifier     string
+	Challenge    string
+	Provider     string // Provider the login was started with
+	Nonce        string // Expected nonce claim of the ID token
+	InvitationID uint64 // Invitation accepted with this login, zero for a regular login
+	ExpiresAt    time.Time
+}
+
+// IsExpired reports whether the login took too long to be completed.
+func (p *PKCEParams) IsExpired(now time.Time) bool {
+	return !now.Before(p.ExpiresAt)
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// PKCEStore defines the interface for storing the PKCE parameters of pending OAuth logins.
+// Implementations must be shared by every API replica that can receive the callback.
+type PKCEStore interface {
+	// SavePKCE stores the parameters under the OAuth state. They expire after the store TTL.
+	SavePKCE(ctx context.Context, state string, params *PKCEParams) error
+
+	// TakePKCE returns and deletes the parameters stored under the OAuth state, so that each
+	// state can only be used once. It returns nil when the state is unknown or expired.
+	TakePKCE(ctx context.Context, state string) (*PKCEParams, error)
+
+	// DeleteExpiredPKCE removes the parameters of abandoned logins.
+	DeleteExpiredPKCE(ctx context.Context, now time.Time) (int64, error)
+}
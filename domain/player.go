@@ -25,6 +25,7 @@ type Player struct {
 	CareerSummary    string
 	MVPCount         uint8
 	UserID           *string
+	Version          uint64
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 }
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"time"
+)
+
+// Player claim statuses
+const (
+	PlayerClaimStatusPending  = "pending"
+	PlayerClaimStatusApproved = "approved"
+	PlayerClaimStatusRejected = "rejected"
+)
+
+// PlayerClaim is a user's request to link their account to a player profile.
+// It stays pending until an administrator approves or rejects it.
+type PlayerClaim struct {
+	ID             uint64
+	UserID         string
+	PlayerID       uint64
+	Status         string
+	Message        string // Evidence for the reviewer, such as the squad number or team
+	ReviewComments string
+	ReviewedByID   *string
+	ReviewedAt     *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	// Related entities
+	User   *User
+	Player *Player
+}
+
+// IsValid performs basic domain validation for the claim.
+func (c *PlayerClaim) IsValid() bool {
+	return c.UserID != "" && c.PlayerID > 0 && len(c.Message) <= 500
+}
+
+// IsPending reports whether the claim is waiting for review.
+func (c *PlayerClaim) IsPending() bool {
+	return c.Status == PlayerClaimStatusPending
+}
+
+// IsValidPlayerClaimStatus reports whether the status is a known claim status.
+func IsValidPlayerClaimStatus(status string) bool {
+	switch status {
+	case PlayerClaimStatusPending, PlayerClaimStatusApproved, PlayerClaimStatusRejected:
+		return true
+	}
+	return false
+}
+
+// Review records the outcome of the review on the claim.
+func (c *PlayerClaim) Review(status, reviewerID, comments string, reviewedAt time.Time) {
+	c.Status = status
+	c.ReviewComments = comments
+	c.ReviewedByID = &reviewerID
+	c.ReviewedAt = &reviewedAt
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"context"
+)
+
+// PlayerClaimFilter narrows paginated player claim listings.
+// Empty fields are ignored.
+type PlayerClaimFilter struct {
+	Status string
+	UserID string
+}
+
+// PlayerClaimRepository defines the interface for player claim persistence operations.
+// This port belongs in the domain layer following hexagonal architecture.
+type PlayerClaimRepository interface {
+	CreatePlayerClaim(ctx context.Context, claim *PlayerClaim) error
+	GetPlayerClaimByID(ctx context.Context, id uint64) (*PlayerClaim, error)
+	GetPaginatedPlayerClaims(ctx context.Context, filter PlayerClaimFilter, page int, pageSize int) ([]PlayerClaim, int64, error)
+	HasPendingPlayerClaim(ctx context.Context, userID string) (bool, error)
+	// RejectPlayerClaim stores the review of a pending claim.
+	RejectPlayerClaim(ctx context.Context, claim *PlayerClaim) error
+	// ApprovePlayerClaim stores the review, links the player to the user and sets the user's
+	// role when roleID is not zero, in a single transaction. Other pending claims for the
+	// player are rejected with the given comments.
+	ApprovePlayerClaim(ctx context.Context, claim *PlayerClaim, roleID uint64, otherClaimsComments string) error
+}
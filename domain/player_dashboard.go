@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// PlayerSeasonSplit aggregates the match statistics of a player over one season.
+// The totals of a dashboard use the same shape with SeasonID set to zero.
+type PlayerSeasonSplit struct {
+	SeasonID      uint64
+	SeasonYear    uint16
+	Appearances   uint16
+	Starts        uint16
+	MinutesPlayed uint32
+	Goals         uint16
+	Assists       uint16
+	Saves         uint16
+	YellowCards   uint16
+	RedCards      uint16
+	MVPs          uint16
+	AverageRating float64
+}
+
+// PlayerDashboard is the profile and statistics of the player linked to a user.
+type PlayerDashboard struct {
+	Player  *Player
+	Seasons []PlayerSeasonSplit // Newest season first
+	Totals  PlayerSeasonSplit
+}
+
+// BuildPlayerDashboard groups the player's match statistics by season. seasons maps the
+// season IDs to their seasons so the splits can be ordered and labelled by year.
+func BuildPlayerDashboard(player *Player, stats []PlayerStat, seasons map[uint64]*Season) *PlayerDashboard {
+	dashboard := &PlayerDashboard{Player: player}
+	ratingSums := make(map[uint64]uint32)
+	var totalRating uint32
+
+	splits := make(map[uint64]*PlayerSeasonSplit)
+	for _, stat := range stats {
+		split, ok := splits[stat.SeasonID]
+		if !ok {
+			split = &PlayerSeasonSplit{SeasonID: stat.SeasonID}
+			if season := seasons[stat.SeasonID]; season != nil {
+				split.SeasonYear = season.Year
+			}
+			splits[stat.SeasonID] = split
+		}
+		split.add(stat)
+		dashboard.Totals.add(stat)
+		ratingSums[stat.SeasonID] += uint32(stat.Rating)
+		totalRating += uint32(stat.Rating)
+	}
+
+	for seasonID, split := range splits {
+		split.AverageRating = averageRating(ratingSums[seasonID], split.Appearances)
+		dashboard.Seasons = append(dashboard.Seasons, *split)
+	}
+	dashboard.Totals.AverageRating = averageRating(totalRating, dashboard.Totals.Appearances)
+
+	slices.SortFunc(dashboard.Seasons, func(a, b PlayerSeasonSplit) int {
+		if a.SeasonYear != b.SeasonYear {
+			return cmp.Compare(b.SeasonYear, a.SeasonYear)
+		}
+		return cmp.Compare(b.SeasonID, a.SeasonID)
+	})
+	return dashboard
+}
+
+// add counts one match stat line in the split.
+func (s *PlayerSeasonSplit) add(stat PlayerStat) {
+	s.Appearances++
+	if stat.IsStarting {
+		s.Starts++
+	}
+	s.MinutesPlayed += uint32(stat.MinutesPlayed)
+	s.Goals += uint16(stat.Goals)
+	s.Assists += uint16(stat.Assists)
+	s.Saves += uint16(stat.Saves)
+	s.YellowCards += uint16(stat.YellowCards)
+	s.RedCards += uint16(stat.RedCards)
+	if stat.IsMVP {
+		s.MVPs++
+	}
+}
+
+// averageRating returns the mean rating rounded to one decimal.
+func averageRating(sum uint32, appearances uint16) float64 {
+	if appearances == 0 {
+		return 0
+	}
+	return math.Round(float64(sum)/float64(appearances)*10) / 10
+}
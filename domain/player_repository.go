@@ -10,6 +10,7 @@ type PlayerRepository interface {
 	CreatePlayer(ctx context.Context, player *Player) error
 	GetPlayerByID(ctx context.Context, id uint64) (*Player, error)
 	GetPlayerByNickName(ctx context.Context, nickName string) (*Player, error)
+	GetPlayerByUserID(ctx context.Context, userID string) (*Player, error)
 	GetPaginatedPlayers(ctx context.Context, sort string, order string, page int, pageSize int) ([]Player, int64, error)
 	UpdatePlayer(ctx context.Context, id uint64, player *Player) error
 	DeletePlayer(ctx context.Context, id uint64) error
@@ -22,6 +22,7 @@ type PlayerStat struct {
 	IsMVP         bool
 	Position      string
 
+	Version   uint64
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
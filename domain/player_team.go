@@ -19,6 +19,7 @@ type PlayerTeam struct {
 	StartDate time.Time
 	EndDate   *time.Time
 
+	Version   uint64
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"time"
+)
+
+// Prediction is a user's guess of the final score of a match.
+// Points stay nil until the match is completed and the prediction is scored.
+type Prediction struct {
+	ID        uint64
+	UserID    string
+	MatchID   uint64
+	HomeGoals uint8
+	AwayGoals uint8
+	Points    *uint8
+	Exact     bool
+	ScoredAt  *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// Related entities
+	Match *Match
+	User  *User
+}
+
+// IsValid performs basic domain validation for the prediction.
+func (p *Prediction) IsValid() bool {
+	return p.UserID != "" && p.MatchID != 0 && p.HomeGoals <= 30 && p.AwayGoals <= 30
+}
+
+// PredictionScoring holds the points awarded for each kind of correct prediction.
+type PredictionScoring struct {
+	ExactScore     uint8
+	CorrectOutcome uint8
+	GoalDifference uint8
+}
+
+// Score returns the points earned by the prediction for the match result and
+// whether it matched the final score exactly. An exact score earns ExactScore;
+// otherwise a correct outcome earns CorrectOutcome plus GoalDifference when the
+// margin is also right.
+func (s PredictionScoring) Score(prediction *Prediction, match *Match) (uint8, bool) {
+	if prediction.HomeGoals == match.HomeGoals && prediction.AwayGoals == match.AwayGoals {
+		return s.ExactScore, true
+	}
+
+	predictedDiff := int(prediction.HomeGoals) - int(prediction.AwayGoals)
+	actualDiff := int(match.HomeGoals) - int(match.AwayGoals)
+	if sign(predictedDiff) != sign(actualDiff) {
+		return 0, false
+	}
+
+	points := s.CorrectOutcome
+	if predictedDiff == actualDiff {
+		points += s.GoalDifference
+	}
+	return points, false
+}
+
+func sign(n int) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	}
+	return 0
+}
+
+// PredictionLeaderboardEntry is a user's aggregated prediction score for a season.
+type PredictionLeaderboardEntry struct {
+	Rank        uint64
+	UserID      string
+	Name        string
+	LastName    string
+	ImgProfile  string
+	Points      uint64
+	ExactScores uint64
+	Predictions uint64
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"context"
+)
+
+// PredictionRepository defines the interface for prediction persistence operations.
+// This port belongs in the domain layer following hexagonal architecture.
+type PredictionRepository interface {
+	CreatePrediction(ctx context.Context, prediction *Prediction) error
+	GetPredictionByID(ctx context.Context, id uint64) (*Prediction, error)
+	GetPredictionByUserAndMatch(ctx context.Context, userID string, matchID uint64) (*Prediction, error)
+	GetPaginatedPredictionsByUser(ctx context.Context, userID string, sort string, order string, page int, pageSize int) ([]Prediction, int64, error)
+	GetPredictionsByMatchID(ctx context.Context, matchID uint64) ([]Prediction, error)
+	UpdatePrediction(ctx context.Context, id uint64, prediction *Prediction) error
+	DeletePrediction(ctx context.Context, id uint64) error
+
+	// SavePredictionScores stores the points of the given predictions in a single transaction.
+	SavePredictionScores(ctx context.Context, predictions []Prediction) error
+	// GetSeasonLeaderboard ranks the users with the given role by their prediction points in the season.
+	GetSeasonLeaderboard(ctx context.Context, seasonID uint64, roleName string, page int, pageSize int) ([]PredictionLeaderboardEntry, int64, error)
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// RateLimitPolicy is a token bucket: a client may send Limit requests in a burst and
+// regains them at a steady rate, so the bucket is full again after Window.
+type RateLimitPolicy struct {
+	Name   string // Identifies the buckets of the policy in the store
+	Limit  int
+	Window time.Duration
+}
+
+// RateLimitBucket holds the tokens left to a client under a policy.
+type RateLimitBucket struct {
+	Tokens     float64
+	RefilledAt time.Time
+}
+
+// RateLimitDecision is the outcome of taking a token, used for the RateLimit-* headers.
+type RateLimitDecision struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	ResetAfter time.Duration // Time until the bucket is full again
+	RetryAfter time.Duration // Time until a token is available, when not allowed
+}
+
+// IsValid checks that the policy can refill its bucket.
+func (p RateLimitPolicy) IsValid() bool {
+	return p.Name != "" && p.Limit > 0 && p.Window > 0
+}
+
+// NewBucket returns a full bucket.
+func (p RateLimitPolicy) NewBucket(now time.Time) RateLimitBucket {
+	return RateLimitBucket{Tokens: float64(p.Limit), RefilledAt: now}
+}
+
+// Take refills the bucket for the time elapsed since the last request and removes cost
+// tokens when enough are left. A cost of zero only checks that a token is available.
+func (p RateLimitPolicy) Take(bucket *RateLimitBucket, cost int, now time.Time) RateLimitDecision {
+	perSecond := float64(p.Limit) / p.Window.Seconds()
+	if elapsed := now.Sub(bucket.RefilledAt).Seconds(); elapsed > 0 {
+		bucket.Tokens = math.Min(float64(p.Limit), bucket.Tokens+elapsed*perSecond)
+		bucket.RefilledAt = now
+	}
+
+	decision := RateLimitDecision{Limit: p.Limit}
+	needed := float64(max(cost, 1))
+	if bucket.Tokens >= needed {
+		decision.Allowed = true
+		bucket.Tokens -= float64(cost)
+	} else {
+		decision.RetryAfter = secondsToDuration((needed - bucket.Tokens) / perSecond)
+	}
+
+	decision.Remaining = int(math.Floor(bucket.Tokens))
+	decision.ResetAfter = secondsToDuration((float64(p.Limit) - bucket.Tokens) / perSecond)
+	return decision
+}
+
+// secondsToDuration converts a fractional number of seconds to a duration.
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
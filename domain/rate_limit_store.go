@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimitStore defines the interface for the token buckets of the rate limiter.
+// Implementations shared by every API replica enforce the limits across the cluster.
+type RateLimitStore interface {
+	// TakeRateLimitToken applies RateLimitPolicy.Take to the bucket of the key atomically.
+	// Unknown keys start with a full bucket.
+	TakeRateLimitToken(ctx context.Context, key string, policy RateLimitPolicy, cost int, now time.Time) (RateLimitDecision, error)
+
+	// DeleteIdleRateLimitBuckets removes the buckets not used since the given time.
+	DeleteIdleRateLimitBuckets(ctx context.Context, before time.Time) (int64, error)
+}
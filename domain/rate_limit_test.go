@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRateLimitPolicyTake(t *testing.T) {
+	// One token per second, three in a burst
+	policy := RateLimitPolicy{Name: "test", Limit: 3, Window: 3 * time.Second}
+
+	type step struct {
+		at             time.Duration // Time of the request since the bucket was created
+		cost           int
+		wantAllowed    bool
+		wantRemaining  int
+		wantResetAfter time.Duration
+		wantRetryAfter time.Duration
+	}
+
+	tests := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "burst up to the limit",
+			steps: []step{
+				{cost: 1, wantAllowed: true, wantRemaining: 2, wantResetAfter: time.Second},
+				{cost: 1, wantAllowed: true, wantRemaining: 1, wantResetAfter: 2 * time.Second},
+				{cost: 1, wantAllowed: true, wantRemaining: 0, wantResetAfter: 3 * time.Second},
+				{cost: 1, wantRemaining: 0, wantResetAfter: 3 * time.Second, wantRetryAfter: time.Second},
+			},
+		},
+		{
+			name: "refills at a steady rate",
+			steps: []step{
+				{cost: 3, wantAllowed: true, wantRemaining: 0, wantResetAfter: 3 * time.Second},
+				{at: 500 * time.Millisecond, cost: 1, wantRemaining: 0, wantResetAfter: 2500 * time.Millisecond, wantRetryAfter: 500 * time.Millisecond},
+				{at: time.Second, cost: 1, wantAllowed: true, wantRemaining: 0, wantResetAfter: 3 * time.Second},
+			},
+		},
+		{
+			name: "never refills beyond the limit",
+			steps: []step{
+				{cost: 1, wantAllowed: true, wantRemaining: 2, wantResetAfter: time.Second},
+				{at: time.Hour, cost: 1, wantAllowed: true, wantRemaining: 2, wantResetAfter: time.Second},
+			},
+		},
+		{
+			name: "zero cost checks without taking",
+			steps: []step{
+				{cost: 0, wantAllowed: true, wantRemaining: 3},
+				{cost: 3, wantAllowed: true, wantRemaining: 0, wantResetAfter: 3 * time.Second},
+				{cost: 0, wantRemaining: 0, wantResetAfter: 3 * time.Second, wantRetryAfter: time.Second},
+			},
+		},
+		{
+			name: "cost above the tokens left is rejected whole",
+			steps: []step{
+				{cost: 2, wantAllowed: true, wantRemaining: 1, wantResetAfter: 2 * time.Second},
+				{cost: 2, wantRemaining: 1, wantResetAfter: 2 * time.Second, wantRetryAfter: time.Second},
+				{cost: 1, wantAllowed: true, wantRemaining: 0, wantResetAfter: 3 * time.Second},
+			},
+		},
+		{
+			name: "clock going backwards does not refill",
+			steps: []step{
+				{at: time.Second, cost: 1, wantAllowed: true, wantRemaining: 2, wantResetAfter: time.Second},
+				{cost: 1, wantAllowed: true, wantRemaining: 1, wantResetAfter: 2 * time.Second},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			bucket := policy.NewBucket(start)
+			for i, s := range tt.steps {
+				got := policy.Take(&bucket, s.cost, start.Add(s.at))
+				want := RateLimitDecision{
+					Allowed:    s.wantAllowed,
+					Limit:      policy.Limit,
+					Remaining:  s.wantRemaining,
+					ResetAfter: s.wantResetAfter,
+					RetryAfter: s.wantRetryAfter,
+				}
+				if got != want {
+					t.Fatalf("step %d: Take() = %+v, want %+v", i, got, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"time"
+)
+
+// RefreshToken is a server-side record of an opaque refresh token.
+// Only a hash of the token is stored. Every refresh replaces the token with a
+// new one in the same family, so presenting a token that was already used
+// means it was stolen and the whole family is revoked. The family ID is the ID of the
+// session the tokens were issued for.
+type RefreshToken struct {
+	ID        uint64
+	UserID    string
+	FamilyID  string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// IsExpired reports whether the token is past its expiry time.
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// IsUsed reports whether the token was already exchanged for a new one.
+func (t *RefreshToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+// IsRevoked reports whether the token family was revoked.
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// AuthTokens is the pair of tokens issued when a session starts or is renewed.
+type AuthTokens struct {
+	AccessToken           string
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// RefreshTokenRepository defines the interface for refresh token persistence operations.
+// This port belongs in the domain layer following hexagonal architecture.
+type RefreshTokenRepository interface {
+	CreateRefreshToken(ctx context.Context, token *RefreshToken) error
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+
+	// RotateRefreshToken marks the token as used and stores its replacement in a single
+	// transaction. It returns constants.ErrRefreshTokenReused when the token had already
+	// been used or revoked, which happens when two requests race with the same token.
+	RotateRefreshToken(ctx context.Context, usedID uint64, usedAt time.Time, replacement *RefreshToken) error
+
+	DeleteExpiredRefreshTokens(ctx context.Context, before time.Time) (int64, error)
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"slices"
 	"strings"
 	"time"
 )
@@ -10,21 +11,50 @@ type Role struct {
 	ID          uint64
 	Name        string
 	Description string
+	Permissions []string
+	Version     uint64
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
 // Common role names
 const (
-	RoleAdmin  = "admin"
-	RolePlayer = "player"
-	RoleCoach  = "coach"
+	RoleAdmin   = "admin"
+	RolePlayer  = "player"
+	RoleCoach   = "coach"
+	RoleReferee = "referee"
+	RoleFan     = "fan"
 )
 
 // IsSystemRole returns true if this is a built-in system role.
 func (r *Role) IsSystemRole() bool {
 	roleName := strings.ToLower(r.Name)
-	return roleName == RoleAdmin || roleName == RolePlayer || roleName == RoleCoach
+	return roleName == RoleAdmin || roleName == RolePlayer || roleName == RoleCoach || roleName == RoleReferee
+}
+
+// HasFixedPermissions reports whether the role's permissions cannot be edited.
+// Administrators are always granted every permission.
+func (r *Role) HasFixedPermissions() bool {
+	return strings.ToLower(r.Name) == RoleAdmin
+}
+
+// EffectivePermissions returns the permissions the role actually grants.
+func (r *Role) EffectivePermissions() []string {
+	if r.HasFixedPermissions() {
+		return AllPermissions()
+	}
+	return r.Permissions
+}
+
+// HasPermission checks if the role grants the given permission.
+func (r *Role) HasPermission(permission string) bool {
+	return slices.Contains(r.EffectivePermissions(), permission)
+}
+
+// GrantsTeamScopedPermission reports whether the role grants a permission that applies to
+// the team its users are linked to.
+func (r *Role) GrantsTeamScopedPermission() bool {
+	return slices.ContainsFunc(r.EffectivePermissions(), IsTeamScopedPermission)
 }
 
 // IsValid performs basic domain validation for the role.
@@ -68,6 +98,16 @@ func (r *Role) GetDisplayName() string {
 	return r.Name
 }
 
+// CanBeRenamedTo reports whether the role can take the given name. System roles and their
+// fixed permissions are recognized by name, so they keep theirs and no other role takes one.
+func (r *Role) CanBeRenamedTo(name string) bool {
+	if name == r.Name {
+		return true
+	}
+	renamed := Role{Name: name}
+	return !r.IsSystemRole() && !renamed.IsSystemRole()
+}
+
 // CanBeDeleted returns true if the role can be safely deleted.
 func (r *Role) CanBeDeleted() bool {
 	// System roles cannot be deleted
@@ -13,5 +13,6 @@ type RoleRepository interface {
 	CreateRole(ctx context.Context, role *Role) error
 	UpdateRole(ctx context.Context, role *Role) error
 	DeleteRole(ctx context.Context, id uint64) error
+	SetRolePermissions(ctx context.Context, role *Role, permissions []string) error
 	GetPaginatedRoles(ctx context.Context, sort string, order string, page int, pageSize int) ([]Role, int64, error)
 }
@@ -10,6 +10,7 @@ type Season struct {
 	StartDate time.Time
 	EndDate   time.Time
 	IsCurrent bool
+	Version   uint64
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
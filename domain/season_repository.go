@@ -18,5 +18,5 @@ type SeasonRepository interface {
 	DeleteSeason(ctx context.Context, id uint64) error
 
 	// Business logic specific methods
-	SetCurrentSeason(ctx context.Context, id uint64) error
+	SetCurrentSeason(ctx context.Context, season *Season) error
 }
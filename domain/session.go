@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"time"
+)
+
+// Session is a signed-in device of a user. It is created at login, shares its ID
+// with the refresh token family issued for it, and is carried in the sid claim of
+// every access token so revoking the session also invalidates those tokens.
+type Session struct {
+	ID         string
+	UserID     string
+	UserAgent  string
+	IPAddress  string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	ExpiresAt  time.Time
+	RevokedAt  *time.Time
+}
+
+// IsActive reports whether the session can still be refreshed.
+func (s *Session) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
+
+// SessionsToEvict returns the oldest sessions that exceed the limit.
+// The sessions must be ordered from oldest to newest.
+func SessionsToEvict(sessions []Session, limit int) []Session {
+	if limit <= 0 || len(sessions) <= limit {
+		return nil
+	}
+	return sessions[:len(sessions)-limit]
+}
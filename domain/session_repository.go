@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// SessionRepository defines the interface for session persistence operations.
+// This port belongs in the domain layer following hexagonal architecture.
+type SessionRepository interface {
+	CreateSession(ctx context.Context, session *Session) error
+	GetSessionByID(ctx context.Context, id string) (*Session, error)
+
+	// GetActiveSessionsByUserID returns the sessions that are neither revoked nor expired, oldest first.
+	GetActiveSessionsByUserID(ctx context.Context, userID string, now time.Time) ([]Session, error)
+
+	// TouchSession records activity on the session and extends it to the new refresh token expiry.
+	TouchSession(ctx context.Context, id string, lastSeenAt, expiresAt time.Time) error
+
+	// RevokeSessions revokes the sessions and their refresh tokens in a single transaction.
+	RevokeSessions(ctx context.Context, ids []string, revokedAt time.Time) error
+
+	// RevokeUserSessions revokes every session of the user and their refresh tokens.
+	RevokeUserSessions(ctx context.Context, userID string, revokedAt time.Time) error
+
+	DeleteExpiredSessions(ctx context.Context, before time.Time) (int64, error)
+}
@@ -7,7 +7,7 @@ type TeamNextMatch struct {
 	ID        uint64
 	Status    string
 	Kickoff   time.Time
-	Location  string
+	Venue     *Venue
 	HomeGoals uint8
 	AwayGoals uint8
 }
@@ -23,6 +23,9 @@ type Team struct {
 	Shield         string
 	NextMatchID    *uint64
 	NextMatch      *TeamNextMatch
+	HomeVenueID    *uint64
+	HomeVenue      *Venue
+	Version        uint64
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// TeamScope describes which teams a user is allowed to manage.
+// Users granted PermissionTeamManageAny manage every team, users linked to a team
+// manage only that team and every other user manages none.
+type TeamScope struct {
+	AllTeams bool
+	TeamID   uint64
+}
+
+// TeamScope returns the teams the user may manage with the required permission.
+// The permissions are the ones granted to the credentials of the request, so an
+// API key is limited to its scopes.
+func (u *User) TeamScope(permissions []string, required string) TeamScope {
+	if slices.Contains(permissions, PermissionTeamManageAny) {
+		return TeamScope{AllTeams: true}
+	}
+	if u.TeamID != nil && slices.Contains(permissions, required) {
+		return TeamScope{TeamID: *u.TeamID}
+	}
+	return TeamScope{}
+}
+
+// Covers reports whether the scope includes the given team.
+func (s TeamScope) Covers(teamID uint64) bool {
+	return s.AllTeams || (s.TeamID != 0 && s.TeamID == teamID)
+}
+
+// CoversMatch reports whether the scope includes one of the teams playing the match.
+func (s TeamScope) CoversMatch(match *Match) bool {
+	return s.Covers(match.HomeTeamID) || s.Covers(match.AwayTeamID)
+}
+
+// CoversSquadMember reports whether the player belongs to a team in the scope
+// during the given season, according to the player's team memberships.
+func (s TeamScope) CoversSquadMember(memberships []PlayerTeam, seasonID uint64) bool {
+	for _, membership := range memberships {
+		if membership.SeasonID == seasonID && s.Covers(membership.TeamID) {
+			return true
+		}
+	}
+	return false
+}
+
+// CoversActiveSquadMember reports whether the player currently belongs to a team in the scope.
+func (s TeamScope) CoversActiveSquadMember(memberships []PlayerTeam, at time.Time) bool {
+	for _, membership := range memberships {
+		if membership.IsActive(at) && s.Covers(membership.TeamID) {
+			return true
+		}
+	}
+	return false
+}
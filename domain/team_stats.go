@@ -19,6 +19,17 @@ type TeamStats struct {
 	TeamID       uint64
 	Team         *Team
 	Season       *Season
+	Version      uint64
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 }
+
+// MatchesPlayed returns the number of matches the team played in the season.
+func (s *TeamStats) MatchesPlayed() int {
+	return int(s.Wins) + int(s.Draws) + int(s.Losses)
+}
+
+// GoalDifference returns the goals scored minus the goals conceded.
+func (s *TeamStats) GoalDifference() int {
+	return int(s.GoalsFor) - int(s.GoalsAgainst)
+}
@@ -0,0 +1,14 @@
+package domain
+
+import (
+	"time"
+)
+
+// RevokedToken is an access token revoked before its expiry, identified by its jti claim.
+// The record is only needed until the token would have expired anyway.
+type RevokedToken struct {
+	TokenID   string
+	UserID    string
+	ExpiresAt time.Time
+	RevokedAt time.Time
+}
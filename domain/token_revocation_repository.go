@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// TokenRevocationRepository defines the interface for the access token revocation store.
+// This port belongs in the domain layer following hexagonal architecture.
+type TokenRevocationRepository interface {
+	// RevokeToken stores the revoked token, ignoring tokens that are already revoked.
+	RevokeToken(ctx context.Context, token *RevokedToken) error
+
+	// RevokeUserTokens invalidates every token of the user issued before revokedBefore,
+	// truncated to the second like the issue time of the tokens, so that a token issued
+	// right after, such as on the next login, stays valid.
+	RevokeUserTokens(ctx context.Context, userID string, revokedBefore time.Time) error
+
+	// IsTokenRevoked reports whether the token was revoked on its own, belongs to a
+	// revoked session or was issued before all tokens of its user were revoked.
+	IsTokenRevoked(ctx context.Context, claims *AuthenticationClaims) (bool, error)
+
+	// DeleteExpiredRevocations removes revoked tokens that expired before now and user
+	// revocations older than revokedBefore, which no live token can predate.
+	DeleteExpiredRevocations(ctx context.Context, now, revokedBefore time.Time) (int64, error)
+}
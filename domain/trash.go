@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// trashEntityTypes are the entity types that are soft deleted and can be restored.
+// They share the names of the audit entity types.
+var trashEntityTypes = []string{
+	AuditEntityArticle,
+	AuditEntityMatch,
+	AuditEntityPlayer,
+	AuditEntityTeam,
+}
+
+// TrashItem is a soft deleted record that can be restored until it is purged.
+type TrashItem struct {
+	EntityType string
+	EntityID   uint64
+	Label      string // Title, name or teams of the record, to recognise it in listings
+	DeletedAt  time.Time
+	PurgeAt    time.Time // When the record is permanently deleted
+}
+
+// IsValidTrashEntityType reports whether records of the entity type go to the trash when deleted.
+func IsValidTrashEntityType(entityType string) bool {
+	return slices.Contains(trashEntityTypes, entityType)
+}
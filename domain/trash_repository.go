@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// TrashFilter narrows the trash items returned by a query. An empty entity type matches every item.
+type TrashFilter struct {
+	EntityType string
+}
+
+// TrashRepository defines the interface for the soft deleted records of every trash entity type.
+type TrashRepository interface {
+	// GetPaginatedTrash returns the deleted records matching the filter, most recently deleted first.
+	GetPaginatedTrash(ctx context.Context, filter TrashFilter, page int, pageSize int) ([]TrashItem, int64, error)
+
+	// RestoreTrashItem undeletes the record and reports false when it is not in the trash.
+	RestoreTrashItem(ctx context.Context, entityType string, id uint64) (bool, error)
+
+	// PurgeTrash permanently deletes the records deleted before the given time and returns how many were removed.
+	// Records still referenced by other data are kept.
+	PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error)
+}
@@ -17,6 +17,8 @@ type User struct {
 	ImgBanner  string
 	RoleID     uint64
 	Role       *Role
+	TeamID     *uint64 // Team managed by the user when they are a coach
+	Version    uint64
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 }
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// UserIdentity links a user to their account at an external OpenID Connect provider.
+// A user signs in with every provider whose identity is linked to them.
+type UserIdentity struct {
+	ID          uint64
+	UserID      string
+	Provider    string
+	Subject     string // Stable identifier of the account at the provider (sub claim)
+	Email       string
+	CreatedAt   time.Time
+	LastLoginAt time.Time
+}
+
+// ExternalIdentity is the verified identity returned by a provider at sign-in.
+type ExternalIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	LastName      string
+	Picture       string
+}
+
+// IdentitySignIn is the outcome of signing in with an external identity.
+type IdentitySignIn struct {
+	User    *User
+	Created bool // A new user was created for the identity
+	Linked  bool // The identity was linked to an existing user with the same email
+}
+
+// NormalizedEmail returns the email in the form used as username.
+func (e *ExternalIdentity) NormalizedEmail() string {
+	return strings.ToLower(strings.TrimSpace(e.Email))
+}
+
+// IsValid performs basic domain validation for the identity.
+func (e *ExternalIdentity) IsValid() bool {
+	return e.Provider != "" && e.Subject != "" && e.NormalizedEmail() != ""
+}
@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// UserIdentityRepository defines the interface for external identity persistence operations.
+// This port belongs in the domain layer following hexagonal architecture.
+type UserIdentityRepository interface {
+	CreateIdentity(ctx context.Context, identity *UserIdentity) error
+
+	// GetIdentity returns the identity with the given subject at the provider.
+	GetIdentity(ctx context.Context, provider, subject string) (*UserIdentity, error)
+
+	// TouchIdentity records a sign-in with the identity.
+	TouchIdentity(ctx context.Context, id uint64, lastLoginAt time.Time) error
+}
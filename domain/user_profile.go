@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"time"
+)
+
+// Profile fields a user can change on their own account.
+const (
+	ProfileFieldName       = "name"
+	ProfileFieldLastName   = "last_name"
+	ProfileFieldBirthdate  = "birthdate"
+	ProfileFieldImgProfile = "img_profile"
+	ProfileFieldImgBanner  = "img_banner"
+)
+
+// UserProfileUpdate holds the profile fields a user can edit themselves. Nil fields are left
+// unchanged; an empty image clears it. The username and role can only be changed by an admin.
+type UserProfileUpdate struct {
+	Name       *string
+	LastName   *string
+	Birthdate  *time.Time
+	ImgProfile *string
+	ImgBanner  *string
+}
+
+// UserProfileChange records the old and new value of a profile field changed by a user.
+type UserProfileChange struct {
+	ID        uint64
+	UserID    string
+	ChangedBy string // User who made the change
+	Field     string
+	OldValue  string
+	NewValue  string
+	IPAddress string
+	CreatedAt time.Time
+}
+
+// ApplyProfileUpdate applies the update to the user and returns a change for every field
+// whose value is different. Only the field, values and user are set on the changes.
+func (u *User) ApplyProfileUpdate(update UserProfileUpdate) []UserProfileChange {
+	var changes []UserProfileChange
+	record := func(field, oldValue, newValue string) {
+		if oldValue != newValue {
+			changes = append(changes, UserProfileChange{
+				UserID:   u.ID,
+				Field:    field,
+				OldValue: oldValue,
+				NewValue: newValue,
+			})
+		}
+	}
+
+	if update.Name != nil {
+		record(ProfileFieldName, u.Name, *update.Name)
+		u.Name = *update.Name
+	}
+	if update.LastName != nil {
+		record(ProfileFieldLastName, u.LastName, *update.LastName)
+		u.LastName = *update.LastName
+	}
+	if update.Birthdate != nil {
+		record(ProfileFieldBirthdate, formatBirthdate(u.Birthdate), formatBirthdate(update.Birthdate))
+		u.Birthdate = update.Birthdate
+	}
+	if update.ImgProfile != nil {
+		record(ProfileFieldImgProfile, u.ImgProfile, *update.ImgProfile)
+		u.ImgProfile = *update.ImgProfile
+	}
+	if update.ImgBanner != nil {
+		record(ProfileFieldImgBanner, u.ImgBanner, *update.ImgBanner)
+		u.ImgBanner = *update.ImgBanner
+	}
+	return changes
+}
+
+// formatBirthdate formats a birthdate as a date, or an empty string when it is not set.
+func formatBirthdate(birthdate *time.Time) string {
+	if birthdate == nil {
+		return ""
+	}
+	return birthdate.Format(time.DateOnly)
+}
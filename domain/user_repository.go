@@ -15,4 +15,9 @@ type UserRepository interface {
 	GetPaginatedUsers(ctx context.Context, sort string, order string, page int, pageSize int) ([]User, int64, error)
 	UpdateUser(ctx context.Context, id string, user *User) error
 	DeleteUser(ctx context.Context, id string) error
+
+	// UpdateUserProfile saves the profile fields of the user and records the changes in the same transaction.
+	UpdateUserProfile(ctx context.Context, user *User, changes []UserProfileChange) error
+	// GetUserProfileChanges returns the profile changes of a user, newest first.
+	GetUserProfileChanges(ctx context.Context, userID string) ([]UserProfileChange, error)
 }
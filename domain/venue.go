@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// Venue surfaces supported by the league
+const (
+	VenueSurfaceNaturalGrass   = "natural_grass"
+	VenueSurfaceArtificialTurf = "artificial_turf"
+	VenueSurfaceHybrid         = "hybrid"
+	VenueSurfaceIndoor         = "indoor"
+)
+
+// Venue represents a stadium or field where matches are played.
+// This is a pure business entity without infrastructure concerns.
+type Venue struct {
+	ID        uint64
+	Name      string
+	Address   string
+	Capacity  uint32
+	Surface   string
+	Latitude  *float64
+	Longitude *float64
+	Version   uint64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsValid performs basic domain validation for the venue.
+func (v *Venue) IsValid() bool {
+	if strings.TrimSpace(v.Name) == "" || len(v.Name) > 100 || len(v.Address) > 200 {
+		return false
+	}
+
+	if v.Surface != "" && !IsValidVenueSurface(v.Surface) {
+		return false
+	}
+
+	// Coordinates must be provided together and within range
+	if (v.Latitude == nil) != (v.Longitude == nil) {
+		return false
+	}
+	if v.Latitude != nil && (*v.Latitude < -90 || *v.Latitude > 90) {
+		return false
+	}
+	if v.Longitude != nil && (*v.Longitude < -180 || *v.Longitude > 180) {
+		return false
+	}
+
+	return true
+}
+
+// IsValidVenueSurface reports whether the surface is one of the supported values.
+func IsValidVenueSurface(surface string) bool {
+	switch surface {
+	case VenueSurfaceNaturalGrass, VenueSurfaceArtificialTurf, VenueSurfaceHybrid, VenueSurfaceIndoor:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,17 @@
+package domain
+
+import (
+	"context"
+)
+
+// VenueRepository defines the interface for venue persistence operations.
+// This port belongs in the domain layer following hexagonal architecture.
+type VenueRepository interface {
+	CreateVenue(ctx context.Context, venue *Venue) error
+	GetVenueByID(ctx context.Context, id uint64) (*Venue, error)
+	GetVenueByName(ctx context.Context, name string) (*Venue, error)
+	GetPaginatedVenues(ctx context.Context, sort string, order string, page int, pageSize int) ([]Venue, int64, error)
+	UpdateVenue(ctx context.Context, id uint64, venue *Venue) error
+	DeleteVenue(ctx context.Context, id uint64) error
+	CountMatchesByVenueID(ctx context.Context, id uint64) (int64, error)
+}
@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"context"
+	"slices"
+)
+
+type expectedVersionsKey struct{}
+
+// WithExpectedVersions returns a context whose updates and deletes only apply to records at
+// one of the given versions, as named by the If-Match request header.
+func WithExpectedVersions(ctx context.Context, versions []uint64) context.Context {
+	return context.WithValue(ctx, expectedVersionsKey{}, versions)
+}
+
+// VersionExpected reports whether a record at the version may be changed. Every version may
+// be changed when the context does not name any.
+func VersionExpected(ctx context.Context, version uint64) bool {
+	versions, ok := ctx.Value(expectedVersionsKey{}).([]uint64)
+	return !ok || slices.Contains(versions, version)
+}
@@ -91,6 +91,46 @@ func NewConflictError(resource string, detail string) *AppError {
 	)
 }
 
+// NewPreconditionFailedError creates a user-safe 412 error
+func NewPreconditionFailedError(resource string) *AppError {
+	return newAppError(
+		http.StatusPreconditionFailed,
+		"Precondition failed",
+		withDetail(fmt.Sprintf("The %s was modified since it was read; fetch it again and retry", resource)),
+		safe(),
+	)
+}
+
+// NewPreconditionRequiredError creates a user-safe 428 error
+func NewPreconditionRequiredError(detail string) *AppError {
+	return newAppError(
+		http.StatusPreconditionRequired,
+		"Precondition required",
+		withDetail(detail),
+		safe(),
+	)
+}
+
+// NewUnprocessableEntityError creates a user-safe 422 error
+func NewUnprocessableEntityError(detail string) *AppError {
+	return newAppError(
+		http.StatusUnprocessableEntity,
+		"Unprocessable entity",
+		withDetail(detail),
+		safe(),
+	)
+}
+
+// NewTooManyRequestsError creates a user-safe 429 error
+func NewTooManyRequestsError(detail string) *AppError {
+	return newAppError(
+		http.StatusTooManyRequests,
+		"Too many requests",
+		withDetail(detail),
+		safe(),
+	)
+}
+
 // NewInternalServerError creates a 500 error that logs but doesn't expose details
 func NewInternalServerError(err error) *AppError {
 	return newAppError(
@@ -0,0 +1,62 @@
+package helper
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ETag formats a record version as a strong entity tag.
+func ETag(version uint64) string {
+	return `"` + strconv.FormatUint(version, 10) + `"`
+}
+
+// ParseETags returns the versions named by an If-Match or If-None-Match header. Weak tags
+// are only accepted when weak is true, since If-Match requires a strong comparison.
+// Tags that are not record versions are ignored.
+func ParseETags(header string, weak bool) []uint64 {
+	var versions []uint64
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if strings.HasPrefix(tag, "W/") {
+			if !weak {
+				continue
+			}
+			tag = strings.TrimPrefix(tag, "W/")
+		}
+		if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+			continue
+		}
+		version, err := strconv.ParseUint(tag[1:len(tag)-1], 10, 64)
+		if err == nil {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// SetETag sets the ETag response header to the record version.
+func SetETag(c *gin.Context, version uint64) {
+	c.Header("ETag", ETag(version))
+}
+
+// WriteNotModified sets the ETag response header and answers 304 Not Modified when the
+// If-None-Match request header already names the record version. Handlers return without
+// writing a body when it reports true.
+func WriteNotModified(c *gin.Context, version uint64) bool {
+	SetETag(c, version)
+
+	header := strings.TrimSpace(c.GetHeader("If-None-Match"))
+	if header == "" {
+		return false
+	}
+	if header != "*" && !slices.Contains(ParseETags(header, true), version) {
+		return false
+	}
+
+	c.Status(http.StatusNotModified)
+	return true
+}
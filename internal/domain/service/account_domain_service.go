@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// AccountDomainService handles data-subject requests: exporting the personal data of a user
+// and erasing their account. Erasure anonymizes the user after a grace period instead of
+// deleting the row, so predictions, votes and match history keep their references.
+type AccountDomainService struct {
+	accountRepository         domain.AccountRepository
+	userRepository            domain.UserRepository
+	roleRepository            domain.RoleRepository
+	tokenRevocationRepository domain.TokenRevocationRepository
+	gracePeriod               time.Duration
+}
+
+// NewAccountDomainService creates a new AccountDomainService instance.
+// gracePeriod is how long the user has to cancel a deletion request.
+func NewAccountDomainService(
+	accountRepository domain.AccountRepository,
+	userRepository domain.UserRepository,
+	roleRepository domain.RoleRepository,
+	tokenRevocationRepository domain.TokenRevocationRepository,
+	gracePeriod time.Duration,
+) *AccountDomainService {
+	return &AccountDomainService{
+		accountRepository:         accountRepository,
+		userRepository:            userRepository,
+		roleRepository:            roleRepository,
+		tokenRevocationRepository: tokenRevocationRepository,
+		gracePeriod:               gracePeriod,
+	}
+}
+
+// ExportUserData returns the personal data held about the user.
+func (s *AccountDomainService) ExportUserData(ctx context.Context, userID string) (*domain.UserDataExport, error) {
+	export, err := s.accountRepository.GetUserDataExport(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if export == nil {
+		return nil, constants.ErrRecordNotFound
+	}
+	export.GeneratedAt = time.Now()
+	return export, nil
+}
+
+// RequestDeletion schedules the account of the user for erasure once the grace period is over.
+// Requesting it again returns the pending request unchanged.
+func (s *AccountDomainService) RequestDeletion(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	existing, err := s.accountRepository.GetAccountDeletion(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	deletion := domain.NewAccountDeletion(userID, time.Now(), s.gracePeriod)
+	if err := s.accountRepository.CreateAccountDeletion(ctx, deletion); err != nil {
+		return nil, err
+	}
+	return deletion, nil
+}
+
+// GetDeletion returns the pending deletion request of the user.
+func (s *AccountDomainService) GetDeletion(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	deletion, err := s.accountRepository.GetAccountDeletion(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if deletion == nil {
+		return nil, constants.ErrRecordNotFound
+	}
+	return deletion, nil
+}
+
+// CancelDeletion withdraws the pending deletion request of the user.
+func (s *AccountDomainService) CancelDeletion(ctx context.Context, userID string) error {
+	deleted, err := s.accountRepository.DeleteAccountDeletion(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return constants.ErrRecordNotFound
+	}
+	return nil
+}
+
+// AnonymizeDueAccounts erases the accounts whose grace period is over and returns how many
+// were anonymized. A failing account does not stop the others; the first error is returned.
+func (s *AccountDomainService) AnonymizeDueAccounts(ctx context.Context) (int, error) {
+	deletions, err := s.accountRepository.GetDueAccountDeletions(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	if len(deletions) == 0 {
+		return 0, nil
+	}
+
+	defaultRole, err := s.roleRepository.GetRoleByName(ctx, constants.RoleDefault)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get default role: %w", err)
+	}
+	if defaultRole == nil {
+		return 0, constants.ErrRecordNotFound
+	}
+
+	anonymized := 0
+	var firstErr error
+	for _, deletion := range deletions {
+		if err := s.anonymize(ctx, deletion.UserID, defaultRole.ID); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("failed to anonymize user %s: %w", deletion.UserID, err)
+			}
+			continue
+		}
+		anonymized++
+	}
+	return anonymized, firstErr
+}
+
+// anonymize ends the sessions of the user and replaces their personal data.
+func (s *AccountDomainService) anonymize(ctx context.Context, userID string, defaultRoleID uint64) error {
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if user == nil {
+		// The user was deleted during the grace period; drop the request
+		_, err := s.accountRepository.DeleteAccountDeletion(ctx, userID)
+		return err
+	}
+
+	// Access tokens stay valid until they expire unless they are revoked
+	if err := s.tokenRevocationRepository.RevokeUserTokens(ctx, userID, time.Now()); err != nil {
+		return err
+	}
+
+	user.Anonymize()
+	user.RoleID = defaultRoleID
+	user.Role = nil
+	return s.accountRepository.AnonymizeUser(ctx, user)
+}
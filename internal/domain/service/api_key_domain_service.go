@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// APIKeyDomainService contains the business logic for personal API keys.
+// Keys are minted for a user and can only be scoped to permissions the user's role grants.
+type APIKeyDomainService struct {
+	authRepository   domain.AuthenticationRepository
+	apiKeyRepository domain.APIKeyRepository
+	userRepository   domain.UserRepository
+	roleRepository   domain.RoleRepository
+	auditor          domain.Auditor
+}
+
+// NewAPIKeyDomainService creates a new APIKeyDomainService instance.
+func NewAPIKeyDomainService(
+	authRepository domain.AuthenticationRepository,
+	apiKeyRepository domain.APIKeyRepository,
+	userRepository domain.UserRepository,
+	roleRepository domain.RoleRepository,
+	auditor domain.Auditor,
+) *APIKeyDomainService {
+	return &APIKeyDomainService{
+		authRepository:   authRepository,
+		apiKeyRepository: apiKeyRepository,
+		userRepository:   userRepository,
+		roleRepository:   roleRepository,
+		auditor:          auditor,
+	}
+}
+
+// CreateAPIKey mints a new key for the user and returns it with the raw key.
+// The raw key is never stored, so this is the only time it can be shown.
+func (s *APIKeyDomainService) CreateAPIKey(ctx context.Context, apiKey *domain.APIKey) (*domain.APIKey, string, error) {
+	if apiKey == nil || !apiKey.IsValid() || len(apiKey.Scopes) == 0 {
+		return nil, "", constants.ErrInvalidData
+	}
+	if apiKey.ExpiresAt != nil && !apiKey.ExpiresAt.After(time.Now()) {
+		return nil, "", constants.ErrInvalidData
+	}
+
+	scopes, ok := domain.NormalizePermissions(apiKey.Scopes)
+	if !ok {
+		return nil, "", constants.ErrInvalidPermission
+	}
+
+	user, err := s.userRepository.GetUserByID(ctx, apiKey.UserID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if user == nil {
+		return nil, "", constants.ErrRecordNotFound
+	}
+
+	role, err := s.roleRepository.GetRoleByID(ctx, user.RoleID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get role by ID: %w", err)
+	}
+	if role == nil {
+		return nil, "", constants.ErrRecordNotFound
+	}
+
+	// A key must not grant more than its owner can do
+	granted := role.EffectivePermissions()
+	for _, scope := range scopes {
+		if !slices.Contains(granted, scope) {
+			return nil, "", fmt.Errorf("%w: %s", constants.ErrScopeNotGranted, scope)
+		}
+	}
+
+	rawKey, prefix, keyHash, err := s.authRepository.GenerateAPIKey()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+
+	apiKey.Scopes = scopes
+	apiKey.Prefix = prefix
+	apiKey.KeyHash = keyHash
+	apiKey.LastUsedAt = nil
+	apiKey.RevokedAt = nil
+	if err := s.apiKeyRepository.CreateAPIKey(ctx, apiKey); err != nil {
+		return nil, "", err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionCreate, domain.AuditEntityAPIKey, apiKey.ID, nil, apiKey)
+	return apiKey, rawKey, nil
+}
+
+// GetUserAPIKeys returns the keys of the user that were not revoked, newest first.
+func (s *APIKeyDomainService) GetUserAPIKeys(ctx context.Context, userID string) ([]domain.APIKey, error) {
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if user == nil {
+		return nil, constants.ErrRecordNotFound
+	}
+
+	return s.apiKeyRepository.GetAPIKeysByUserID(ctx, userID)
+}
+
+// RevokeAPIKey revokes one of the user's keys. It takes effect on the next request made with the key.
+func (s *APIKeyDomainService) RevokeAPIKey(ctx context.Context, userID, apiKeyID string) error {
+	apiKey, err := s.apiKeyRepository.GetAPIKeyByID(ctx, apiKeyID)
+	if err != nil {
+		return fmt.Errorf("failed to get API key by ID: %w", err)
+	}
+	// Keys of other users are reported as missing to avoid leaking their existence
+	if apiKey == nil || apiKey.UserID != userID || apiKey.RevokedAt != nil {
+		return constants.ErrAPIKeyNotFound
+	}
+
+	revokedAt := time.Now()
+	if err := s.apiKeyRepository.RevokeAPIKey(ctx, apiKey.ID, revokedAt); err != nil {
+		return err
+	}
+
+	revokedKey := *apiKey
+	revokedKey.RevokedAt = &revokedAt
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityAPIKey, apiKey.ID, apiKey, &revokedKey)
+	return nil
+}
@@ -12,12 +12,14 @@ import (
 type ArticleDomainService struct {
 	articleRepository domain.ArticleRepository
 	seasonRepository  domain.SeasonRepository
+	auditor           domain.Auditor
 }
 
-func NewArticleDomainService(articleRepository domain.ArticleRepository, seasonRepository domain.SeasonRepository) *ArticleDomainService {
+func NewArticleDomainService(articleRepository domain.ArticleRepository, seasonRepository domain.SeasonRepository, auditor domain.Auditor) *ArticleDomainService {
 	return &ArticleDomainService{
 		articleRepository: articleRepository,
 		seasonRepository:  seasonRepository,
+		auditor:           auditor,
 	}
 }
 
@@ -32,7 +34,12 @@ func (s *ArticleDomainService) CreateArticle(ctx context.Context, article *domai
 		return err
 	}
 
-	return s.articleRepository.CreateArticle(ctx, article)
+	if err := s.articleRepository.CreateArticle(ctx, article); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionCreate, domain.AuditEntityArticle, article.ID, nil, article)
+	return nil
 }
 
 // GetArticleByID retrieves an article by its ID.
@@ -77,6 +84,12 @@ func (s *ArticleDomainService) UpdateArticle(ctx context.Context, articleID uint
 		return nil, constants.ErrRecordNotFound
 	}
 
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingArticle.Version) {
+		return nil, constants.ErrVersionMismatch
+	}
+	updatedArticle.Version = existingArticle.Version
+
 	// If updating season, verify it exists
 	if updatedArticle.SeasonID != 0 && updatedArticle.SeasonID != existingArticle.SeasonID {
 		_, err := s.seasonRepository.GetSeasonByID(ctx, updatedArticle.SeasonID)
@@ -93,10 +106,34 @@ func (s *ArticleDomainService) UpdateArticle(ctx context.Context, articleID uint
 	}
 
 	// Return updated article
-	return s.articleRepository.GetArticleByID(ctx, articleID)
+	article, err := s.articleRepository.GetArticleByID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityArticle, articleID, existingArticle, article)
+	return article, nil
 }
 
 // DeleteArticle deletes an article by its ID.
 func (s *ArticleDomainService) DeleteArticle(ctx context.Context, id uint64) error {
-	return s.articleRepository.DeleteArticle(ctx, id)
+	existingArticle, err := s.articleRepository.GetArticleByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if existingArticle == nil {
+		return constants.ErrRecordNotFound
+	}
+
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingArticle.Version) {
+		return constants.ErrVersionMismatch
+	}
+
+	if err := s.articleRepository.DeleteArticle(ctx, id); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionDelete, domain.AuditEntityArticle, id, existingArticle, nil)
+	return nil
 }
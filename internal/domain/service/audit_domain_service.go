@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// AuditDomainService queries the audit log written by the domain services.
+type AuditDomainService struct {
+	auditRepository domain.AuditRepository
+}
+
+// NewAuditDomainService creates a new AuditDomainService instance.
+func NewAuditDomainService(auditRepository domain.AuditRepository) *AuditDomainService {
+	return &AuditDomainService{
+		auditRepository: auditRepository,
+	}
+}
+
+// GetPaginatedAuditEntries returns the entries matching the filter, newest first.
+// An entity ID can only be given together with the entity type.
+func (s *AuditDomainService) GetPaginatedAuditEntries(ctx context.Context, filter domain.AuditFilter, page int, pageSize int) ([]domain.AuditEntry, int64, error) {
+	if filter.EntityType != "" && !domain.IsValidAuditEntityType(filter.EntityType) {
+		return nil, 0, constants.ErrInvalidData
+	}
+	if filter.EntityID != "" && filter.EntityType == "" {
+		return nil, 0, constants.ErrInvalidData
+	}
+	if filter.Action != "" && !domain.IsValidAuditAction(filter.Action) {
+		return nil, 0, constants.ErrInvalidData
+	}
+
+	if page < 0 {
+		page = 0
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+	return s.auditRepository.GetPaginatedAuditEntries(ctx, filter, page, pageSize)
+}
@@ -2,6 +2,10 @@ package service
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/domain"
@@ -10,30 +14,367 @@ import (
 // AuthenticationDomainService contains the business logic for authentication operations.
 // It operates on domain entities and implements authentication business rules.
 type AuthenticationDomainService struct {
-	authRepository domain.AuthenticationRepository
+	authRepository            domain.AuthenticationRepository
+	refreshTokenRepository    domain.RefreshTokenRepository
+	sessionRepository         domain.SessionRepository
+	tokenRevocationRepository domain.TokenRevocationRepository
+	userRepository            domain.UserRepository
+	apiKeyRepository          domain.APIKeyRepository
+	roleRepository            domain.RoleRepository
+	policy                    domain.SessionPolicy
 }
 
 // NewAuthenticationDomainService creates a new AuthenticationDomainService.
-func NewAuthenticationDomainService(authRepository domain.AuthenticationRepository) *AuthenticationDomainService {
+func NewAuthenticationDomainService(
+	authRepository domain.AuthenticationRepository,
+	refreshTokenRepository domain.RefreshTokenRepository,
+	sessionRepository domain.SessionRepository,
+	tokenRevocationRepository domain.TokenRevocationRepository,
+	userRepository domain.UserRepository,
+	apiKeyRepository domain.APIKeyRepository,
+	roleRepository domain.RoleRepository,
+	policy domain.SessionPolicy,
+) *AuthenticationDomainService {
 	return &AuthenticationDomainService{
-		authRepository: authRepository,
+		authRepository:            authRepository,
+		refreshTokenRepository:    refreshTokenRepository,
+		sessionRepository:         sessionRepository,
+		tokenRevocationRepository: tokenRevocationRepository,
+		userRepository:            userRepository,
+		apiKeyRepository:          apiKeyRepository,
+		roleRepository:            roleRepository,
+		policy:                    policy,
 	}
 }
 
-// GenerateToken creates a new authentication token for the given user.
-func (s *AuthenticationDomainService) GenerateToken(ctx context.Context, user *domain.User) (string, error) {
+// GenerateToken creates a new authentication token for the given user and session.
+func (s *AuthenticationDomainService) GenerateToken(ctx context.Context, user *domain.User, sessionID string) (string, error) {
 	if user == nil {
 		return "", constants.ErrInvalidData
 	}
 
-	return s.authRepository.GenerateAccessToken(ctx, user)
+	return s.authRepository.GenerateAccessToken(ctx, user, sessionID)
+}
+
+// StartSession records a new session for the user's device and issues an access token
+// and the first refresh token of the session. When the user exceeds the session limit
+// the oldest sessions are revoked.
+func (s *AuthenticationDomainService) StartSession(ctx context.Context, user *domain.User, userAgent, ipAddress string) (*domain.AuthTokens, error) {
+	if user == nil {
+		return nil, constants.ErrInvalidData
+	}
+
+	now := time.Now()
+	session := &domain.Session{
+		UserID:     user.ID,
+		UserAgent:  truncate(userAgent, 255),
+		IPAddress:  ipAddress,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(s.policy.RefreshTokenTTL),
+	}
+	if err := s.sessionRepository.CreateSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	accessToken, err := s.GenerateToken(ctx, user, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, rawToken, err := s.newRefreshToken(user.ID, session.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.refreshTokenRepository.CreateRefreshToken(ctx, refreshToken); err != nil {
+		return nil, err
+	}
+
+	if err := s.evictExcessSessions(ctx, user.ID, now); err != nil {
+		return nil, err
+	}
+
+	return &domain.AuthTokens{
+		AccessToken:           accessToken,
+		RefreshToken:          rawToken,
+		RefreshTokenExpiresAt: refreshToken.ExpiresAt,
+	}, nil
+}
+
+// RefreshSession exchanges a refresh token for a new access token and a new refresh token.
+// The presented token can only be used once: presenting it again revokes its session and
+// every token of its family, which forces both the legitimate user and the attacker to sign in again.
+func (s *AuthenticationDomainService) RefreshSession(ctx context.Context, rawToken string) (*domain.AuthTokens, error) {
+	if rawToken == "" {
+		return nil, constants.ErrInvalidRefreshToken
+	}
+
+	stored, err := s.refreshTokenRepository.GetRefreshTokenByHash(ctx, s.authRepository.HashRefreshToken(rawToken))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+	if stored == nil || stored.IsRevoked() {
+		return nil, constants.ErrInvalidRefreshToken
+	}
+
+	now := time.Now()
+	if stored.IsUsed() {
+		return nil, s.revokeReusedFamily(ctx, stored.FamilyID, now)
+	}
+	if stored.IsExpired(now) {
+		return nil, constants.ErrInvalidRefreshToken
+	}
+
+	user, err := s.userRepository.GetUserByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if user == nil {
+		return nil, constants.ErrInvalidRefreshToken
+	}
+
+	// The access token is built from the current user so role changes apply on refresh
+	accessToken, err := s.GenerateToken(ctx, user, stored.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	replacement, newRawToken, err := s.newRefreshToken(user.ID, stored.FamilyID, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.refreshTokenRepository.RotateRefreshToken(ctx, stored.ID, now, replacement); err != nil {
+		if errors.Is(err, constants.ErrRefreshTokenReused) {
+			return nil, s.revokeReusedFamily(ctx, stored.FamilyID, now)
+		}
+		return nil, err
+	}
+
+	if err := s.sessionRepository.TouchSession(ctx, stored.FamilyID, now, replacement.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	return &domain.AuthTokens{
+		AccessToken:           accessToken,
+		RefreshToken:          newRawToken,
+		RefreshTokenExpiresAt: replacement.ExpiresAt,
+	}, nil
 }
 
 // ValidateAuthentication validates a token and returns authentication claims.
+// Tokens that were revoked on logout or by an administrator are rejected even
+// though their signature and expiry are still valid.
 func (s *AuthenticationDomainService) ValidateAuthentication(ctx context.Context, token string) (*domain.AuthenticationClaims, error) {
 	if token == "" {
 		return nil, constants.ErrInvalidData
 	}
 
-	return s.authRepository.ValidateAccessToken(ctx, token)
+	claims, err := s.authRepository.ValidateAccessToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := s.tokenRevocationRepository.IsTokenRevoked(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, constants.ErrTokenRevoked
+	}
+
+	return claims, nil
+}
+
+// AuthenticateAPIKey validates an API key and returns the claims of its owner.
+// The permissions are the key scopes that the owner's current role still grants.
+// API key claims carry no token or session ID since they cannot be refreshed or logged out.
+func (s *AuthenticationDomainService) AuthenticateAPIKey(ctx context.Context, rawKey string) (*domain.AuthenticationClaims, error) {
+	prefix, keyHash, ok := s.authRepository.ParseAPIKey(rawKey)
+	if !ok {
+		return nil, constants.ErrInvalidAPIKey
+	}
+
+	apiKey, err := s.apiKeyRepository.GetAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+	if apiKey == nil || subtle.ConstantTimeCompare([]byte(apiKey.KeyHash), []byte(keyHash)) != 1 {
+		return nil, constants.ErrInvalidAPIKey
+	}
+
+	now := time.Now()
+	if !apiKey.IsActive(now) {
+		return nil, constants.ErrInvalidAPIKey
+	}
+
+	user, err := s.userRepository.GetUserByID(ctx, apiKey.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if user == nil {
+		return nil, constants.ErrInvalidAPIKey
+	}
+
+	role, err := s.roleRepository.GetRoleByID(ctx, user.RoleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role by ID: %w", err)
+	}
+	if role == nil {
+		return nil, constants.ErrInvalidAPIKey
+	}
+
+	if apiKey.NeedsUsageUpdate(now) {
+		if err := s.apiKeyRepository.TouchAPIKey(ctx, apiKey.ID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	claims := &domain.AuthenticationClaims{
+		APIKeyID:    apiKey.ID,
+		UserID:      user.ID,
+		Username:    user.Username,
+		Role:        role.Name,
+		Permissions: apiKey.EffectivePermissions(role),
+		IssuedAt:    apiKey.CreatedAt,
+	}
+	if apiKey.ExpiresAt != nil {
+		claims.ExpiresAt = *apiKey.ExpiresAt
+	}
+	return claims, nil
+}
+
+// Logout revokes the access token and the session it belongs to.
+func (s *AuthenticationDomainService) Logout(ctx context.Context, claims *domain.AuthenticationClaims) error {
+	if claims == nil || claims.UserID == "" {
+		return constants.ErrInvalidData
+	}
+
+	now := time.Now()
+	if claims.TokenID != "" {
+		err := s.tokenRevocationRepository.RevokeToken(ctx, &domain.RevokedToken{
+			TokenID:   claims.TokenID,
+			UserID:    claims.UserID,
+			ExpiresAt: claims.ExpiresAt,
+			RevokedAt: now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if claims.SessionID == "" {
+		return nil
+	}
+	return s.sessionRepository.RevokeSessions(ctx, []string{claims.SessionID}, now)
+}
+
+// GetActiveSessions returns the sessions of the user that can still be refreshed, oldest first.
+func (s *AuthenticationDomainService) GetActiveSessions(ctx context.Context, userID string) ([]domain.Session, error) {
+	return s.sessionRepository.GetActiveSessionsByUserID(ctx, userID, time.Now())
+}
+
+// RevokeSession ends one of the user's own sessions.
+func (s *AuthenticationDomainService) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	session, err := s.sessionRepository.GetSessionByID(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to get session by ID: %w", err)
+	}
+	// Sessions of other users are reported as missing to avoid leaking their existence
+	if session == nil || session.UserID != userID || !session.IsActive(time.Now()) {
+		return constants.ErrSessionNotFound
+	}
+
+	return s.sessionRepository.RevokeSessions(ctx, []string{session.ID}, time.Now())
+}
+
+// RevokeUserSessions invalidates every session, access token and refresh token issued to the user so far.
+func (s *AuthenticationDomainService) RevokeUserSessions(ctx context.Context, userID string) error {
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if user == nil {
+		return constants.ErrRecordNotFound
+	}
+
+	now := time.Now()
+	if err := s.tokenRevocationRepository.RevokeUserTokens(ctx, userID, now); err != nil {
+		return err
+	}
+	return s.sessionRepository.RevokeUserSessions(ctx, userID, now)
+}
+
+// PurgeExpiredTokens removes sessions, refresh tokens and revocation records that can no
+// longer affect any live token and returns how many records were deleted.
+func (s *AuthenticationDomainService) PurgeExpiredTokens(ctx context.Context) (int64, error) {
+	now := time.Now()
+
+	refreshTokens, err := s.refreshTokenRepository.DeleteExpiredRefreshTokens(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	// Access tokens of an expired session may outlive it by up to the access token TTL,
+	// and the same applies to tokens issued before a user revocation cutoff
+	cutoff := now.Add(-s.policy.AccessTokenTTL)
+	sessions, err := s.sessionRepository.DeleteExpiredSessions(ctx, cutoff)
+	if err != nil {
+		return refreshTokens, err
+	}
+
+	revocations, err := s.tokenRevocationRepository.DeleteExpiredRevocations(ctx, now, cutoff)
+	if err != nil {
+		return refreshTokens + sessions, err
+	}
+
+	return refreshTokens + sessions + revocations, nil
+}
+
+// newRefreshToken builds a refresh token record for the session and returns it with the raw token.
+func (s *AuthenticationDomainService) newRefreshToken(userID, sessionID string, now time.Time) (*domain.RefreshToken, string, error) {
+	rawToken, tokenHash, err := s.authRepository.GenerateRefreshToken()
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	return &domain.RefreshToken{
+		UserID:    userID,
+		FamilyID:  sessionID,
+		TokenHash: tokenHash,
+		ExpiresAt: now.Add(s.policy.RefreshTokenTTL),
+	}, rawToken, nil
+}
+
+// evictExcessSessions revokes the oldest active sessions beyond the per-user limit.
+func (s *AuthenticationDomainService) evictExcessSessions(ctx context.Context, userID string, now time.Time) error {
+	sessions, err := s.sessionRepository.GetActiveSessionsByUserID(ctx, userID, now)
+	if err != nil {
+		return err
+	}
+
+	evicted := domain.SessionsToEvict(sessions, s.policy.MaxSessions)
+	if len(evicted) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(evicted))
+	for i, session := range evicted {
+		ids[i] = session.ID
+	}
+	return s.sessionRepository.RevokeSessions(ctx, ids, now)
+}
+
+// revokeReusedFamily revokes the session and its whole token family after a refresh token was reused.
+func (s *AuthenticationDomainService) revokeReusedFamily(ctx context.Context, familyID string, now time.Time) error {
+	if err := s.sessionRepository.RevokeSessions(ctx, []string{familyID}, now); err != nil {
+		return err
+	}
+	return constants.ErrRefreshTokenReused
+}
+
+// truncate shortens s to at most limit bytes.
+func truncate(s string, limit int) string {
+	if len(s) <= limit {
+		return s
+	}
+	return s[:limit]
 }
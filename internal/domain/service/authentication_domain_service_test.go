@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+type authenticationFixture struct {
+	service       *AuthenticationDomainService
+	refreshTokens *fakeRefreshTokenRepository
+	sessions      *fakeSessionRepository
+	tokens        *domain.AuthTokens // Tokens issued when the session started
+}
+
+func newAuthenticationFixture(t *testing.T) *authenticationFixture {
+	t.Helper()
+	refreshTokens := newFakeRefreshTokenRepository()
+	sessions := newFakeSessionRepository(refreshTokens)
+	users := &fakeUserRepository{users: map[string]*domain.User{
+		"user-1": {ID: "user-1", Username: "fan@example.com"},
+	}}
+	service := NewAuthenticationDomainService(&fakeAuthRepository{}, refreshTokens, sessions, nil, users, nil, nil,
+		domain.SessionPolicy{AccessTokenTTL: time.Minute, RefreshTokenTTL: time.Hour})
+
+	user, _ := users.GetUserByID(context.Background(), "user-1")
+	tokens, err := service.StartSession(context.Background(), user, "test", "192.0.2.1")
+	if err != nil {
+		t.Fatalf("StartSession() error = %v", err)
+	}
+	return &authenticationFixture{service: service, refreshTokens: refreshTokens, sessions: sessions, tokens: tokens}
+}
+
+func TestRefreshSessionReuseDetection(t *testing.T) {
+	tests := []struct {
+		name string
+		// present prepares the fixture and returns the refresh token to exchange
+		present            func(t *testing.T, f *authenticationFixture) string
+		wantErr            error
+		wantSessionRevoked bool
+	}{
+		{
+			name: "first use rotates the token",
+			present: func(t *testing.T, f *authenticationFixture) string {
+				return f.tokens.RefreshToken
+			},
+		},
+		{
+			name: "replacement can be used",
+			present: func(t *testing.T, f *authenticationFixture) string {
+				return refresh(t, f, f.tokens.RefreshToken).RefreshToken
+			},
+		},
+		{
+			name: "reused token revokes the session",
+			present: func(t *testing.T, f *authenticationFixture) string {
+				refresh(t, f, f.tokens.RefreshToken)
+				return f.tokens.RefreshToken
+			},
+			wantErr:            constants.ErrRefreshTokenReused,
+			wantSessionRevoked: true,
+		},
+		{
+			name: "token used by a concurrent request revokes the session",
+			present: func(t *testing.T, f *authenticationFixture) string {
+				f.refreshTokens.beforeRotate = func() {
+					now := time.Now()
+					for _, token := range f.refreshTokens.tokens {
+						token.UsedAt = &now
+					}
+				}
+				return f.tokens.RefreshToken
+			},
+			wantErr:            constants.ErrRefreshTokenReused,
+			wantSessionRevoked: true,
+		},
+		{
+			name: "expired token",
+			present: func(t *testing.T, f *authenticationFixture) string {
+				for _, token := range f.refreshTokens.tokens {
+					token.ExpiresAt = time.Now().Add(-time.Second)
+				}
+				return f.tokens.RefreshToken
+			},
+			wantErr: constants.ErrInvalidRefreshToken,
+		},
+		{
+			name: "unknown token",
+			present: func(t *testing.T, f *authenticationFixture) string {
+				return "refresh-unknown"
+			},
+			wantErr: constants.ErrInvalidRefreshToken,
+		},
+		{
+			name: "empty token",
+			present: func(t *testing.T, f *authenticationFixture) string {
+				return ""
+			},
+			wantErr: constants.ErrInvalidRefreshToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAuthenticationFixture(t)
+			rawToken := tt.present(t, f)
+
+			tokens, err := f.service.RefreshSession(context.Background(), rawToken)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RefreshSession() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (tokens.RefreshToken == "" || tokens.RefreshToken == rawToken) {
+				t.Errorf("RefreshSession() refresh token = %q, want a new token", tokens.RefreshToken)
+			}
+
+			session := f.sessions.sessions["session-1"]
+			if revoked := session.RevokedAt != nil; revoked != tt.wantSessionRevoked {
+				t.Errorf("session revoked = %v, want %v", revoked, tt.wantSessionRevoked)
+			}
+		})
+	}
+}
+
+func TestRefreshSessionAfterReuseRejectsWholeFamily(t *testing.T) {
+	f := newAuthenticationFixture(t)
+	latest := refresh(t, f, f.tokens.RefreshToken)
+
+	// The stolen first token is replayed, so the token the legitimate client holds stops working too
+	if _, err := f.service.RefreshSession(context.Background(), f.tokens.RefreshToken); !errors.Is(err, constants.ErrRefreshTokenReused) {
+		t.Fatalf("RefreshSession() with a reused token error = %v, want %v", err, constants.ErrRefreshTokenReused)
+	}
+	if _, err := f.service.RefreshSession(context.Background(), latest.RefreshToken); !errors.Is(err, constants.ErrInvalidRefreshToken) {
+		t.Errorf("RefreshSession() with the latest token error = %v, want %v", err, constants.ErrInvalidRefreshToken)
+	}
+}
+
+// refresh exchanges the refresh token and fails the test on error.
+func refresh(t *testing.T, f *authenticationFixture, rawToken string) *domain.AuthTokens {
+	t.Helper()
+	tokens, err := f.service.RefreshSession(context.Background(), rawToken)
+	if err != nil {
+		t.Fatalf("RefreshSession() error = %v", err)
+	}
+	return tokens
+}
@@ -0,0 +1,336 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// CredentialDomainService contains the business logic for local email/password authentication.
+// Failed logins are counted per IP address and lock the address out once the policy limit is
+// reached, so passwords cannot be guessed faster than the lockout allows.
+type CredentialDomainService struct {
+	credentialRepository   domain.CredentialRepository
+	loginAttemptRepository domain.LoginAttemptRepository
+	authRepository         domain.AuthenticationRepository
+	userRepository         domain.UserRepository
+	roleRepository         domain.RoleRepository
+	isEmailDomainAllowed   func(ctx context.Context, email string) (bool, error)
+	policy                 domain.PasswordPolicy
+}
+
+// NewCredentialDomainService creates a new CredentialDomainService instance.
+// isEmailDomainAllowed decides whether a new account may be registered for an email.
+func NewCredentialDomainService(
+	credentialRepository domain.CredentialRepository,
+	loginAttemptRepository domain.LoginAttemptRepository,
+	authRepository domain.AuthenticationRepository,
+	userRepository domain.UserRepository,
+	roleRepository domain.RoleRepository,
+	isEmailDomainAllowed func(ctx context.Context, email string) (bool, error),
+	policy domain.PasswordPolicy,
+) *CredentialDomainService {
+	return &CredentialDomainService{
+		credentialRepository:   credentialRepository,
+		loginAttemptRepository: loginAttemptRepository,
+		authRepository:         authRepository,
+		userRepository:         userRepository,
+		roleRepository:         roleRepository,
+		isEmailDomainAllowed:   isEmailDomainAllowed,
+		policy:                 policy,
+	}
+}
+
+// LockoutDuration returns how long an IP address is locked out after too many failed logins.
+func (s *CredentialDomainService) LockoutDuration() time.Duration {
+	return s.policy.LockoutDuration
+}
+
+// Register creates a user with the default role and a local password.
+func (s *CredentialDomainService) Register(ctx context.Context, user *domain.User, password string) (*domain.User, error) {
+	if user == nil {
+		return nil, constants.ErrInvalidData
+	}
+	if !s.policy.IsValidPassword(password) {
+		return nil, constants.ErrInvalidPassword
+	}
+
+	user.Username = normalizeUsername(user.Username)
+	allowed, err := s.isEmailDomainAllowed(ctx, user.Username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check email domain: %w", err)
+	}
+	if !allowed {
+		return nil, constants.ErrEmailDomainNotAllowed
+	}
+
+	existing, err := s.userRepository.GetUserByUsername(ctx, user.Username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by username: %w", err)
+	}
+	if existing != nil {
+		return nil, constants.ErrRecordAlreadyExists
+	}
+
+	defaultRole, err := s.roleRepository.GetRoleByName(ctx, constants.RoleDefault)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default role: %w", err)
+	}
+	if defaultRole == nil {
+		return nil, constants.ErrRecordNotFound
+	}
+
+	// The role is always the default one, whatever the request asked for
+	user.RoleID = defaultRole.ID
+	user.TeamID = nil
+	if !user.IsValid() {
+		return nil, constants.ErrInvalidData
+	}
+
+	passwordHash, err := s.authRepository.HashPassword(password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	if err := s.userRepository.CreateUser(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	err = s.credentialRepository.SavePasswordCredential(ctx, &domain.PasswordCredential{
+		UserID:       user.ID,
+		PasswordHash: passwordHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Reload the user so the role is populated like for existing users
+	created, err := s.userRepository.GetUserByID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if created == nil {
+		return nil, constants.ErrRecordNotFound
+	}
+	return created, nil
+}
+
+// Login checks the username and password and returns the user. Every attempt is recorded
+// for the IP address before the password is checked; once the failures within the lockout
+// duration reach the limit, further attempts are rejected with constants.ErrTooManyLoginAttempts
+// without being checked. Failures are never cleared by a successful login, they age out.
+func (s *CredentialDomainService) Login(ctx context.Context, username, password, ipAddress string) (*domain.User, error) {
+	username = normalizeUsername(username)
+	attempt, err := s.startLoginAttempt(ctx, ipAddress, username)
+	if err != nil {
+		return nil, err
+	}
+
+	user, credential, err := s.getCredential(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unknown users and users without a password are checked against an empty hash so
+	// that they take as long to reject as a wrong password
+	passwordHash := ""
+	if credential != nil {
+		passwordHash = credential.PasswordHash
+	}
+	match, needsRehash, err := s.authRepository.VerifyPassword(passwordHash, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify password: %w", err)
+	}
+	if !match {
+		return nil, constants.ErrInvalidCredentials
+	}
+
+	if err := s.loginAttemptRepository.MarkLoginAttemptSucceeded(ctx, attempt.ID); err != nil {
+		return nil, err
+	}
+
+	// Upgrade legacy bcrypt hashes and outdated parameters while the password is known
+	if needsRehash {
+		if err := s.savePassword(ctx, user.ID, password); err != nil {
+			return nil, err
+		}
+	}
+	return user, nil
+}
+
+// ChangePassword replaces the password of a user after checking the current one. The check
+// counts as a login attempt for the IP address, so a stolen session cannot be used to guess
+// the password faster than the login lockout allows.
+func (s *CredentialDomainService) ChangePassword(ctx context.Context, userID, currentPassword, newPassword, ipAddress string) error {
+	if !s.policy.IsValidPassword(newPassword) {
+		return constants.ErrInvalidPassword
+	}
+
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if user == nil {
+		return constants.ErrRecordNotFound
+	}
+
+	attempt, err := s.startLoginAttempt(ctx, ipAddress, user.Username)
+	if err != nil {
+		return err
+	}
+
+	credential, err := s.credentialRepository.GetPasswordCredential(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get password credential: %w", err)
+	}
+	passwordHash := ""
+	if credential != nil {
+		passwordHash = credential.PasswordHash
+	}
+
+	match, _, err := s.authRepository.VerifyPassword(passwordHash, currentPassword)
+	if err != nil {
+		return fmt.Errorf("failed to verify password: %w", err)
+	}
+	if !match {
+		return constants.ErrInvalidCredentials
+	}
+
+	if err := s.loginAttemptRepository.MarkLoginAttemptSucceeded(ctx, attempt.ID); err != nil {
+		return err
+	}
+	return s.savePassword(ctx, userID, newPassword)
+}
+
+// startLoginAttempt records a failed attempt for the IP address before the password is
+// checked, and returns constants.ErrTooManyLoginAttempts once the address is locked out.
+func (s *CredentialDomainService) startLoginAttempt(ctx context.Context, ipAddress, username string) (*domain.LoginAttempt, error) {
+	now := time.Now()
+	attempt := &domain.LoginAttempt{
+		IPAddress: ipAddress,
+		Username:  truncate(username, 100),
+		CreatedAt: now,
+	}
+	err := s.loginAttemptRepository.StartLoginAttempt(ctx, attempt, now.Add(-s.policy.LockoutDuration), s.policy.MaxLoginAttempts)
+	if err != nil {
+		return nil, err
+	}
+	return attempt, nil
+}
+
+// IssuePasswordReset creates a single-use reset token for the user and returns the raw token
+// with its expiry. The raw token is never stored, so this is the only time it can be read.
+// It also lets users who only signed in with a provider set a local password.
+func (s *CredentialDomainService) IssuePasswordReset(ctx context.Context, userID string) (string, time.Time, error) {
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if user == nil {
+		return "", time.Time{}, constants.ErrRecordNotFound
+	}
+
+	rawToken, tokenHash, err := s.authRepository.GeneratePasswordResetToken()
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate password reset token: %w", err)
+	}
+
+	token := &domain.PasswordResetToken{
+		UserID:    user.ID,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(s.policy.ResetTokenTTL),
+	}
+	if err := s.credentialRepository.CreatePasswordResetToken(ctx, token); err != nil {
+		return "", time.Time{}, err
+	}
+	return rawToken, token.ExpiresAt, nil
+}
+
+// ResetPassword redeems a reset token and sets the new password. It returns the ID of the
+// user so the caller can end the sessions opened with the old password.
+func (s *CredentialDomainService) ResetPassword(ctx context.Context, rawToken, newPassword string) (string, error) {
+	if rawToken == "" {
+		return "", constants.ErrInvalidResetToken
+	}
+	if !s.policy.IsValidPassword(newPassword) {
+		return "", constants.ErrInvalidPassword
+	}
+
+	token, err := s.credentialRepository.GetPasswordResetTokenByHash(ctx, s.authRepository.HashPasswordResetToken(rawToken))
+	if err != nil {
+		return "", fmt.Errorf("failed to get password reset token: %w", err)
+	}
+	now := time.Now()
+	if token == nil || !token.IsUsable(now) {
+		return "", constants.ErrInvalidResetToken
+	}
+
+	passwordHash, err := s.authRepository.HashPassword(newPassword)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	err = s.credentialRepository.ResetPassword(ctx, token.ID, now, &domain.PasswordCredential{
+		UserID:       token.UserID,
+		PasswordHash: passwordHash,
+	})
+	if err != nil {
+		return "", err
+	}
+	return token.UserID, nil
+}
+
+// PurgeExpired removes expired reset tokens and login attempts older than the lockout
+// window, and returns how many records were deleted.
+func (s *CredentialDomainService) PurgeExpired(ctx context.Context) (int64, error) {
+	now := time.Now()
+	tokens, err := s.credentialRepository.DeleteExpiredPasswordResetTokens(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	attempts, err := s.loginAttemptRepository.DeleteLoginAttemptsBefore(ctx, now.Add(-s.policy.LockoutDuration))
+	if err != nil {
+		return tokens, err
+	}
+	return tokens + attempts, nil
+}
+
+// getCredential loads the user and their password credential. Both are nil for unknown users.
+func (s *CredentialDomainService) getCredential(ctx context.Context, username string) (*domain.User, *domain.PasswordCredential, error) {
+	user, err := s.userRepository.GetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get user by username: %w", err)
+	}
+	if user == nil {
+		return nil, nil, nil
+	}
+
+	credential, err := s.credentialRepository.GetPasswordCredential(ctx, user.ID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get password credential: %w", err)
+	}
+	return user, credential, nil
+}
+
+// savePassword hashes the password and stores it as the user's credential.
+func (s *CredentialDomainService) savePassword(ctx context.Context, userID, password string) error {
+	passwordHash, err := s.authRepository.HashPassword(password)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	return s.credentialRepository.SavePasswordCredential(ctx, &domain.PasswordCredential{
+		UserID:       userID,
+		PasswordHash: passwordHash,
+	})
+}
+
+// normalizeUsername lowercases the email used as username.
+func normalizeUsername(username string) string {
+	return strings.ToLower(strings.TrimSpace(username))
+}
@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+const (
+	testIPAddress     = "192.0.2.1"
+	testPassword      = "correct horse battery"
+	testWrongPassword = "wrong password"
+)
+
+var testPasswordPolicy = domain.PasswordPolicy{
+	MinLength:        8,
+	MaxLength:        64,
+	MaxLoginAttempts: 3,
+	LockoutDuration:  15 * time.Minute,
+}
+
+type credentialFixture struct {
+	service       *CredentialDomainService
+	loginAttempts *fakeLoginAttemptRepository
+	credentials   *fakeCredentialRepository
+}
+
+func newCredentialFixture() *credentialFixture {
+	users := &fakeUserRepository{users: map[string]*domain.User{
+		"user-1": {ID: "user-1", Username: "fan@example.com"},
+	}}
+	credentials := &fakeCredentialRepository{credentials: map[string]*domain.PasswordCredential{
+		"user-1": {UserID: "user-1", PasswordHash: "hash-" + testPassword},
+	}}
+	loginAttempts := &fakeLoginAttemptRepository{}
+	service := NewCredentialDomainService(credentials, loginAttempts, &fakeAuthRepository{}, users, nil, nil, testPasswordPolicy)
+	return &credentialFixture{service: service, loginAttempts: loginAttempts, credentials: credentials}
+}
+
+// addFailures records failed attempts from the address as if they had started at the given time.
+func (f *credentialFixture) addFailures(count int, ipAddress string, createdAt time.Time) {
+	for range count {
+		f.loginAttempts.attempts = append(f.loginAttempts.attempts, &domain.LoginAttempt{
+			ID:        uint64(len(f.loginAttempts.attempts) + 1),
+			IPAddress: ipAddress,
+			CreatedAt: createdAt,
+		})
+	}
+}
+
+func TestLoginLockout(t *testing.T) {
+	tests := []struct {
+		name string
+		// earlier are the passwords tried from testIPAddress before the checked login
+		earlier         []string
+		earlierUsername string // Username of the earlier attempts, the test user when empty
+		agedFailures    int    // Failures from testIPAddress older than the lockout duration
+		ipAddress       string // Address of the checked login, testIPAddress when empty
+		password        string
+		wantErr         error
+		wantAttempts    int // Attempts recorded once the checked login is done
+	}{
+		{
+			name:         "correct password",
+			password:     testPassword,
+			wantAttempts: 1,
+		},
+		{
+			name:         "failure below the limit",
+			earlier:      []string{testWrongPassword},
+			password:     testWrongPassword,
+			wantErr:      constants.ErrInvalidCredentials,
+			wantAttempts: 2,
+		},
+		{
+			name:         "correct password after failures below the limit",
+			earlier:      []string{testWrongPassword, testWrongPassword},
+			password:     testPassword,
+			wantAttempts: 3,
+		},
+		{
+			name:         "failure reaching the limit is still checked",
+			earlier:      []string{testWrongPassword, testWrongPassword},
+			password:     testWrongPassword,
+			wantErr:      constants.ErrInvalidCredentials,
+			wantAttempts: 3,
+		},
+		{
+			name:         "locked out once the limit is reached",
+			earlier:      []string{testWrongPassword, testWrongPassword, testWrongPassword},
+			password:     testPassword,
+			wantErr:      constants.ErrTooManyLoginAttempts,
+			wantAttempts: 3,
+		},
+		{
+			name:         "success does not clear earlier failures",
+			earlier:      []string{testWrongPassword, testWrongPassword, testPassword, testWrongPassword},
+			password:     testPassword,
+			wantErr:      constants.ErrTooManyLoginAttempts,
+			wantAttempts: 4,
+		},
+		{
+			name:         "failures age out of the lockout",
+			agedFailures: 3,
+			password:     testPassword,
+			wantAttempts: 4,
+		},
+		{
+			name:         "other addresses are not locked out",
+			earlier:      []string{testWrongPassword, testWrongPassword, testWrongPassword},
+			ipAddress:    "198.51.100.7",
+			password:     testPassword,
+			wantAttempts: 4,
+		},
+		{
+			name:            "unknown users count as failures",
+			earlier:         []string{testPassword, testPassword, testPassword},
+			earlierUsername: "nobody@example.com",
+			password:        testPassword,
+			wantErr:         constants.ErrTooManyLoginAttempts,
+			wantAttempts:    3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newCredentialFixture()
+			f.addFailures(tt.agedFailures, testIPAddress, time.Now().Add(-2*testPasswordPolicy.LockoutDuration))
+			earlierUsername := tt.earlierUsername
+			if earlierUsername == "" {
+				earlierUsername = "fan@example.com"
+			}
+			for _, password := range tt.earlier {
+				_, _ = f.service.Login(context.Background(), earlierUsername, password, testIPAddress)
+			}
+
+			ipAddress := tt.ipAddress
+			if ipAddress == "" {
+				ipAddress = testIPAddress
+			}
+			user, err := f.service.Login(context.Background(), "Fan@Example.com", tt.password, ipAddress)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Login() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && user.ID != "user-1" {
+				t.Errorf("Login() user = %q, want %q", user.ID, "user-1")
+			}
+			if got := len(f.loginAttempts.attempts); got != tt.wantAttempts {
+				t.Errorf("recorded %d login attempts, want %d", got, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestChangePasswordCountsAsLoginAttempt(t *testing.T) {
+	f := newCredentialFixture()
+	f.addFailures(testPasswordPolicy.MaxLoginAttempts, testIPAddress, time.Now())
+
+	err := f.service.ChangePassword(context.Background(), "user-1", testPassword, "a new password", testIPAddress)
+	if !errors.Is(err, constants.ErrTooManyLoginAttempts) {
+		t.Fatalf("ChangePassword() error = %v, want %v", err, constants.ErrTooManyLoginAttempts)
+	}
+	if got := f.credentials.credentials["user-1"].PasswordHash; got != "hash-"+testPassword {
+		t.Errorf("password hash = %q, want it unchanged", got)
+	}
+
+	err = f.service.ChangePassword(context.Background(), "user-1", testPassword, "a new password", "198.51.100.7")
+	if err != nil {
+		t.Fatalf("ChangePassword() from another address error = %v", err)
+	}
+	if got := f.credentials.credentials["user-1"].PasswordHash; got != "hash-a new password" {
+		t.Errorf("password hash = %q, want %q", got, "hash-a new password")
+	}
+}
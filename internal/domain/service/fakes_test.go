@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// In-memory fakes of the repositories used by the service tests. Each embeds its interface,
+// so calling a method a test does not expect panics instead of silently succeeding.
+
+// fakeAuthRepository derives tokens and hashes from the input, so tests can predict them.
+type fakeAuthRepository struct {
+	domain.AuthenticationRepository
+	refreshTokens int
+}
+
+func (r *fakeAuthRepository) GenerateAccessToken(_ context.Context, user *domain.User, sessionID string) (string, error) {
+	return "access-" + user.ID + "-" + sessionID, nil
+}
+
+func (r *fakeAuthRepository) GenerateRefreshToken() (string, string, error) {
+	r.refreshTokens++
+	token := fmt.Sprintf("refresh-%d", r.refreshTokens)
+	return token, r.HashRefreshToken(token), nil
+}
+
+func (r *fakeAuthRepository) HashRefreshToken(token string) string {
+	return "hash-" + token
+}
+
+func (r *fakeAuthRepository) HashPassword(password string) (string, error) {
+	return "hash-" + password, nil
+}
+
+func (r *fakeAuthRepository) VerifyPassword(passwordHash string, password string) (bool, bool, error) {
+	return passwordHash != "" && passwordHash == "hash-"+password, false, nil
+}
+
+// fakeUserRepository holds users by ID.
+type fakeUserRepository struct {
+	domain.UserRepository
+	users map[string]*domain.User
+}
+
+func (r *fakeUserRepository) GetUserByID(_ context.Context, id string) (*domain.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *user
+	return &copied, nil
+}
+
+func (r *fakeUserRepository) GetUserByUsername(_ context.Context, username string) (*domain.User, error) {
+	for _, user := range r.users {
+		if user.Username == username {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+// fakeCredentialRepository holds password credentials by user ID.
+type fakeCredentialRepository struct {
+	domain.CredentialRepository
+	credentials map[string]*domain.PasswordCredential
+}
+
+func (r *fakeCredentialRepository) GetPasswordCredential(_ context.Context, userID string) (*domain.PasswordCredential, error) {
+	credential, ok := r.credentials[userID]
+	if !ok {
+		return nil, nil
+	}
+	copied := *credential
+	return &copied, nil
+}
+
+func (r *fakeCredentialRepository) SavePasswordCredential(_ context.Context, credential *domain.PasswordCredential) error {
+	copied := *credential
+	r.credentials[credential.UserID] = &copied
+	return nil
+}
+
+// fakeLoginAttemptRepository keeps login attempts in the order they started.
+type fakeLoginAttemptRepository struct {
+	domain.LoginAttemptRepository
+	attempts []*domain.LoginAttempt
+}
+
+func (r *fakeLoginAttemptRepository) StartLoginAttempt(_ context.Context, attempt *domain.LoginAttempt, since time.Time, maxFailures int) error {
+	failures := 1 // The attempt being started
+	for _, earlier := range r.attempts {
+		if earlier.IPAddress == attempt.IPAddress && !earlier.Succeeded && earlier.CreatedAt.After(since) {
+			failures++
+		}
+	}
+	if failures > maxFailures {
+		return constants.ErrTooManyLoginAttempts
+	}
+
+	attempt.ID = uint64(len(r.attempts) + 1)
+	copied := *attempt
+	r.attempts = append(r.attempts, &copied)
+	return nil
+}
+
+func (r *fakeLoginAttemptRepository) MarkLoginAttemptSucceeded(_ context.Context, id uint64) error {
+	r.attempts[id-1].Succeeded = true
+	return nil
+}
+
+// fakeRefreshTokenRepository holds refresh tokens by ID.
+type fakeRefreshTokenRepository struct {
+	domain.RefreshTokenRepository
+	tokens map[uint64]*domain.RefreshToken
+	nextID uint64
+
+	// beforeRotate runs at the start of RotateRefreshToken, to simulate a concurrent request
+	beforeRotate func()
+}
+
+func newFakeRefreshTokenRepository() *fakeRefreshTokenRepository {
+	return &fakeRefreshTokenRepository{tokens: make(map[uint64]*domain.RefreshToken)}
+}
+
+func (r *fakeRefreshTokenRepository) CreateRefreshToken(_ context.Context, token *domain.RefreshToken) error {
+	r.nextID++
+	token.ID = r.nextID
+	copied := *token
+	r.tokens[token.ID] = &copied
+	return nil
+}
+
+func (r *fakeRefreshTokenRepository) GetRefreshTokenByHash(_ context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	for _, token := range r.tokens {
+		if token.TokenHash == tokenHash {
+			copied := *token
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeRefreshTokenRepository) RotateRefreshToken(ctx context.Context, usedID uint64, usedAt time.Time, replacement *domain.RefreshToken) error {
+	if r.beforeRotate != nil {
+		r.beforeRotate()
+	}
+	used := r.tokens[usedID]
+	if used.IsUsed() || used.IsRevoked() {
+		return constants.ErrRefreshTokenReused
+	}
+	used.UsedAt = &usedAt
+	return r.CreateRefreshToken(ctx, replacement)
+}
+
+// fakeSessionRepository holds sessions by ID and revokes their refresh tokens with them.
+type fakeSessionRepository struct {
+	domain.SessionRepository
+	sessions      map[string]*domain.Session
+	refreshTokens *fakeRefreshTokenRepository
+}
+
+func newFakeSessionRepository(refreshTokens *fakeRefreshTokenRepository) *fakeSessionRepository {
+	return &fakeSessionRepository{sessions: make(map[string]*domain.Session), refreshTokens: refreshTokens}
+}
+
+func (r *fakeSessionRepository) CreateSession(_ context.Context, session *domain.Session) error {
+	session.ID = fmt.Sprintf("session-%d", len(r.sessions)+1)
+	copied := *session
+	r.sessions[session.ID] = &copied
+	return nil
+}
+
+func (r *fakeSessionRepository) GetActiveSessionsByUserID(_ context.Context, userID string, now time.Time) ([]domain.Session, error) {
+	var sessions []domain.Session
+	for _, session := range r.sessions {
+		if session.UserID == userID && session.IsActive(now) {
+			sessions = append(sessions, *session)
+		}
+	}
+	return sessions, nil
+}
+
+func (r *fakeSessionRepository) TouchSession(_ context.Context, id string, lastSeenAt, expiresAt time.Time) error {
+	r.sessions[id].LastSeenAt = lastSeenAt
+	r.sessions[id].ExpiresAt = expiresAt
+	return nil
+}
+
+func (r *fakeSessionRepository) RevokeSessions(_ context.Context, ids []string, revokedAt time.Time) error {
+	for _, id := range ids {
+		if session, ok := r.sessions[id]; ok {
+			session.RevokedAt = &revokedAt
+		}
+		for _, token := range r.refreshTokens.tokens {
+			if token.FamilyID == id && token.RevokedAt == nil {
+				token.RevokedAt = &revokedAt
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// IdentityDomainService signs users in with identities from external OpenID Connect providers.
+// An identity is matched by provider and subject; a new identity is linked to the user with
+// the same email, so the same person can sign in with several providers.
+type IdentityDomainService struct {
+	identityRepository   domain.UserIdentityRepository
+	userRepository       domain.UserRepository
+	roleRepository       domain.RoleRepository
+	credentialRepository domain.CredentialRepository
+	isEmailDomainAllowed func(ctx context.Context, email string) (bool, error)
+}
+
+// NewIdentityDomainService creates a new IdentityDomainService instance.
+// isEmailDomainAllowed decides whether a new account may be created for an email.
+func NewIdentityDomainService(
+	identityRepository domain.UserIdentityRepository,
+	userRepository domain.UserRepository,
+	roleRepository domain.RoleRepository,
+	credentialRepository domain.CredentialRepository,
+	isEmailDomainAllowed func(ctx context.Context, email string) (bool, error),
+) *IdentityDomainService {
+	return &IdentityDomainService{
+		identityRepository:   identityRepository,
+		userRepository:       userRepository,
+		roleRepository:       roleRepository,
+		credentialRepository: credentialRepository,
+		isEmailDomainAllowed: isEmailDomainAllowed,
+	}
+}
+
+// SignIn returns the user the external identity belongs to. Unknown identities are linked
+// to the user with the same email, or a new user is created for them. Linking and creation
+// require an email the provider has verified, otherwise anyone able to register that email
+// at some provider could take over the account.
+func (s *IdentityDomainService) SignIn(ctx context.Context, external *domain.ExternalIdentity) (*domain.IdentitySignIn, error) {
+	if external == nil || !external.IsValid() {
+		return nil, constants.ErrInvalidData
+	}
+
+	now := time.Now()
+	identity, err := s.identityRepository.GetIdentity(ctx, external.Provider, external.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user identity: %w", err)
+	}
+	if identity != nil {
+		user, err := s.userRepository.GetUserByID(ctx, identity.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user by ID: %w", err)
+		}
+		if user == nil {
+			return nil, constants.ErrRecordNotFound
+		}
+		if err := s.identityRepository.TouchIdentity(ctx, identity.ID, now); err != nil {
+			return nil, err
+		}
+		return &domain.IdentitySignIn{User: user}, nil
+	}
+
+	if !external.EmailVerified {
+		return nil, constants.ErrEmailNotVerified
+	}
+
+	email := external.NormalizedEmail()
+	result := &domain.IdentitySignIn{}
+	user, err := s.userRepository.GetUserByUsername(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by username: %w", err)
+	}
+
+	if user != nil {
+		// A password set before the email was proven may belong to someone who registered
+		// the address in advance, so the verified owner takes the account over without it
+		if err := s.credentialRepository.DeletePasswordCredential(ctx, user.ID); err != nil {
+			return nil, err
+		}
+		result.Linked = true
+	} else {
+		if user, err = s.createUser(ctx, external, email); err != nil {
+			return nil, err
+		}
+		result.Created = true
+	}
+
+	err = s.identityRepository.CreateIdentity(ctx, &domain.UserIdentity{
+		UserID:      user.ID,
+		Provider:    external.Provider,
+		Subject:     external.Subject,
+		Email:       email,
+		LastLoginAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.User = user
+	return result, nil
+}
+
+// createUser registers a new user with the default role for the identity.
+func (s *IdentityDomainService) createUser(ctx context.Context, external *domain.ExternalIdentity, email string) (*domain.User, error) {
+	allowed, err := s.isEmailDomainAllowed(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check email domain: %w", err)
+	}
+	if !allowed {
+		return nil, constants.ErrEmailDomainNotAllowed
+	}
+
+	defaultRole, err := s.roleRepository.GetRoleByName(ctx, constants.RoleDefault)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default role: %w", err)
+	}
+	if defaultRole == nil {
+		return nil, constants.ErrRecordNotFound
+	}
+
+	user := &domain.User{
+		Username:   email,
+		Name:       external.Name,
+		LastName:   external.LastName,
+		ImgProfile: external.Picture,
+		RoleID:     defaultRole.ID,
+	}
+	if !user.IsValid() {
+		return nil, constants.ErrInvalidData
+	}
+
+	if err := s.userRepository.CreateUser(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	// Reload the user so the role is populated like for existing users
+	created, err := s.userRepository.GetUserByID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if created == nil {
+		return nil, constants.ErrRecordNotFound
+	}
+	return created, nil
+}
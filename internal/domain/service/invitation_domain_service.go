@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// InvitationDomainService lets administrators invite people by email with a role and team
+// decided in advance. The invitee accepts by signing in with any provider; the identity is
+// bound to the new account even when its email differs from the invited one, since only
+// the owner of the invited mailbox received the token.
+type InvitationDomainService struct {
+	invitationRepository domain.InvitationRepository
+	userRepository       domain.UserRepository
+	roleRepository       domain.RoleRepository
+	teamRepository       domain.TeamRepository
+	identityRepository   domain.UserIdentityRepository
+	authRepository       domain.AuthenticationRepository
+	mailSender           domain.MailSender
+	isEmailDomainAllowed func(ctx context.Context, email string) (bool, error)
+	invitationTTL        time.Duration
+	acceptURL            string // Page of the web app that accepts an invitation token
+	auditor              domain.Auditor
+}
+
+// NewInvitationDomainService creates a new InvitationDomainService instance.
+// isEmailDomainAllowed decides whether an account may be created for the invited email.
+func NewInvitationDomainService(
+	invitationRepository domain.InvitationRepository,
+	userRepository domain.UserRepository,
+	roleRepository domain.RoleRepository,
+	teamRepository domain.TeamRepository,
+	identityRepository domain.UserIdentityRepository,
+	authRepository domain.AuthenticationRepository,
+	mailSender domain.MailSender,
+	isEmailDomainAllowed func(ctx context.Context, email string) (bool, error),
+	invitationTTL time.Duration,
+	acceptURL string,
+	auditor domain.Auditor,
+) *InvitationDomainService {
+	return &InvitationDomainService{
+		invitationRepository: invitationRepository,
+		userRepository:       userRepository,
+		roleRepository:       roleRepository,
+		teamRepository:       teamRepository,
+		identityRepository:   identityRepository,
+		authRepository:       authRepository,
+		mailSender:           mailSender,
+		isEmailDomainAllowed: isEmailDomainAllowed,
+		invitationTTL:        invitationTTL,
+		acceptURL:            acceptURL,
+		auditor:              auditor,
+	}
+}
+
+// CreateInvitation stores the invitation and emails its link to the invitee. The pending
+// invitations for the same email are revoked. The email domain must be allowed. When the
+// email cannot be sent the invitation is removed again and constants.ErrMailNotSent is returned.
+func (s *InvitationDomainService) CreateInvitation(ctx context.Context, invitation *domain.Invitation) (*domain.Invitation, error) {
+	invitation.Email = invitation.NormalizedEmail()
+
+	allowed, err := s.isEmailDomainAllowed(ctx, invitation.Email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check email domain: %w", err)
+	}
+	if !allowed {
+		return nil, constants.ErrEmailDomainNotAllowed
+	}
+
+	existing, err := s.userRepository.GetUserByUsername(ctx, invitation.Email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by username: %w", err)
+	}
+	if existing != nil {
+		return nil, constants.ErrRecordAlreadyExists
+	}
+
+	role, err := s.roleRepository.GetRoleByID(ctx, invitation.RoleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role by ID: %w", err)
+	}
+	if role == nil {
+		return nil, constants.ErrRecordNotFound
+	}
+
+	var team *domain.Team
+	if invitation.TeamID != nil {
+		if team, err = s.teamRepository.GetTeamByID(ctx, *invitation.TeamID); err != nil {
+			return nil, fmt.Errorf("failed to get team by ID: %w", err)
+		}
+		if team == nil {
+			return nil, constants.ErrTeamNotFound
+		}
+	}
+
+	rawToken, tokenHash, err := s.authRepository.GenerateInvitationToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate invitation token: %w", err)
+	}
+	invitation.TokenHash = tokenHash
+	invitation.ExpiresAt = time.Now().Add(s.invitationTTL)
+	if !invitation.IsValid() {
+		return nil, constants.ErrInvalidData
+	}
+
+	if err := s.invitationRepository.CreateInvitation(ctx, invitation); err != nil {
+		return nil, err
+	}
+	invitation.Role = role
+	invitation.Team = team
+
+	if err := s.mailSender.Send(ctx, s.invitationMessage(invitation, rawToken)); err != nil {
+		if deleteErr := s.invitationRepository.DeleteInvitation(ctx, invitation.ID); deleteErr != nil {
+			return nil, deleteErr
+		}
+		return nil, fmt.Errorf("%w: %v", constants.ErrMailNotSent, err)
+	}
+	s.auditor.Record(ctx, domain.AuditActionCreate, domain.AuditEntityInvitation, invitation.ID, nil, invitation)
+	return invitation, nil
+}
+
+// GetInvitationByID retrieves an invitation by ID.
+func (s *InvitationDomainService) GetInvitationByID(ctx context.Context, id uint64) (*domain.Invitation, error) {
+	invitation, err := s.invitationRepository.GetInvitationByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if invitation == nil {
+		return nil, constants.ErrInvitationNotFound
+	}
+	return invitation, nil
+}
+
+// GetPaginatedInvitations retrieves paginated invitations matching the filter, newest first.
+func (s *InvitationDomainService) GetPaginatedInvitations(ctx context.Context, filter domain.InvitationFilter, page int, pageSize int) ([]domain.Invitation, int64, error) {
+	if filter.Status != "" && !domain.IsValidInvitationStatus(filter.Status) {
+		return nil, 0, constants.ErrInvalidData
+	}
+	filter.Email = (&domain.Invitation{Email: filter.Email}).NormalizedEmail()
+	return s.invitationRepository.GetPaginatedInvitations(ctx, filter, time.Now(), page, pageSize)
+}
+
+// RevokeInvitation makes a pending invitation unusable.
+func (s *InvitationDomainService) RevokeInvitation(ctx context.Context, id uint64) error {
+	invitation, err := s.GetInvitationByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	revokedAt := time.Now()
+	if err := s.invitationRepository.RevokeInvitation(ctx, id, revokedAt); err != nil {
+		return err
+	}
+
+	revokedInvitation := *invitation
+	revokedInvitation.RevokedAt = &revokedAt
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityInvitation, id, invitation, &revokedInvitation)
+	return nil
+}
+
+// GetUsableInvitation returns the invitation of a token that can still be accepted, or
+// constants.ErrInvitationNotUsable for unknown, accepted, revoked and expired tokens.
+func (s *InvitationDomainService) GetUsableInvitation(ctx context.Context, rawToken string) (*domain.Invitation, error) {
+	if rawToken == "" {
+		return nil, constants.ErrInvitationNotUsable
+	}
+
+	invitation, err := s.invitationRepository.GetInvitationByTokenHash(ctx, s.authRepository.HashInvitationToken(rawToken))
+	if err != nil {
+		return nil, err
+	}
+	if invitation == nil || !invitation.IsUsable(time.Now()) {
+		return nil, constants.ErrInvitationNotUsable
+	}
+	return invitation, nil
+}
+
+// AcceptInvitation creates the invited user with the invitation role and team and binds the
+// external identity to it. The identity must not be linked to another user yet.
+func (s *InvitationDomainService) AcceptInvitation(ctx context.Context, invitationID uint64, external *domain.ExternalIdentity) (*domain.IdentitySignIn, error) {
+	if external == nil || !external.IsValid() {
+		return nil, constants.ErrInvalidData
+	}
+
+	now := time.Now()
+	invitation, err := s.invitationRepository.GetInvitationByID(ctx, invitationID)
+	if err != nil {
+		return nil, err
+	}
+	if invitation == nil || !invitation.IsUsable(now) {
+		return nil, constants.ErrInvitationNotUsable
+	}
+
+	identity, err := s.identityRepository.GetIdentity(ctx, external.Provider, external.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user identity: %w", err)
+	}
+	if identity != nil {
+		return nil, constants.ErrIdentityAlreadyLinked
+	}
+
+	existing, err := s.userRepository.GetUserByUsername(ctx, invitation.Email)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by username: %w", err)
+	}
+	if existing != nil {
+		return nil, constants.ErrRecordAlreadyExists
+	}
+
+	user := &domain.User{
+		Username:   invitation.Email,
+		Name:       external.Name,
+		LastName:   external.LastName,
+		ImgProfile: external.Picture,
+		RoleID:     invitation.RoleID,
+		TeamID:     invitation.TeamID,
+	}
+	if !user.IsValid() {
+		return nil, constants.ErrInvalidData
+	}
+
+	err = s.invitationRepository.AcceptInvitation(ctx, invitation.ID, user, &domain.UserIdentity{
+		Provider:    external.Provider,
+		Subject:     external.Subject,
+		Email:       external.NormalizedEmail(),
+		LastLoginAt: now,
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reload the user so the role is populated like for existing users
+	created, err := s.userRepository.GetUserByID(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if created == nil {
+		return nil, constants.ErrRecordNotFound
+	}
+	return &domain.IdentitySignIn{User: created, Created: true}, nil
+}
+
+// invitationMessage builds the email carrying the invitation link.
+func (s *InvitationDomainService) invitationMessage(invitation *domain.Invitation, rawToken string) *domain.MailMessage {
+	body := "You have been invited to join Browers FC"
+	if invitation.Role != nil {
+		body += " as " + invitation.Role.Name
+	}
+	if invitation.Team != nil {
+		body += " of " + invitation.Team.FullName
+	}
+	body += ".\n\nOpen the link below and sign in with any of the supported accounts to accept the invitation:\n\n" +
+		s.acceptURL + "?token=" + url.QueryEscape(rawToken) + "\n\n" +
+		"The link can only be used once and expires on " + invitation.ExpiresAt.UTC().Format("2 January 2006 at 15:04 MST") + ".\n" +
+		"If you were not expecting this invitation you can ignore this email.\n"
+
+	return &domain.MailMessage{
+		To:      invitation.Email,
+		Subject: "Your invitation to Browers FC",
+		Body:    body,
+	}
+}
@@ -11,17 +11,20 @@ type LineupDomainService struct {
 	lineupRepository domain.LineupRepository
 	matchRepository  domain.MatchRepository
 	playerRepository domain.PlayerRepository
+	auditor          domain.Auditor
 }
 
 func NewLineupDomainService(
 	lineupRepository domain.LineupRepository,
 	matchRepository domain.MatchRepository,
 	playerRepository domain.PlayerRepository,
+	auditor domain.Auditor,
 ) *LineupDomainService {
 	return &LineupDomainService{
 		lineupRepository: lineupRepository,
 		matchRepository:  matchRepository,
 		playerRepository: playerRepository,
+		auditor:          auditor,
 	}
 }
 
@@ -49,7 +52,12 @@ func (s *LineupDomainService) CreateLineup(ctx context.Context, lineup *domain.L
 		return constants.ErrPlayerNotFound
 	}
 
-	return s.lineupRepository.CreateLineup(ctx, lineup)
+	if err := s.lineupRepository.CreateLineup(ctx, lineup); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionCreate, domain.AuditEntityLineup, lineup.ID, nil, lineup)
+	return nil
 }
 
 func (s *LineupDomainService) GetLineupByID(ctx context.Context, id uint64) (*domain.Lineup, error) {
@@ -86,7 +94,18 @@ func (s *LineupDomainService) UpdateLineup(ctx context.Context, id uint64, lineu
 		return constants.ErrLineupNotFound
 	}
 
-	return s.lineupRepository.UpdateLineup(ctx, id, lineup)
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existing.Version) {
+		return constants.ErrVersionMismatch
+	}
+	lineup.Version = existing.Version
+
+	if err := s.lineupRepository.UpdateLineup(ctx, id, lineup); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityLineup, id, existing, lineup)
+	return nil
 }
 
 func (s *LineupDomainService) DeleteLineup(ctx context.Context, id uint64) error {
@@ -103,7 +122,17 @@ func (s *LineupDomainService) DeleteLineup(ctx context.Context, id uint64) error
 		return constants.ErrLineupNotFound
 	}
 
-	return s.lineupRepository.DeleteLineup(ctx, id)
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existing.Version) {
+		return constants.ErrVersionMismatch
+	}
+
+	if err := s.lineupRepository.DeleteLineup(ctx, id); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionDelete, domain.AuditEntityLineup, id, existing, nil)
+	return nil
 }
 
 func (s *LineupDomainService) GetPaginatedLineups(ctx context.Context, sort string, order string, page int, pageSize int) ([]domain.Lineup, int64, error) {
@@ -9,18 +9,30 @@ import (
 
 type MatchDomainService struct {
 	matchRepository domain.MatchRepository
+	venueRepository domain.VenueRepository
+	resultPublisher *MatchResultPublisher
+	auditor         domain.Auditor
 }
 
-func NewMatchDomainService(matchRepository domain.MatchRepository) *MatchDomainService {
+func NewMatchDomainService(matchRepository domain.MatchRepository, venueRepository domain.VenueRepository, resultPublisher *MatchResultPublisher, auditor domain.Auditor) *MatchDomainService {
 	return &MatchDomainService{
 		matchRepository: matchRepository,
+		venueRepository: venueRepository,
+		resultPublisher: resultPublisher,
+		auditor:         auditor,
 	}
 }
 
 func (s *MatchDomainService) CreateMatch(ctx context.Context, match *domain.Match) (*domain.Match, error) {
+	if err := s.ensureVenueExists(ctx, match.VenueID); err != nil {
+		return nil, err
+	}
+
 	if err := s.matchRepository.CreateMatch(ctx, match); err != nil {
 		return nil, err
 	}
+
+	s.auditor.Record(ctx, domain.AuditActionCreate, domain.AuditEntityMatch, match.ID, nil, match)
 	return match, nil
 }
 
@@ -62,6 +74,14 @@ func (s *MatchDomainService) GetMatchesByTeamID(ctx context.Context, teamID uint
 	return s.matchRepository.GetMatchesByTeamID(ctx, teamID, sort, order, page, pageSize)
 }
 
+// GetMatchesByVenueID retrieves matches played at a specific venue
+func (s *MatchDomainService) GetMatchesByVenueID(ctx context.Context, venueID uint64, sort string, order string, page int, pageSize int) ([]domain.Match, int64, error) {
+	if err := s.ensureVenueExists(ctx, &venueID); err != nil {
+		return nil, 0, err
+	}
+	return s.matchRepository.GetMatchesByVenueID(ctx, venueID, sort, order, page, pageSize)
+}
+
 // GetNextMatchByTeamID retrieves the next scheduled match for a team
 func (s *MatchDomainService) GetNextMatchByTeamID(ctx context.Context, teamID uint64) (*domain.Match, error) {
 	return s.matchRepository.GetNextMatchByTeamID(ctx, teamID)
@@ -77,12 +97,30 @@ func (s *MatchDomainService) UpdateMatch(ctx context.Context, id uint64, match *
 		return nil, constants.ErrRecordNotFound
 	}
 
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingMatch.Version) {
+		return nil, constants.ErrVersionMismatch
+	}
+	match.Version = existingMatch.Version
+
+	if err := s.ensureVenueExists(ctx, match.VenueID); err != nil {
+		return nil, err
+	}
+
 	if err := s.matchRepository.UpdateMatch(ctx, id, match); err != nil {
 		return nil, err
 	}
 
-	// Return the updated match
-	return s.matchRepository.GetMatchByID(ctx, id)
+	updatedMatch, err := s.matchRepository.GetMatchByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityMatch, id, existingMatch, updatedMatch)
+
+	// Notify dependents (e.g. predictions) once the result is final
+	s.resultPublisher.PublishMatchCompleted(ctx, updatedMatch)
+
+	return updatedMatch, nil
 }
 
 // DeleteMatch deletes a match by its ID
@@ -96,5 +134,31 @@ func (s *MatchDomainService) DeleteMatch(ctx context.Context, id uint64) error {
 		return constants.ErrRecordNotFound
 	}
 
-	return s.matchRepository.DeleteMatch(ctx, id)
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingMatch.Version) {
+		return constants.ErrVersionMismatch
+	}
+
+	if err := s.matchRepository.DeleteMatch(ctx, id); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionDelete, domain.AuditEntityMatch, id, existingMatch, nil)
+	return nil
+}
+
+// ensureVenueExists verifies that a referenced venue is present
+func (s *MatchDomainService) ensureVenueExists(ctx context.Context, venueID *uint64) error {
+	if venueID == nil {
+		return nil
+	}
+
+	venue, err := s.venueRepository.GetVenueByID(ctx, *venueID)
+	if err != nil {
+		return err
+	}
+	if venue == nil {
+		return constants.ErrVenueNotFound
+	}
+	return nil
 }
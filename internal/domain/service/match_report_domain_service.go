@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// MatchReportDomainService encapsulates the post-match report workflow:
+// submission by officials, resubmission after rejection and admin review.
+type MatchReportDomainService struct {
+	matchReportRepository domain.MatchReportRepository
+	matchRepository       domain.MatchRepository
+	playerRepository      domain.PlayerRepository
+	resultPublisher       *MatchResultPublisher
+	auditor               domain.Auditor
+}
+
+// NewMatchReportDomainService creates a new MatchReportDomainService instance.
+func NewMatchReportDomainService(matchReportRepository domain.MatchReportRepository, matchRepository domain.MatchRepository, playerRepository domain.PlayerRepository, resultPublisher *MatchResultPublisher, auditor domain.Auditor) *MatchReportDomainService {
+	return &MatchReportDomainService{
+		matchReportRepository: matchReportRepository,
+		matchRepository:       matchRepository,
+		playerRepository:      playerRepository,
+		resultPublisher:       resultPublisher,
+		auditor:               auditor,
+	}
+}
+
+// SubmitReport creates a pending report for a completed match.
+func (s *MatchReportDomainService) SubmitReport(ctx context.Context, report *domain.MatchReport) (*domain.MatchReport, error) {
+	match, err := s.getCompletedMatch(ctx, report.MatchID)
+	if err != nil {
+		return nil, err
+	}
+
+	approved, err := s.matchReportRepository.HasApprovedReport(ctx, match.ID)
+	if err != nil {
+		return nil, err
+	}
+	if approved {
+		return nil, constants.ErrMatchReportApproved
+	}
+
+	report.Status = domain.MatchReportStatusPending
+	report.ReviewComments = ""
+	report.ReviewedByID = nil
+	report.ReviewedAt = nil
+	if err := s.validateReport(ctx, report, match); err != nil {
+		return nil, err
+	}
+
+	if err := s.matchReportRepository.CreateMatchReport(ctx, report); err != nil {
+		return nil, err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionCreate, domain.AuditEntityMatchReport, report.ID, nil, report)
+	return s.GetReportByID(ctx, report.ID)
+}
+
+// GetReportByID retrieves a match report by ID.
+func (s *MatchReportDomainService) GetReportByID(ctx context.Context, id uint64) (*domain.MatchReport, error) {
+	if id == 0 {
+		return nil, constants.ErrInvalidID
+	}
+
+	report, err := s.matchReportRepository.GetMatchReportByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match report by ID: %w", err)
+	}
+	if report == nil {
+		return nil, constants.ErrMatchReportNotFound
+	}
+
+	return report, nil
+}
+
+// GetPaginatedReports retrieves paginated reports matching the filter.
+func (s *MatchReportDomainService) GetPaginatedReports(ctx context.Context, filter domain.MatchReportFilter, sort string, order string, page int, pageSize int) ([]domain.MatchReport, int64, error) {
+	if filter.Status != "" && !domain.IsValidMatchReportStatus(filter.Status) {
+		return nil, 0, constants.ErrInvalidData
+	}
+	return s.matchReportRepository.GetPaginatedMatchReports(ctx, filter, sort, order, page, pageSize)
+}
+
+// ResubmitReport replaces the content of a pending or rejected report and
+// puts it back in the review queue. Only the original submitter may do so.
+func (s *MatchReportDomainService) ResubmitReport(ctx context.Context, id uint64, userID string, updates *domain.MatchReport) (*domain.MatchReport, error) {
+	existingReport, err := s.GetReportByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if existingReport.SubmittedByID != userID {
+		return nil, constants.ErrForbidden
+	}
+	if !existingReport.CanBeEditedBy(userID) {
+		return nil, constants.ErrMatchReportApproved
+	}
+
+	match, err := s.getCompletedMatch(ctx, existingReport.MatchID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := domain.AuditSnapshot(existingReport)
+	existingReport.Status = domain.MatchReportStatusPending
+	existingReport.HomeGoals = updates.HomeGoals
+	existingReport.AwayGoals = updates.AwayGoals
+	existingReport.Attendance = updates.Attendance
+	existingReport.Incidents = updates.Incidents
+	existingReport.Events = updates.Events
+	existingReport.ReviewedByID = nil
+	existingReport.ReviewedAt = nil
+	if err := s.validateReport(ctx, existingReport, match); err != nil {
+		return nil, err
+	}
+
+	if err := s.matchReportRepository.UpdateMatchReport(ctx, existingReport); err != nil {
+		return nil, err
+	}
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityMatchReport, id, before, existingReport)
+
+	return s.GetReportByID(ctx, id)
+}
+
+// ApproveReport accepts a pending report and applies its result to the match,
+// the player statistics and the season standings atomically.
+func (s *MatchReportDomainService) ApproveReport(ctx context.Context, id uint64, reviewerID string, comments string) (*domain.MatchReport, error) {
+	report, err := s.GetReportByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !report.IsPending() {
+		return nil, constants.ErrMatchReportNotPending
+	}
+
+	match, err := s.getCompletedMatch(ctx, report.MatchID)
+	if err != nil {
+		return nil, err
+	}
+	if !report.ConsistentWith(match) {
+		return nil, constants.ErrMatchReportInconsistent
+	}
+
+	// Business rule: a match has at most one approved report
+	approved, err := s.matchReportRepository.HasApprovedReport(ctx, match.ID)
+	if err != nil {
+		return nil, err
+	}
+	if approved {
+		return nil, constants.ErrMatchReportApproved
+	}
+
+	before := domain.AuditSnapshot(report)
+	report.ReviewComments = strings.TrimSpace(comments)
+	approval := report.BuildApproval(match, reviewerID, time.Now())
+	if err := s.matchReportRepository.ApproveMatchReport(ctx, approval); err != nil {
+		return nil, err
+	}
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityMatchReport, id, before, approval.Report)
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityMatch, match.ID, match, approval.Match)
+
+	// The approved score is the official result
+	s.resultPublisher.PublishMatchCompleted(ctx, approval.Match)
+
+	return s.GetReportByID(ctx, id)
+}
+
+// RejectReport sends a pending report back to its submitter with review comments.
+func (s *MatchReportDomainService) RejectReport(ctx context.Context, id uint64, reviewerID string, comments string) (*domain.MatchReport, error) {
+	comments = strings.TrimSpace(comments)
+	if comments == "" || len(comments) > 2000 {
+		return nil, constants.ErrInvalidData
+	}
+
+	report, err := s.GetReportByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !report.IsPending() {
+		return nil, constants.ErrMatchReportNotPending
+	}
+
+	before := domain.AuditSnapshot(report)
+	reviewedAt := time.Now()
+	report.ReviewComments = comments
+	report.ReviewedByID = &reviewerID
+	report.ReviewedAt = &reviewedAt
+	if err := s.matchReportRepository.RejectMatchReport(ctx, report); err != nil {
+		return nil, err
+	}
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityMatchReport, id, before, report)
+
+	return s.GetReportByID(ctx, id)
+}
+
+// getCompletedMatch loads the match and ensures it has reached full time
+func (s *MatchReportDomainService) getCompletedMatch(ctx context.Context, matchID uint64) (*domain.Match, error) {
+	match, err := s.matchRepository.GetMatchByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if match == nil {
+		return nil, constants.ErrMatchNotFound
+	}
+	if !match.IsCompleted() {
+		return nil, constants.ErrMatchNotCompleted
+	}
+	return match, nil
+}
+
+// validateReport checks the report content and that every referenced player exists
+func (s *MatchReportDomainService) validateReport(ctx context.Context, report *domain.MatchReport, match *domain.Match) error {
+	if !report.IsValid() {
+		return constants.ErrInvalidData
+	}
+	if !report.ConsistentWith(match) {
+		return constants.ErrMatchReportInconsistent
+	}
+
+	checked := make(map[uint64]bool)
+	for _, event := range report.Events {
+		playerIDs := []uint64{event.PlayerID}
+		if event.AssistPlayerID != nil {
+			playerIDs = append(playerIDs, *event.AssistPlayerID)
+		}
+
+		for _, playerID := range playerIDs {
+			if checked[playerID] {
+				continue
+			}
+			player, err := s.playerRepository.GetPlayerByID(ctx, playerID)
+			if err != nil {
+				return fmt.Errorf("failed to check player: %w", err)
+			}
+			if player == nil {
+				return constants.ErrPlayerNotFound
+			}
+			checked[playerID] = true
+		}
+	}
+
+	return nil
+}
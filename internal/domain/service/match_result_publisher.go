@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/pkg/logger"
+)
+
+// MatchResultListener reacts to a match reaching, or correcting, its final result.
+// Listeners must be idempotent since the same match can be published more than once.
+type MatchResultListener interface {
+	OnMatchCompleted(ctx context.Context, match *domain.Match) error
+}
+
+// MatchResultPublisher notifies the registered listeners when a match is completed.
+type MatchResultPublisher struct {
+	listeners []MatchResultListener
+}
+
+// NewMatchResultPublisher creates a publisher without listeners.
+func NewMatchResultPublisher() *MatchResultPublisher {
+	return &MatchResultPublisher{}
+}
+
+// Subscribe registers a listener. It is meant to be called during application wiring.
+func (p *MatchResultPublisher) Subscribe(listener MatchResultListener) {
+	p.listeners = append(p.listeners, listener)
+}
+
+// PublishMatchCompleted calls every listener once the result is stored. Failures are logged
+// rather than returned, since the result stays committed either way; saving the match again
+// publishes it again. A failing listener does not prevent the remaining ones from running.
+func (p *MatchResultPublisher) PublishMatchCompleted(ctx context.Context, match *domain.Match) {
+	if p == nil || match == nil || !match.IsCompleted() {
+		return
+	}
+
+	for _, listener := range p.listeners {
+		if err := listener.OnMatchCompleted(ctx, match); err != nil {
+			logger.Error(ctx, "failed to process match result", "match_id", match.ID, "listener", fmt.Sprintf("%T", listener), "error", err)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// MVPVoteDomainService runs the fan vote for the most valuable player of each match.
+// A poll opens when the match is completed and is closed by CloseExpiredPolls.
+type MVPVoteDomainService struct {
+	mvpVoteRepository domain.MVPVoteRepository
+	matchRepository   domain.MatchRepository
+	lineupRepository  domain.LineupRepository
+	votingWindow      time.Duration
+}
+
+// NewMVPVoteDomainService creates a new MVPVoteDomainService instance.
+func NewMVPVoteDomainService(mvpVoteRepository domain.MVPVoteRepository, matchRepository domain.MatchRepository, lineupRepository domain.LineupRepository, votingWindow time.Duration) *MVPVoteDomainService {
+	return &MVPVoteDomainService{
+		mvpVoteRepository: mvpVoteRepository,
+		matchRepository:   matchRepository,
+		lineupRepository:  lineupRepository,
+		votingWindow:      votingWindow,
+	}
+}
+
+// OnMatchCompleted opens the MVP poll of the match. Polls are only opened once,
+// so result corrections do not extend the voting window.
+func (s *MVPVoteDomainService) OnMatchCompleted(ctx context.Context, match *domain.Match) error {
+	poll := domain.NewMVPPoll(match.ID, time.Now(), s.votingWindow)
+	return s.mvpVoteRepository.CreatePoll(ctx, poll)
+}
+
+// CastVote records the user's vote for a player who appeared in the match lineups.
+func (s *MVPVoteDomainService) CastVote(ctx context.Context, matchID uint64, userID string, playerID uint64) (*domain.MVPVote, error) {
+	poll, err := s.getPoll(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if !poll.IsOpenAt(time.Now()) {
+		return nil, constants.ErrMVPVotingClosed
+	}
+
+	candidates, err := s.getCandidates(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := candidates[playerID]; !ok {
+		return nil, constants.ErrPlayerNotInLineup
+	}
+
+	vote := &domain.MVPVote{
+		MatchID:  matchID,
+		UserID:   userID,
+		PlayerID: playerID,
+	}
+	if err := s.mvpVoteRepository.CreateVote(ctx, vote); err != nil {
+		return nil, err
+	}
+
+	return vote, nil
+}
+
+// GetPollResults returns the candidates of the match poll and the user's own vote.
+func (s *MVPVoteDomainService) GetPollResults(ctx context.Context, matchID uint64, userID string) (*domain.MVPPollResults, error) {
+	poll, err := s.getPoll(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	lineups, err := s.lineupRepository.GetLineupsByMatchID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := &domain.MVPPollResults{
+		Poll:          poll,
+		VotesRevealed: poll.IsClosed(),
+	}
+
+	votesByPlayer := make(map[uint64]uint64)
+	if results.VotesRevealed {
+		tallies, err := s.mvpVoteRepository.GetTalliesByMatchID(ctx, matchID)
+		if err != nil {
+			return nil, err
+		}
+		for _, tally := range tallies {
+			votesByPlayer[tally.PlayerID] = tally.Votes
+		}
+	}
+
+	seen := make(map[uint64]bool)
+	for _, lineup := range lineups {
+		if lineup.Player == nil || seen[lineup.PlayerID] {
+			continue
+		}
+		seen[lineup.PlayerID] = true
+		results.Candidates = append(results.Candidates, domain.MVPCandidate{
+			Player: lineup.Player,
+			Votes:  votesByPlayer[lineup.PlayerID],
+		})
+	}
+
+	if userID != "" {
+		results.UserVote, err = s.mvpVoteRepository.GetVoteByUserAndMatch(ctx, userID, matchID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+// CloseExpiredPolls closes every poll whose window has ended and applies the winner.
+// It returns the number of polls closed; failures on one poll do not stop the others.
+func (s *MVPVoteDomainService) CloseExpiredPolls(ctx context.Context) (int, error) {
+	now := time.Now()
+	polls, err := s.mvpVoteRepository.GetPollsToClose(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	closed := 0
+	var errs []error
+	for i := range polls {
+		if err := s.closePoll(ctx, &polls[i], now); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close MVP poll for match %d: %w", polls[i].MatchID, err))
+			continue
+		}
+		closed++
+	}
+
+	return closed, errors.Join(errs...)
+}
+
+// closePoll determines the winner and persists the result
+func (s *MVPVoteDomainService) closePoll(ctx context.Context, poll *domain.MVPPoll, now time.Time) error {
+	match, err := s.matchRepository.GetMatchByID(ctx, poll.MatchID)
+	if err != nil {
+		return err
+	}
+	if match == nil {
+		return constants.ErrMatchNotFound
+	}
+
+	tallies, err := s.mvpVoteRepository.GetTalliesByMatchID(ctx, poll.MatchID)
+	if err != nil {
+		return err
+	}
+
+	poll.ClosedAt = &now
+	poll.WinnerPlayerID = domain.MVPWinner(tallies)
+	return s.mvpVoteRepository.ClosePoll(ctx, poll, match.SeasonID)
+}
+
+// getPoll loads the poll of the match
+func (s *MVPVoteDomainService) getPoll(ctx context.Context, matchID uint64) (*domain.MVPPoll, error) {
+	poll, err := s.mvpVoteRepository.GetPollByMatchID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if poll == nil {
+		return nil, constants.ErrMVPPollNotFound
+	}
+	return poll, nil
+}
+
+// getCandidates returns the IDs of the players who appeared in the match lineups
+func (s *MVPVoteDomainService) getCandidates(ctx context.Context, matchID uint64) (map[uint64]struct{}, error) {
+	lineups, err := s.lineupRepository.GetLineupsByMatchID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make(map[uint64]struct{}, len(lineups))
+	for _, lineup := range lineups {
+		candidates[lineup.PlayerID] = struct{}{}
+	}
+	return candidates, nil
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// otherClaimsRejection is the review comment of the claims left over when a player is linked.
+const otherClaimsRejection = "The player was linked to another account"
+
+// PlayerClaimDomainService encapsulates the claim-your-player workflow: users ask to be
+// linked to a player profile and an administrator approves or rejects the request.
+// Approved users see their own statistics on the player dashboard.
+type PlayerClaimDomainService struct {
+	playerClaimRepository domain.PlayerClaimRepository
+	playerRepository      domain.PlayerRepository
+	userRepository        domain.UserRepository
+	roleRepository        domain.RoleRepository
+	playerStatsRepository domain.PlayerStatsRepository
+	seasonRepository      domain.SeasonRepository
+	auditor               domain.Auditor
+}
+
+// NewPlayerClaimDomainService creates a new PlayerClaimDomainService instance.
+func NewPlayerClaimDomainService(
+	playerClaimRepository domain.PlayerClaimRepository,
+	playerRepository domain.PlayerRepository,
+	userRepository domain.UserRepository,
+	roleRepository domain.RoleRepository,
+	playerStatsRepository domain.PlayerStatsRepository,
+	seasonRepository domain.SeasonRepository,
+	auditor domain.Auditor,
+) *PlayerClaimDomainService {
+	return &PlayerClaimDomainService{
+		playerClaimRepository: playerClaimRepository,
+		playerRepository:      playerRepository,
+		userRepository:        userRepository,
+		roleRepository:        roleRepository,
+		playerStatsRepository: playerStatsRepository,
+		seasonRepository:      seasonRepository,
+		auditor:               auditor,
+	}
+}
+
+// SubmitClaim creates a pending claim of the user for an unlinked player.
+// A user can only have one pending claim and cannot claim a second player.
+func (s *PlayerClaimDomainService) SubmitClaim(ctx context.Context, claim *domain.PlayerClaim) (*domain.PlayerClaim, error) {
+	claim.Message = strings.TrimSpace(claim.Message)
+	claim.Status = domain.PlayerClaimStatusPending
+	if !claim.IsValid() {
+		return nil, constants.ErrInvalidData
+	}
+
+	player, err := s.playerRepository.GetPlayerByID(ctx, claim.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player by ID: %w", err)
+	}
+	if player == nil {
+		return nil, constants.ErrPlayerNotFound
+	}
+	if player.UserID != nil {
+		return nil, constants.ErrPlayerAlreadyLinked
+	}
+
+	if err := s.checkUserUnlinked(ctx, claim.UserID); err != nil {
+		return nil, err
+	}
+	pending, err := s.playerClaimRepository.HasPendingPlayerClaim(ctx, claim.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, constants.ErrPlayerClaimPending
+	}
+
+	if err := s.playerClaimRepository.CreatePlayerClaim(ctx, claim); err != nil {
+		return nil, err
+	}
+	return s.GetClaimByID(ctx, claim.ID)
+}
+
+// GetClaimByID retrieves a player claim by ID.
+func (s *PlayerClaimDomainService) GetClaimByID(ctx context.Context, id uint64) (*domain.PlayerClaim, error) {
+	claim, err := s.playerClaimRepository.GetPlayerClaimByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if claim == nil {
+		return nil, constants.ErrPlayerClaimNotFound
+	}
+	return claim, nil
+}
+
+// GetPaginatedClaims retrieves paginated claims matching the filter.
+func (s *PlayerClaimDomainService) GetPaginatedClaims(ctx context.Context, filter domain.PlayerClaimFilter, page int, pageSize int) ([]domain.PlayerClaim, int64, error) {
+	if filter.Status != "" && !domain.IsValidPlayerClaimStatus(filter.Status) {
+		return nil, 0, constants.ErrInvalidData
+	}
+	return s.playerClaimRepository.GetPaginatedPlayerClaims(ctx, filter, page, pageSize)
+}
+
+// ApproveClaim links the player to the user of a pending claim and rejects the other
+// pending claims for the player. Users with the default role are given the player role;
+// other roles are kept so that staff who also play do not lose their permissions.
+// It reports whether the role changed, since tokens carry the role permissions.
+func (s *PlayerClaimDomainService) ApproveClaim(ctx context.Context, id uint64, reviewerID string, comments string) (*domain.PlayerClaim, bool, error) {
+	claim, err := s.getPendingClaim(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := s.checkUserUnlinked(ctx, claim.UserID); err != nil {
+		return nil, false, err
+	}
+
+	roleID, err := s.playerRoleFor(ctx, claim.UserID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	before := domain.AuditSnapshot(claim)
+	claim.Review(domain.PlayerClaimStatusApproved, reviewerID, strings.TrimSpace(comments), time.Now())
+	if err := s.playerClaimRepository.ApprovePlayerClaim(ctx, claim, roleID, otherClaimsRejection); err != nil {
+		return nil, false, err
+	}
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityPlayerClaim, id, before, claim)
+
+	approved, err := s.GetClaimByID(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+	return approved, roleID != 0, nil
+}
+
+// RejectClaim closes a pending claim with the reviewer's comments, which are required.
+func (s *PlayerClaimDomainService) RejectClaim(ctx context.Context, id uint64, reviewerID string, comments string) (*domain.PlayerClaim, error) {
+	comments = strings.TrimSpace(comments)
+	if comments == "" || len(comments) > 2000 {
+		return nil, constants.ErrInvalidData
+	}
+
+	claim, err := s.getPendingClaim(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	before := domain.AuditSnapshot(claim)
+	claim.Review(domain.PlayerClaimStatusRejected, reviewerID, comments, time.Now())
+	if err := s.playerClaimRepository.RejectPlayerClaim(ctx, claim); err != nil {
+		return nil, err
+	}
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityPlayerClaim, id, before, claim)
+	return s.GetClaimByID(ctx, id)
+}
+
+// GetPlayerDashboard returns the player linked to the user with their statistics split by season.
+func (s *PlayerClaimDomainService) GetPlayerDashboard(ctx context.Context, userID string) (*domain.PlayerDashboard, error) {
+	player, err := s.playerRepository.GetPlayerByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if player == nil {
+		return nil, constants.ErrPlayerNotFound
+	}
+
+	stats, err := s.playerStatsRepository.GetPlayerStatsByPlayerID(ctx, player.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	seasons := make(map[uint64]*domain.Season)
+	for _, stat := range stats {
+		if _, ok := seasons[stat.SeasonID]; ok {
+			continue
+		}
+		season, err := s.seasonRepository.GetSeasonByID(ctx, stat.SeasonID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get season by ID: %w", err)
+		}
+		seasons[stat.SeasonID] = season
+	}
+
+	return domain.BuildPlayerDashboard(player, stats, seasons), nil
+}
+
+// getPendingClaim loads a claim and ensures it is waiting for review
+func (s *PlayerClaimDomainService) getPendingClaim(ctx context.Context, id uint64) (*domain.PlayerClaim, error) {
+	claim, err := s.GetClaimByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !claim.IsPending() {
+		return nil, constants.ErrPlayerClaimNotPending
+	}
+	return claim, nil
+}
+
+// checkUserUnlinked ensures the user is not linked to a player yet
+func (s *PlayerClaimDomainService) checkUserUnlinked(ctx context.Context, userID string) error {
+	linked, err := s.playerRepository.GetPlayerByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if linked != nil {
+		return constants.ErrUserAlreadyLinked
+	}
+	return nil
+}
+
+// playerRoleFor returns the ID of the player role when the user still has the default
+// role, or zero when their role is kept.
+func (s *PlayerClaimDomainService) playerRoleFor(ctx context.Context, userID string) (uint64, error) {
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if user == nil {
+		return 0, constants.ErrRecordNotFound
+	}
+	if user.Role == nil || user.Role.Name != constants.RoleDefault {
+		return 0, nil
+	}
+
+	playerRole, err := s.roleRepository.GetRoleByName(ctx, constants.RolePlayer)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get player role: %w", err)
+	}
+	if playerRole == nil {
+		return 0, constants.ErrRecordNotFound
+	}
+	return playerRole.ID, nil
+}
@@ -12,11 +12,13 @@ import (
 // It contains domain rules and validation while being infrastructure-agnostic.
 type PlayerDomainService struct {
 	playerRepository domain.PlayerRepository
+	auditor          domain.Auditor
 }
 
-func NewPlayerDomainService(playerRepository domain.PlayerRepository) *PlayerDomainService {
+func NewPlayerDomainService(playerRepository domain.PlayerRepository, auditor domain.Auditor) *PlayerDomainService {
 	return &PlayerDomainService{
 		playerRepository: playerRepository,
+		auditor:          auditor,
 	}
 }
 
@@ -35,7 +37,12 @@ func (s *PlayerDomainService) CreatePlayer(ctx context.Context, player *domain.P
 		return constants.ErrRecordAlreadyExists
 	}
 
-	return s.playerRepository.CreatePlayer(ctx, player)
+	if err := s.playerRepository.CreatePlayer(ctx, player); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionCreate, domain.AuditEntityPlayer, player.ID, nil, player)
+	return nil
 }
 
 func (s *PlayerDomainService) GetPlayerByID(ctx context.Context, id uint64) (*domain.Player, error) {
@@ -88,6 +95,13 @@ func (s *PlayerDomainService) UpdatePlayer(ctx context.Context, id uint64, playe
 		return nil, constants.ErrRecordNotFound
 	}
 
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingPlayer.Version) {
+		return nil, constants.ErrVersionMismatch
+	}
+
+	before := domain.AuditSnapshot(existingPlayer)
+
 	// Apply updates to existing player
 	if player.NickName != "" && player.NickName != existingPlayer.NickName {
 		// Check if new nickname already exists
@@ -139,6 +153,28 @@ func (s *PlayerDomainService) UpdatePlayer(ctx context.Context, id uint64, playe
 		return nil, fmt.Errorf("failed to update player: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityPlayer, id, before, existingPlayer)
+	return existingPlayer, nil
+}
+
+// UpdatePlayerAvailability marks the player as injured or available for selection.
+func (s *PlayerDomainService) UpdatePlayerAvailability(ctx context.Context, id uint64, injured bool) (*domain.Player, error) {
+	existingPlayer, err := s.playerRepository.GetPlayerByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player by ID: %w", err)
+	}
+	if existingPlayer == nil {
+		return nil, constants.ErrRecordNotFound
+	}
+
+	before := domain.AuditSnapshot(existingPlayer)
+	existingPlayer.Injured = injured
+	err = s.playerRepository.UpdatePlayer(ctx, id, existingPlayer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update player availability: %w", err)
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityPlayer, id, before, existingPlayer)
 	return existingPlayer, nil
 }
 
@@ -156,5 +192,15 @@ func (s *PlayerDomainService) DeletePlayer(ctx context.Context, id uint64) error
 		return constants.ErrRecordNotFound
 	}
 
-	return s.playerRepository.DeletePlayer(ctx, id)
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingPlayer.Version) {
+		return constants.ErrVersionMismatch
+	}
+
+	if err := s.playerRepository.DeletePlayer(ctx, id); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionDelete, domain.AuditEntityPlayer, id, existingPlayer, nil)
+	return nil
 }
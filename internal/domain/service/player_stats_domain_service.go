@@ -16,6 +16,7 @@ type PlayerStatsDomainService struct {
 	matchRepository       domain.MatchRepository
 	seasonRepository      domain.SeasonRepository
 	teamRepository        domain.TeamRepository
+	auditor               domain.Auditor
 }
 
 func NewPlayerStatsDomainService(
@@ -24,6 +25,7 @@ func NewPlayerStatsDomainService(
 	matchRepository domain.MatchRepository,
 	seasonRepository domain.SeasonRepository,
 	teamRepository domain.TeamRepository,
+	auditor domain.Auditor,
 ) *PlayerStatsDomainService {
 	return &PlayerStatsDomainService{
 		playerStatsRepository: playerStatsRepository,
@@ -31,6 +33,7 @@ func NewPlayerStatsDomainService(
 		matchRepository:       matchRepository,
 		seasonRepository:      seasonRepository,
 		teamRepository:        teamRepository,
+		auditor:               auditor,
 	}
 }
 
@@ -78,7 +81,12 @@ func (s *PlayerStatsDomainService) CreatePlayerStat(ctx context.Context, playerS
 		}
 	}
 
-	return s.playerStatsRepository.CreatePlayerStat(ctx, playerStat)
+	if err := s.playerStatsRepository.CreatePlayerStat(ctx, playerStat); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionCreate, domain.AuditEntityPlayerStats, playerStat.ID, nil, playerStat)
+	return nil
 }
 
 func (s *PlayerStatsDomainService) GetPlayerStatByID(ctx context.Context, id uint64) (*domain.PlayerStat, error) {
@@ -162,6 +170,13 @@ func (s *PlayerStatsDomainService) UpdatePlayerStat(ctx context.Context, id uint
 		return nil, constants.ErrRecordNotFound
 	}
 
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingPlayerStat.Version) {
+		return nil, constants.ErrVersionMismatch
+	}
+
+	before := domain.AuditSnapshot(existingPlayerStat)
+
 	// Apply updates to existing player stat
 	if playerStat.TeamID != nil {
 		// Validate Team exists if provided
@@ -213,6 +228,7 @@ func (s *PlayerStatsDomainService) UpdatePlayerStat(ctx context.Context, id uint
 		return nil, fmt.Errorf("failed to update player stat: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityPlayerStats, id, before, existingPlayerStat)
 	return existingPlayerStat, nil
 }
 
@@ -230,5 +246,15 @@ func (s *PlayerStatsDomainService) DeletePlayerStat(ctx context.Context, id uint
 		return constants.ErrRecordNotFound
 	}
 
-	return s.playerStatsRepository.DeletePlayerStat(ctx, id)
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingPlayerStat.Version) {
+		return constants.ErrVersionMismatch
+	}
+
+	if err := s.playerStatsRepository.DeletePlayerStat(ctx, id); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionDelete, domain.AuditEntityPlayerStats, id, existingPlayerStat, nil)
+	return nil
 }
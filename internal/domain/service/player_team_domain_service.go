@@ -13,6 +13,7 @@ type PlayerTeamDomainService struct {
 	playerRepository     domain.PlayerRepository
 	teamRepository       domain.TeamRepository
 	seasonRepository     domain.SeasonRepository
+	auditor              domain.Auditor
 }
 
 func NewPlayerTeamDomainService(
@@ -20,12 +21,14 @@ func NewPlayerTeamDomainService(
 	playerRepository domain.PlayerRepository,
 	teamRepository domain.TeamRepository,
 	seasonRepository domain.SeasonRepository,
+	auditor domain.Auditor,
 ) *PlayerTeamDomainService {
 	return &PlayerTeamDomainService{
 		playerTeamRepository: playerTeamRepository,
 		playerRepository:     playerRepository,
 		teamRepository:       teamRepository,
 		seasonRepository:     seasonRepository,
+		auditor:              auditor,
 	}
 }
 
@@ -86,6 +89,7 @@ func (s *PlayerTeamDomainService) CreatePlayerTeam(ctx context.Context, playerTe
 		return nil, fmt.Errorf("failed to create player team: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditActionCreate, domain.AuditEntityPlayerTeam, playerTeam.ID, nil, playerTeam)
 	return playerTeam, nil
 }
 
@@ -144,6 +148,13 @@ func (s *PlayerTeamDomainService) UpdatePlayerTeam(ctx context.Context, id uint6
 		return nil, err
 	}
 
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingPlayerTeam.Version) {
+		return nil, constants.ErrVersionMismatch
+	}
+
+	before := domain.AuditSnapshot(existingPlayerTeam)
+
 	// Update fields from updateData
 	existingPlayerTeam.PlayerID = updateData.PlayerID
 	existingPlayerTeam.TeamID = updateData.TeamID
@@ -180,6 +191,7 @@ func (s *PlayerTeamDomainService) UpdatePlayerTeam(ctx context.Context, id uint6
 		return nil, fmt.Errorf("failed to update player team: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityPlayerTeam, id, before, existingPlayerTeam)
 	return existingPlayerTeam, nil
 }
 
@@ -189,12 +201,22 @@ func (s *PlayerTeamDomainService) DeletePlayerTeam(ctx context.Context, id uint6
 	}
 
 	// Check if player team exists
-	_, err := s.GetPlayerTeamByID(ctx, id)
+	existingPlayerTeam, err := s.GetPlayerTeamByID(ctx, id)
 	if err != nil {
 		return err
 	}
 
-	return s.playerTeamRepository.DeletePlayerTeam(ctx, id)
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingPlayerTeam.Version) {
+		return constants.ErrVersionMismatch
+	}
+
+	if err := s.playerTeamRepository.DeletePlayerTeam(ctx, id); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionDelete, domain.AuditEntityPlayerTeam, id, existingPlayerTeam, nil)
+	return nil
 }
 
 func (s *PlayerTeamDomainService) DeletePlayerTeamsByPlayerID(ctx context.Context, playerID uint64) error {
@@ -202,5 +224,17 @@ func (s *PlayerTeamDomainService) DeletePlayerTeamsByPlayerID(ctx context.Contex
 		return constants.ErrInvalidData
 	}
 
-	return s.playerTeamRepository.DeleteByPlayerID(ctx, playerID)
+	playerTeams, err := s.playerTeamRepository.GetByPlayerID(ctx, playerID)
+	if err != nil {
+		return fmt.Errorf("failed to get player teams: %w", err)
+	}
+
+	if err := s.playerTeamRepository.DeleteByPlayerID(ctx, playerID); err != nil {
+		return err
+	}
+
+	for i := range playerTeams {
+		s.auditor.Record(ctx, domain.AuditActionDelete, domain.AuditEntityPlayerTeam, playerTeams[i].ID, &playerTeams[i], nil)
+	}
+	return nil
 }
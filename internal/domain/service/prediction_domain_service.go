@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// PredictionDomainService encapsulates the score prediction game: users predict
+// results before kickoff and earn points once the match is completed.
+type PredictionDomainService struct {
+	predictionRepository domain.PredictionRepository
+	matchRepository      domain.MatchRepository
+	seasonRepository     domain.SeasonRepository
+	scoring              domain.PredictionScoring
+}
+
+// NewPredictionDomainService creates a new PredictionDomainService instance.
+func NewPredictionDomainService(predictionRepository domain.PredictionRepository, matchRepository domain.MatchRepository, seasonRepository domain.SeasonRepository, scoring domain.PredictionScoring) *PredictionDomainService {
+	return &PredictionDomainService{
+		predictionRepository: predictionRepository,
+		matchRepository:      matchRepository,
+		seasonRepository:     seasonRepository,
+		scoring:              scoring,
+	}
+}
+
+// CreatePrediction stores the user's prediction for a match that has not kicked off yet.
+func (s *PredictionDomainService) CreatePrediction(ctx context.Context, prediction *domain.Prediction) (*domain.Prediction, error) {
+	if !prediction.IsValid() {
+		return nil, constants.ErrInvalidData
+	}
+
+	if _, err := s.getOpenMatch(ctx, prediction.MatchID); err != nil {
+		return nil, err
+	}
+
+	// Business rule: one prediction per user and match
+	existing, err := s.predictionRepository.GetPredictionByUserAndMatch(ctx, prediction.UserID, prediction.MatchID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, constants.ErrRecordAlreadyExists
+	}
+
+	prediction.Points = nil
+	prediction.Exact = false
+	prediction.ScoredAt = nil
+	if err := s.predictionRepository.CreatePrediction(ctx, prediction); err != nil {
+		return nil, err
+	}
+
+	return s.predictionRepository.GetPredictionByID(ctx, prediction.ID)
+}
+
+// GetOwnPredictionByID retrieves a prediction that belongs to the given user.
+func (s *PredictionDomainService) GetOwnPredictionByID(ctx context.Context, id uint64, userID string) (*domain.Prediction, error) {
+	if id == 0 {
+		return nil, constants.ErrInvalidID
+	}
+
+	prediction, err := s.predictionRepository.GetPredictionByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prediction by ID: %w", err)
+	}
+	// Predictions of other users are reported as missing to avoid leaking them
+	if prediction == nil || prediction.UserID != userID {
+		return nil, constants.ErrPredictionNotFound
+	}
+
+	return prediction, nil
+}
+
+// GetPaginatedPredictionsByUser retrieves the user's predictions.
+func (s *PredictionDomainService) GetPaginatedPredictionsByUser(ctx context.Context, userID string, sort string, order string, page int, pageSize int) ([]domain.Prediction, int64, error) {
+	return s.predictionRepository.GetPaginatedPredictionsByUser(ctx, userID, sort, order, page, pageSize)
+}
+
+// UpdatePrediction changes the predicted score while the match still accepts predictions.
+func (s *PredictionDomainService) UpdatePrediction(ctx context.Context, id uint64, userID string, updates *domain.Prediction) (*domain.Prediction, error) {
+	existingPrediction, err := s.GetOwnPredictionByID(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.getOpenMatch(ctx, existingPrediction.MatchID); err != nil {
+		return nil, err
+	}
+
+	existingPrediction.HomeGoals = updates.HomeGoals
+	existingPrediction.AwayGoals = updates.AwayGoals
+	if !existingPrediction.IsValid() {
+		return nil, constants.ErrInvalidData
+	}
+
+	if err := s.predictionRepository.UpdatePrediction(ctx, id, existingPrediction); err != nil {
+		return nil, fmt.Errorf("failed to update prediction: %w", err)
+	}
+
+	return s.predictionRepository.GetPredictionByID(ctx, id)
+}
+
+// DeletePrediction withdraws a prediction while the match still accepts predictions.
+func (s *PredictionDomainService) DeletePrediction(ctx context.Context, id uint64, userID string) error {
+	existingPrediction, err := s.GetOwnPredictionByID(ctx, id, userID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.getOpenMatch(ctx, existingPrediction.MatchID); err != nil {
+		return err
+	}
+
+	return s.predictionRepository.DeletePrediction(ctx, id)
+}
+
+// GetSeasonLeaderboard ranks fans by the points earned with their predictions in the season.
+func (s *PredictionDomainService) GetSeasonLeaderboard(ctx context.Context, seasonID uint64, page int, pageSize int) ([]domain.PredictionLeaderboardEntry, int64, error) {
+	season, err := s.seasonRepository.GetSeasonByID(ctx, seasonID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if season == nil {
+		return nil, 0, constants.ErrSeasonNotFound
+	}
+
+	return s.predictionRepository.GetSeasonLeaderboard(ctx, seasonID, constants.RoleDefault, page, pageSize)
+}
+
+// OnMatchCompleted scores every prediction of the completed match. Scores are
+// recomputed from scratch, so corrected results are reflected on the next call.
+func (s *PredictionDomainService) OnMatchCompleted(ctx context.Context, match *domain.Match) error {
+	predictions, err := s.predictionRepository.GetPredictionsByMatchID(ctx, match.ID)
+	if err != nil {
+		return err
+	}
+
+	scoredAt := time.Now()
+	for i := range predictions {
+		points, exact := s.scoring.Score(&predictions[i], match)
+		predictions[i].Points = &points
+		predictions[i].Exact = exact
+		predictions[i].ScoredAt = &scoredAt
+	}
+
+	if err := s.predictionRepository.SavePredictionScores(ctx, predictions); err != nil {
+		return fmt.Errorf("failed to score predictions for match %d: %w", match.ID, err)
+	}
+	return nil
+}
+
+// getOpenMatch loads the match and ensures it still accepts predictions
+func (s *PredictionDomainService) getOpenMatch(ctx context.Context, matchID uint64) (*domain.Match, error) {
+	match, err := s.matchRepository.GetMatchByID(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	if match == nil {
+		return nil, constants.ErrMatchNotFound
+	}
+	if !match.AcceptsPredictionsAt(time.Now()) {
+		return nil, constants.ErrPredictionsClosed
+	}
+	return match, nil
+}
@@ -10,11 +10,13 @@ import (
 
 type RoleDomainService struct {
 	roleRepository domain.RoleRepository
+	auditor        domain.Auditor
 }
 
-func NewRoleDomainService(roleRepository domain.RoleRepository) *RoleDomainService {
+func NewRoleDomainService(roleRepository domain.RoleRepository, auditor domain.Auditor) *RoleDomainService {
 	return &RoleDomainService{
 		roleRepository: roleRepository,
+		auditor:        auditor,
 	}
 }
 
@@ -39,6 +41,7 @@ func (s *RoleDomainService) CreateRole(ctx context.Context, role *domain.Role) (
 	if err != nil {
 		return nil, fmt.Errorf("failed to create role: %w", err)
 	}
+	s.auditor.Record(ctx, domain.AuditActionCreate, domain.AuditEntityRole, role.ID, nil, role)
 
 	// Return the created role as domain entity
 	return role, nil
@@ -94,6 +97,17 @@ func (s *RoleDomainService) UpdateRole(ctx context.Context, role *domain.Role) (
 		return nil, constants.ErrRecordNotFound
 	}
 
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingRole.Version) {
+		return nil, constants.ErrVersionMismatch
+	}
+	role.Version = existingRole.Version
+
+	// System roles are recognized by their name
+	if !existingRole.CanBeRenamedTo(role.Name) {
+		return nil, constants.ErrCannotRenameSystemRole
+	}
+
 	// Check if name is being changed to an existing name
 	if existingRole.Name != role.Name {
 		conflictingRole, err := s.roleRepository.GetRoleByName(ctx, role.Name)
@@ -119,6 +133,7 @@ func (s *RoleDomainService) UpdateRole(ctx context.Context, role *domain.Role) (
 		return nil, constants.ErrRecordNotFound
 	}
 
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityRole, role.ID, existingRole, updatedRole)
 	return updatedRole, nil
 }
 
@@ -137,6 +152,11 @@ func (s *RoleDomainService) DeleteRole(ctx context.Context, id uint64) error {
 		return constants.ErrRecordNotFound
 	}
 
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, domainRole.Version) {
+		return constants.ErrVersionMismatch
+	}
+
 	// Apply domain business rules
 	if !domainRole.CanBeDeleted() {
 		return constants.ErrCannotDeleteSystemRole
@@ -148,9 +168,49 @@ func (s *RoleDomainService) DeleteRole(ctx context.Context, id uint64) error {
 		return fmt.Errorf("failed to delete role: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditActionDelete, domain.AuditEntityRole, id, domainRole, nil)
 	return nil
 }
 
+// SetRolePermissions replaces the permissions granted to a role.
+// The administrator role always has every permission and cannot be edited.
+func (s *RoleDomainService) SetRolePermissions(ctx context.Context, id uint64, permissions []string) (*domain.Role, error) {
+	if id == 0 {
+		return nil, constants.ErrInvalidData
+	}
+
+	normalized, ok := domain.NormalizePermissions(permissions)
+	if !ok {
+		return nil, constants.ErrInvalidPermission
+	}
+
+	domainRole, err := s.roleRepository.GetRoleByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+	if domainRole == nil {
+		return nil, constants.ErrRecordNotFound
+	}
+	if domainRole.HasFixedPermissions() {
+		return nil, constants.ErrFixedRolePermissions
+	}
+
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, domainRole.Version) {
+		return nil, constants.ErrVersionMismatch
+	}
+
+	before := domain.AuditSnapshot(domainRole)
+	err = s.roleRepository.SetRolePermissions(ctx, domainRole, normalized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set role permissions: %w", err)
+	}
+
+	domainRole.Permissions = normalized
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityRole, id, before, domainRole)
+	return domainRole, nil
+}
+
 // GetPaginatedRoles retrieves paginated roles with domain conversion.
 func (s *RoleDomainService) GetPaginatedRoles(ctx context.Context, sort string, order string, page int, pageSize int) ([]*domain.Role, int64, error) {
 
@@ -203,6 +263,7 @@ func (s *RoleDomainService) GetSystemRoles() []*domain.Role {
 		{Name: domain.RoleAdmin, Description: "Administrator with full access"},
 		{Name: domain.RolePlayer, Description: "Player with limited access"},
 		{Name: domain.RoleCoach, Description: "Coach with team management access"},
+		{Name: domain.RoleReferee, Description: "Match official who submits match reports"},
 	}
 }
 
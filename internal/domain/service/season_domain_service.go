@@ -12,12 +12,14 @@ import (
 // It contains domain rules and validation while being infrastructure-agnostic.
 type SeasonDomainService struct {
 	seasonRepository domain.SeasonRepository
+	auditor          domain.Auditor
 }
 
 // NewSeasonDomainService creates a new SeasonDomainService instance.
-func NewSeasonDomainService(seasonRepository domain.SeasonRepository) *SeasonDomainService {
+func NewSeasonDomainService(seasonRepository domain.SeasonRepository, auditor domain.Auditor) *SeasonDomainService {
 	return &SeasonDomainService{
 		seasonRepository: seasonRepository,
+		auditor:          auditor,
 	}
 }
 
@@ -48,7 +50,12 @@ func (s *SeasonDomainService) CreateSeason(ctx context.Context, season *domain.S
 		}
 	}
 
-	return s.seasonRepository.CreateSeason(ctx, season)
+	if err := s.seasonRepository.CreateSeason(ctx, season); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionCreate, domain.AuditEntitySeason, season.ID, nil, season)
+	return nil
 }
 
 // GetSeasonByID retrieves a season by ID.
@@ -134,6 +141,12 @@ func (s *SeasonDomainService) UpdateSeason(ctx context.Context, id uint64, seaso
 		return constants.ErrRecordNotFound
 	}
 
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingSeason.Version) {
+		return constants.ErrVersionMismatch
+	}
+	season.Version = existingSeason.Version
+
 	// Business rule: If updating year, check for uniqueness
 	if season.Year != existingSeason.Year {
 		conflictingSeason, err := s.seasonRepository.GetSeasonByYear(ctx, season.Year)
@@ -156,7 +169,12 @@ func (s *SeasonDomainService) UpdateSeason(ctx context.Context, id uint64, seaso
 		}
 	}
 
-	return s.seasonRepository.UpdateSeason(ctx, id, season)
+	if err := s.seasonRepository.UpdateSeason(ctx, id, season); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntitySeason, id, existingSeason, season)
+	return nil
 }
 
 // DeleteSeason deletes a season with business rule validation.
@@ -174,33 +192,54 @@ func (s *SeasonDomainService) DeleteSeason(ctx context.Context, id uint64) error
 		return fmt.Errorf("season with ID %d not found", id)
 	}
 
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, season.Version) {
+		return constants.ErrVersionMismatch
+	}
+
 	// Business rule: Cannot delete current season
 	if season.IsCurrent {
 		return fmt.Errorf("cannot delete current season")
 	}
 
-	return s.seasonRepository.DeleteSeason(ctx, id)
+	if err := s.seasonRepository.DeleteSeason(ctx, id); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionDelete, domain.AuditEntitySeason, id, season, nil)
+	return nil
 }
 
 // SetCurrentSeason sets a season as the current one.
-func (s *SeasonDomainService) SetCurrentSeason(ctx context.Context, id uint64) error {
+func (s *SeasonDomainService) SetCurrentSeason(ctx context.Context, id uint64) (*domain.Season, error) {
 	if id == 0 {
-		return fmt.Errorf("invalid season ID")
+		return nil, fmt.Errorf("invalid season ID")
 	}
 
 	// Check if season exists
 	season, err := s.seasonRepository.GetSeasonByID(ctx, id)
 	if err != nil {
-		return fmt.Errorf("failed to get season: %w", err)
+		return nil, fmt.Errorf("failed to get season: %w", err)
 	}
 	if season == nil {
-		return fmt.Errorf("season with ID %d not found", id)
+		return nil, constants.ErrRecordNotFound
+	}
+
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, season.Version) {
+		return nil, constants.ErrVersionMismatch
 	}
 
 	// Business rule: Season must be valid to be set as current
 	if !season.IsValid() {
-		return fmt.Errorf("cannot set invalid season as current")
+		return nil, fmt.Errorf("cannot set invalid season as current")
+	}
+
+	currentSeason := *season
+	if err := s.seasonRepository.SetCurrentSeason(ctx, &currentSeason); err != nil {
+		return nil, err
 	}
 
-	return s.seasonRepository.SetCurrentSeason(ctx, id)
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntitySeason, id, season, &currentSeason)
+	return &currentSeason, nil
 }
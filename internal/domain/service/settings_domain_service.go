@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// SettingsDomainService manages the settings administrators can change at runtime.
+// The email domain policy is checked on every sign-up, so it is cached in memory. Updates
+// through this service replace the cache at once; other replicas reload it once it is older
+// than cacheTTL.
+type SettingsDomainService struct {
+	emailDomainRepository domain.EmailDomainRuleRepository
+	cacheTTL              time.Duration
+	auditor               domain.Auditor
+
+	mu                sync.RWMutex
+	emailDomainPolicy *domain.EmailDomainPolicy
+	loadedAt          time.Time
+	generation        uint64 // Incremented on invalidation, so a load that raced with it is not cached
+}
+
+// NewSettingsDomainService creates a new SettingsDomainService instance.
+func NewSettingsDomainService(emailDomainRepository domain.EmailDomainRuleRepository, cacheTTL time.Duration, auditor domain.Auditor) *SettingsDomainService {
+	return &SettingsDomainService{
+		emailDomainRepository: emailDomainRepository,
+		cacheTTL:              cacheTTL,
+		auditor:               auditor,
+	}
+}
+
+// GetEmailDomainPolicy returns the email domain policy.
+func (s *SettingsDomainService) GetEmailDomainPolicy(ctx context.Context) (*domain.EmailDomainPolicy, error) {
+	s.mu.RLock()
+	policy, loadedAt, generation := s.emailDomainPolicy, s.loadedAt, s.generation
+	s.mu.RUnlock()
+	if policy != nil && time.Since(loadedAt) < s.cacheTTL {
+		return policy, nil
+	}
+
+	rules, err := s.emailDomainRepository.GetEmailDomainRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get email domain rules: %w", err)
+	}
+	policy = domain.NewEmailDomainPolicy(rules)
+
+	s.mu.Lock()
+	if s.generation == generation {
+		s.emailDomainPolicy, s.loadedAt = policy, time.Now()
+	}
+	s.mu.Unlock()
+	return policy, nil
+}
+
+// ReloadEmailDomainPolicy reads the email domain policy from the database and caches it, so
+// administrators see the version their next update is checked against.
+func (s *SettingsDomainService) ReloadEmailDomainPolicy(ctx context.Context) (*domain.EmailDomainPolicy, error) {
+	s.InvalidateEmailDomainPolicy()
+	return s.GetEmailDomainPolicy(ctx)
+}
+
+// UpdateEmailDomainPolicy replaces the allowed and blocked domains and refreshes the cache.
+// The policy is only replaced when it is still at the version the client last read.
+func (s *SettingsDomainService) UpdateEmailDomainPolicy(ctx context.Context, policy *domain.EmailDomainPolicy, updatedByID string) (*domain.EmailDomainPolicy, error) {
+	if policy == nil {
+		return nil, constants.ErrInvalidData
+	}
+	policy.Normalize()
+	if !policy.IsValid() {
+		return nil, constants.ErrInvalidData
+	}
+
+	// Read the stored rules rather than the cache so the audit entry shows the real previous state
+	previousRules, err := s.emailDomainRepository.GetEmailDomainRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get email domain rules: %w", err)
+	}
+	previousPolicy := domain.NewEmailDomainPolicy(previousRules)
+
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, previousPolicy.Version()) {
+		return nil, constants.ErrVersionMismatch
+	}
+
+	if err := s.emailDomainRepository.ReplaceEmailDomainRules(ctx, previousRules, policy.Rules(&updatedByID)); err != nil {
+		return nil, err
+	}
+	updatedPolicy, err := s.ReloadEmailDomainPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityEmailDomains, domain.AuditEntityEmailDomains, previousPolicy, updatedPolicy)
+	return updatedPolicy, nil
+}
+
+// InvalidateEmailDomainPolicy drops the cached policy so the next check reads it again.
+func (s *SettingsDomainService) InvalidateEmailDomainPolicy() {
+	s.mu.Lock()
+	s.emailDomainPolicy = nil
+	s.generation++
+	s.mu.Unlock()
+}
+
+// IsEmailDomainAllowed reports whether a new account may be created for the email.
+func (s *SettingsDomainService) IsEmailDomainAllowed(ctx context.Context, email string) (bool, error) {
+	policy, err := s.GetEmailDomainPolicy(ctx)
+	if err != nil {
+		return false, err
+	}
+	return policy.AllowsEmail(email), nil
+}
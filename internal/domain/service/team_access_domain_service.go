@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// TeamAccessDomainService authorizes operations on resources that belong to a team.
+// Users granted the team:manage_any permission may manage any team while the others
+// are limited to the team they are linked to, so the check depends on the resource
+// rather than on the route. The permissions passed to each check are the ones granted
+// to the credentials of the request.
+type TeamAccessDomainService struct {
+	userRepository        domain.UserRepository
+	matchRepository       domain.MatchRepository
+	lineupRepository      domain.LineupRepository
+	playerStatsRepository domain.PlayerStatsRepository
+	playerTeamRepository  domain.PlayerTeamRepository
+}
+
+// NewTeamAccessDomainService creates a new TeamAccessDomainService instance.
+func NewTeamAccessDomainService(
+	userRepository domain.UserRepository,
+	matchRepository domain.MatchRepository,
+	lineupRepository domain.LineupRepository,
+	playerStatsRepository domain.PlayerStatsRepository,
+	playerTeamRepository domain.PlayerTeamRepository,
+) *TeamAccessDomainService {
+	return &TeamAccessDomainService{
+		userRepository:        userRepository,
+		matchRepository:       matchRepository,
+		lineupRepository:      lineupRepository,
+		playerStatsRepository: playerStatsRepository,
+		playerTeamRepository:  playerTeamRepository,
+	}
+}
+
+// AuthorizeTeam checks that the user manages the given team with the required permission.
+func (s *TeamAccessDomainService) AuthorizeTeam(ctx context.Context, userID string, permissions []string, required string, teamID uint64) error {
+	scope, err := s.scopeFor(ctx, userID, permissions, required)
+	if err != nil {
+		return err
+	}
+	if !scope.Covers(teamID) {
+		return constants.ErrForbidden
+	}
+	return nil
+}
+
+// AuthorizeLineup checks that the user manages the team of the player in the lineup entry.
+func (s *TeamAccessDomainService) AuthorizeLineup(ctx context.Context, userID string, permissions []string, lineup *domain.Lineup) error {
+	scope, err := s.scopeFor(ctx, userID, permissions, domain.PermissionLineupWrite)
+	if err != nil || scope.AllTeams {
+		return err
+	}
+
+	return s.authorizeMatchPlayer(ctx, scope, lineup.MatchID, lineup.PlayerID)
+}
+
+// AuthorizeLineupByID checks that the user manages the existing lineup entry.
+func (s *TeamAccessDomainService) AuthorizeLineupByID(ctx context.Context, userID string, permissions []string, lineupID uint64) error {
+	lineup, err := s.lineupRepository.GetLineupByID(ctx, lineupID)
+	if err != nil {
+		return fmt.Errorf("failed to get lineup by ID: %w", err)
+	}
+	if lineup == nil {
+		return constants.ErrLineupNotFound
+	}
+
+	return s.AuthorizeLineup(ctx, userID, permissions, lineup)
+}
+
+// AuthorizePlayerStat checks that the user manages the team the statistic is recorded for.
+func (s *TeamAccessDomainService) AuthorizePlayerStat(ctx context.Context, userID string, permissions []string, playerStat *domain.PlayerStat) error {
+	scope, err := s.scopeFor(ctx, userID, permissions, domain.PermissionPlayerStatsWrite)
+	if err != nil || scope.AllTeams {
+		return err
+	}
+
+	if playerStat.TeamID != nil && !scope.Covers(*playerStat.TeamID) {
+		return constants.ErrForbidden
+	}
+	return s.authorizeMatchPlayer(ctx, scope, playerStat.MatchID, playerStat.PlayerID)
+}
+
+// AuthorizePlayerStatByID checks that the user manages the existing statistic.
+func (s *TeamAccessDomainService) AuthorizePlayerStatByID(ctx context.Context, userID string, permissions []string, playerStatID uint64) error {
+	playerStat, err := s.playerStatsRepository.GetPlayerStatByID(ctx, playerStatID)
+	if err != nil {
+		return fmt.Errorf("failed to get player stat by ID: %w", err)
+	}
+	if playerStat == nil {
+		return constants.ErrRecordNotFound
+	}
+
+	return s.AuthorizePlayerStat(ctx, userID, permissions, playerStat)
+}
+
+// AuthorizeSquadPlayer checks that the player is currently in the squad of a team the user manages.
+func (s *TeamAccessDomainService) AuthorizeSquadPlayer(ctx context.Context, userID string, permissions []string, playerID uint64) error {
+	scope, err := s.scopeFor(ctx, userID, permissions, domain.PermissionPlayerAvailability)
+	if err != nil || scope.AllTeams {
+		return err
+	}
+
+	memberships, err := s.playerTeamRepository.GetByPlayerID(ctx, playerID)
+	if err != nil {
+		return fmt.Errorf("failed to get player teams: %w", err)
+	}
+	if !scope.CoversActiveSquadMember(memberships, time.Now()) {
+		return constants.ErrForbidden
+	}
+	return nil
+}
+
+// scopeFor loads the user and returns the teams they may manage with the required permission.
+// Users without any team in scope are rejected straight away.
+func (s *TeamAccessDomainService) scopeFor(ctx context.Context, userID string, permissions []string, required string) (domain.TeamScope, error) {
+	if userID == "" {
+		return domain.TeamScope{}, constants.ErrForbidden
+	}
+
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return domain.TeamScope{}, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if user == nil {
+		return domain.TeamScope{}, constants.ErrForbidden
+	}
+
+	scope := user.TeamScope(permissions, required)
+	if !scope.AllTeams && scope.TeamID == 0 {
+		return domain.TeamScope{}, constants.ErrForbidden
+	}
+	return scope, nil
+}
+
+// authorizeMatchPlayer checks that a team in scope plays the match and that the
+// player belongs to that team's squad for the match season.
+func (s *TeamAccessDomainService) authorizeMatchPlayer(ctx context.Context, scope domain.TeamScope, matchID, playerID uint64) error {
+	match, err := s.matchRepository.GetMatchByID(ctx, matchID)
+	if err != nil {
+		return fmt.Errorf("failed to get match by ID: %w", err)
+	}
+	if match == nil {
+		return constants.ErrMatchNotFound
+	}
+	if !scope.CoversMatch(match) {
+		return constants.ErrForbidden
+	}
+
+	memberships, err := s.playerTeamRepository.GetByPlayerID(ctx, playerID)
+	if err != nil {
+		return fmt.Errorf("failed to get player teams: %w", err)
+	}
+	if !scope.CoversSquadMember(memberships, match.SeasonID) {
+		return constants.ErrForbidden
+	}
+	return nil
+}
@@ -15,13 +15,17 @@ const (
 
 // TeamDomainService encapsulates business logic for team operations.
 type TeamDomainService struct {
-	teamRepository domain.TeamRepository
+	teamRepository  domain.TeamRepository
+	venueRepository domain.VenueRepository
+	auditor         domain.Auditor
 }
 
 // NewTeamDomainService creates a new TeamDomainService instance.
-func NewTeamDomainService(teamRepository domain.TeamRepository) *TeamDomainService {
+func NewTeamDomainService(teamRepository domain.TeamRepository, venueRepository domain.VenueRepository, auditor domain.Auditor) *TeamDomainService {
 	return &TeamDomainService{
-		teamRepository: teamRepository,
+		teamRepository:  teamRepository,
+		venueRepository: venueRepository,
+		auditor:         auditor,
 	}
 }
 
@@ -40,11 +44,16 @@ func (s *TeamDomainService) CreateTeam(ctx context.Context, team *domain.Team) (
 		return nil, constants.ErrRecordAlreadyExists
 	}
 
+	if err := s.ensureHomeVenueExists(ctx, team.HomeVenueID); err != nil {
+		return nil, err
+	}
+
 	// Create the team
 	if err := s.teamRepository.CreateTeam(ctx, team); err != nil {
 		return nil, fmt.Errorf("failed to create team: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditActionCreate, domain.AuditEntityTeam, team.ID, nil, team)
 	return team, nil
 }
 
@@ -103,6 +112,13 @@ func (s *TeamDomainService) UpdateTeam(ctx context.Context, id uint64, updates *
 		return nil, constants.ErrRecordNotFound
 	}
 
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingTeam.Version) {
+		return nil, constants.ErrVersionMismatch
+	}
+
+	before := domain.AuditSnapshot(existingTeam)
+
 	// Update fields
 	if updates.FullName != "" {
 		existingTeam.FullName = updates.FullName
@@ -122,6 +138,12 @@ func (s *TeamDomainService) UpdateTeam(ctx context.Context, id uint64, updates *
 	if updates.NextMatchID != nil {
 		existingTeam.NextMatchID = updates.NextMatchID
 	}
+	if updates.HomeVenueID != nil {
+		if err := s.ensureHomeVenueExists(ctx, updates.HomeVenueID); err != nil {
+			return nil, err
+		}
+		existingTeam.HomeVenueID = updates.HomeVenueID
+	}
 
 	// Validate updated entity
 	if !existingTeam.IsValid() {
@@ -133,6 +155,7 @@ func (s *TeamDomainService) UpdateTeam(ctx context.Context, id uint64, updates *
 		return nil, fmt.Errorf("failed to update team: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityTeam, id, before, existingTeam)
 	return existingTeam, nil
 }
 
@@ -150,10 +173,32 @@ func (s *TeamDomainService) DeleteTeam(ctx context.Context, id uint64) error {
 		return constants.ErrRecordNotFound
 	}
 
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existing.Version) {
+		return constants.ErrVersionMismatch
+	}
+
 	// Delete the team
 	if err := s.teamRepository.DeleteTeam(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete team: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditActionDelete, domain.AuditEntityTeam, id, existing, nil)
+	return nil
+}
+
+// ensureHomeVenueExists verifies that the optional home venue is present
+func (s *TeamDomainService) ensureHomeVenueExists(ctx context.Context, venueID *uint64) error {
+	if venueID == nil {
+		return nil
+	}
+
+	venue, err := s.venueRepository.GetVenueByID(ctx, *venueID)
+	if err != nil {
+		return fmt.Errorf("failed to check home venue: %w", err)
+	}
+	if venue == nil {
+		return constants.ErrVenueNotFound
+	}
 	return nil
 }
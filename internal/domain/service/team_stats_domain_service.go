@@ -12,17 +12,20 @@ type TeamStatsDomainService struct {
 	teamStatsRepository domain.TeamStatsRepository
 	teamRepository      domain.TeamRepository
 	seasonRepository    domain.SeasonRepository
+	auditor             domain.Auditor
 }
 
 func NewTeamStatsDomainService(
 	teamStatsRepository domain.TeamStatsRepository,
 	teamRepository domain.TeamRepository,
 	seasonRepository domain.SeasonRepository,
+	auditor domain.Auditor,
 ) *TeamStatsDomainService {
 	return &TeamStatsDomainService{
 		teamStatsRepository: teamStatsRepository,
 		teamRepository:      teamRepository,
 		seasonRepository:    seasonRepository,
+		auditor:             auditor,
 	}
 }
 
@@ -58,6 +61,7 @@ func (s *TeamStatsDomainService) CreateTeamStats(ctx context.Context, teamStats
 		return fmt.Errorf("failed to create team stats: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditActionCreate, domain.AuditEntityTeamStats, teamStats.ID, nil, teamStats)
 	return nil
 }
 
@@ -126,6 +130,11 @@ func (s *TeamStatsDomainService) UpdateTeamStats(ctx context.Context, teamStatsI
 		return nil, constants.ErrRecordNotFound
 	}
 
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, currentTeamStats.Version) {
+		return nil, constants.ErrVersionMismatch
+	}
+
 	// Validate team if being updated
 	if updatedTeamStats.TeamID != currentTeamStats.TeamID {
 		team, err := s.teamRepository.GetTeamByID(ctx, updatedTeamStats.TeamID)
@@ -161,16 +170,41 @@ func (s *TeamStatsDomainService) UpdateTeamStats(ctx context.Context, teamStatsI
 
 	// Set the ID to ensure we're updating the correct record
 	updatedTeamStats.ID = teamStatsID
+	updatedTeamStats.Version = currentTeamStats.Version
 
 	if err := s.teamStatsRepository.UpdateTeamStats(ctx, teamStatsID, updatedTeamStats); err != nil {
 		return nil, fmt.Errorf("failed to update team stats: %w", err)
 	}
 
 	// Return the updated team stats
-	return s.teamStatsRepository.GetTeamStatsByID(ctx, teamStatsID)
+	teamStats, err := s.teamStatsRepository.GetTeamStatsByID(ctx, teamStatsID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityTeamStats, teamStatsID, currentTeamStats, teamStats)
+	return teamStats, nil
 }
 
 // DeleteTeamStats deletes team statistics by ID.
 func (s *TeamStatsDomainService) DeleteTeamStats(ctx context.Context, id uint64) error {
-	return s.teamStatsRepository.DeleteTeamStats(ctx, id)
+	existing, err := s.teamStatsRepository.GetTeamStatsByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get team stats by ID: %w", err)
+	}
+	if existing == nil {
+		return constants.ErrRecordNotFound
+	}
+
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existing.Version) {
+		return constants.ErrVersionMismatch
+	}
+
+	if err := s.teamStatsRepository.DeleteTeamStats(ctx, id); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionDelete, domain.AuditEntityTeamStats, id, existing, nil)
+	return nil
 }
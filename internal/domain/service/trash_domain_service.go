@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// TrashDomainService lists and restores soft deleted records and purges them once the
+// retention period is over.
+type TrashDomainService struct {
+	trashRepository   domain.TrashRepository
+	articleRepository domain.ArticleRepository
+	matchRepository   domain.MatchRepository
+	playerRepository  domain.PlayerRepository
+	teamRepository    domain.TeamRepository
+	auditor           domain.Auditor
+	retention         time.Duration
+}
+
+// NewTrashDomainService creates a new TrashDomainService instance.
+// Deleted records can be restored for the retention period before they are purged.
+func NewTrashDomainService(
+	trashRepository domain.TrashRepository,
+	articleRepository domain.ArticleRepository,
+	matchRepository domain.MatchRepository,
+	playerRepository domain.PlayerRepository,
+	teamRepository domain.TeamRepository,
+	auditor domain.Auditor,
+	retention time.Duration,
+) *TrashDomainService {
+	return &TrashDomainService{
+		trashRepository:   trashRepository,
+		articleRepository: articleRepository,
+		matchRepository:   matchRepository,
+		playerRepository:  playerRepository,
+		teamRepository:    teamRepository,
+		auditor:           auditor,
+		retention:         retention,
+	}
+}
+
+// GetPaginatedTrash returns the deleted records matching the filter, most recently deleted first.
+func (s *TrashDomainService) GetPaginatedTrash(ctx context.Context, filter domain.TrashFilter, page int, pageSize int) ([]domain.TrashItem, int64, error) {
+	if filter.EntityType != "" && !domain.IsValidTrashEntityType(filter.EntityType) {
+		return nil, 0, constants.ErrInvalidData
+	}
+
+	if page < 0 {
+		page = 0
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+
+	items, total, err := s.trashRepository.GetPaginatedTrash(ctx, filter, page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range items {
+		items[i].PurgeAt = items[i].DeletedAt.Add(s.retention)
+	}
+	return items, total, nil
+}
+
+// RestoreTrashItem undeletes a record. It fails with constants.ErrRecordAlreadyExists when
+// another record took its name or title in the meantime.
+func (s *TrashDomainService) RestoreTrashItem(ctx context.Context, entityType string, id uint64) error {
+	if !domain.IsValidTrashEntityType(entityType) || id == 0 {
+		return constants.ErrInvalidData
+	}
+
+	restored, err := s.trashRepository.RestoreTrashItem(ctx, entityType, id)
+	if err != nil {
+		return err
+	}
+	if !restored {
+		return constants.ErrRecordNotFound
+	}
+
+	entity, err := s.getEntity(ctx, entityType, id)
+	if err != nil {
+		return fmt.Errorf("failed to get restored %s: %w", entityType, err)
+	}
+	s.auditor.Record(ctx, domain.AuditActionRestore, entityType, id, nil, entity)
+	return nil
+}
+
+// PurgeTrash permanently deletes the records that have been in the trash longer than the retention period.
+func (s *TrashDomainService) PurgeTrash(ctx context.Context) (int64, error) {
+	return s.trashRepository.PurgeTrash(ctx, time.Now().Add(-s.retention))
+}
+
+// getEntity loads a record of one of the trash entity types.
+func (s *TrashDomainService) getEntity(ctx context.Context, entityType string, id uint64) (any, error) {
+	switch entityType {
+	case domain.AuditEntityArticle:
+		return s.articleRepository.GetArticleByID(ctx, id)
+	case domain.AuditEntityMatch:
+		return s.matchRepository.GetMatchByID(ctx, id)
+	case domain.AuditEntityPlayer:
+		return s.playerRepository.GetPlayerByID(ctx, id)
+	default:
+		return s.teamRepository.GetTeamByID(ctx, id)
+	}
+}
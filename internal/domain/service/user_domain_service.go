@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/domain"
@@ -10,23 +11,44 @@ import (
 
 // UserDomainService encapsulates business logic for user operations.
 type UserDomainService struct {
-	userRepository domain.UserRepository
+	userRepository       domain.UserRepository
+	teamRepository       domain.TeamRepository
+	isEmailDomainAllowed func(ctx context.Context, email string) (bool, error)
+	auditor              domain.Auditor
 }
 
 // NewUserDomainService creates a new UserDomainService instance.
-func NewUserDomainService(userRepository domain.UserRepository) *UserDomainService {
+// isEmailDomainAllowed decides whether a new account may be created for an email.
+func NewUserDomainService(
+	userRepository domain.UserRepository,
+	teamRepository domain.TeamRepository,
+	isEmailDomainAllowed func(ctx context.Context, email string) (bool, error),
+	auditor domain.Auditor,
+) *UserDomainService {
 	return &UserDomainService{
-		userRepository: userRepository,
+		userRepository:       userRepository,
+		teamRepository:       teamRepository,
+		isEmailDomainAllowed: isEmailDomainAllowed,
+		auditor:              auditor,
 	}
 }
 
 // CreateUser creates a new user after validating business rules.
 func (s *UserDomainService) CreateUser(ctx context.Context, user *domain.User) (*domain.User, error) {
 	// Validate domain entity
+	user.Username = normalizeUsername(user.Username)
 	if !user.IsValid() {
 		return nil, constants.ErrInvalidData
 	}
 
+	allowed, err := s.isEmailDomainAllowed(ctx, user.Username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check email domain: %w", err)
+	}
+	if !allowed {
+		return nil, constants.ErrEmailDomainNotAllowed
+	}
+
 	// Check if a user with this username already exists
 	existing, err := s.userRepository.GetUserByUsername(ctx, user.Username)
 	if err != nil {
@@ -42,6 +64,7 @@ func (s *UserDomainService) CreateUser(ctx context.Context, user *domain.User) (
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditActionCreate, domain.AuditEntityUser, user.ID, nil, user)
 	return user, nil
 }
 
@@ -85,6 +108,13 @@ func (s *UserDomainService) UpdateUser(ctx context.Context, id string, updates *
 		return nil, constants.ErrRecordNotFound
 	}
 
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingUser.Version) {
+		return nil, constants.ErrVersionMismatch
+	}
+
+	before := domain.AuditSnapshot(existingUser)
+
 	// Apply updates to existing user
 	if updates.Name != "" {
 		existingUser.Name = updates.Name
@@ -127,6 +157,92 @@ func (s *UserDomainService) UpdateUser(ctx context.Context, id string, updates *
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
 
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityUser, id, before, existingUser)
+	return existingUser, nil
+}
+
+// UpdateProfile lets a user edit their own profile. Only the fields of domain.UserProfileUpdate
+// can change; every changed field is recorded with the user and IP address that changed it.
+func (s *UserDomainService) UpdateProfile(ctx context.Context, id string, update domain.UserProfileUpdate, ipAddress string) (*domain.User, error) {
+	existingUser, err := s.userRepository.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if existingUser == nil {
+		return nil, constants.ErrRecordNotFound
+	}
+
+	changes := existingUser.ApplyProfileUpdate(update)
+	if !existingUser.IsValid() {
+		return nil, constants.ErrInvalidData
+	}
+	if len(changes) == 0 {
+		return existingUser, nil
+	}
+
+	now := time.Now()
+	for i := range changes {
+		changes[i].ChangedBy = id
+		changes[i].IPAddress = truncate(ipAddress, 45)
+		changes[i].CreatedAt = now
+	}
+
+	if err := s.userRepository.UpdateUserProfile(ctx, existingUser, changes); err != nil {
+		return nil, err
+	}
+	return existingUser, nil
+}
+
+// GetProfileChanges returns the profile changes of a user, newest first.
+func (s *UserDomainService) GetProfileChanges(ctx context.Context, id string) ([]domain.UserProfileChange, error) {
+	existingUser, err := s.userRepository.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if existingUser == nil {
+		return nil, constants.ErrRecordNotFound
+	}
+	return s.userRepository.GetUserProfileChanges(ctx, id)
+}
+
+// AssignTeam links a user to the team they manage, or unlinks them when teamID is nil.
+// Only users whose role grants a team-scoped permission, such as coaches, can be linked.
+func (s *UserDomainService) AssignTeam(ctx context.Context, id string, teamID *uint64) (*domain.User, error) {
+	existingUser, err := s.userRepository.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by ID: %w", err)
+	}
+	if existingUser == nil {
+		return nil, constants.ErrRecordNotFound
+	}
+
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingUser.Version) {
+		return nil, constants.ErrVersionMismatch
+	}
+
+	if teamID != nil {
+		if existingUser.Role == nil || !existingUser.Role.GrantsTeamScopedPermission() {
+			return nil, constants.ErrUserCannotManageTeam
+		}
+
+		team, err := s.teamRepository.GetTeamByID(ctx, *teamID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check team: %w", err)
+		}
+		if team == nil {
+			return nil, constants.ErrTeamNotFound
+		}
+	}
+
+	before := domain.AuditSnapshot(existingUser)
+	existingUser.TeamID = teamID
+	err = s.userRepository.UpdateUser(ctx, id, existingUser)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign user team: %w", err)
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityUser, id, before, existingUser)
 	return existingUser, nil
 }
 
@@ -141,5 +257,15 @@ func (s *UserDomainService) DeleteUser(ctx context.Context, id string) error {
 		return constants.ErrRecordNotFound
 	}
 
-	return s.userRepository.DeleteUser(ctx, id)
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, user.Version) {
+		return constants.ErrVersionMismatch
+	}
+
+	if err := s.userRepository.DeleteUser(ctx, id); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionDelete, domain.AuditEntityUser, id, user, nil)
+	return nil
 }
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// VenueDomainService encapsulates business logic for venue operations.
+type VenueDomainService struct {
+	venueRepository domain.VenueRepository
+	auditor         domain.Auditor
+}
+
+// NewVenueDomainService creates a new VenueDomainService instance.
+func NewVenueDomainService(venueRepository domain.VenueRepository, auditor domain.Auditor) *VenueDomainService {
+	return &VenueDomainService{
+		venueRepository: venueRepository,
+		auditor:         auditor,
+	}
+}
+
+// CreateVenue creates a new venue ensuring its name is unique.
+func (s *VenueDomainService) CreateVenue(ctx context.Context, venue *domain.Venue) (*domain.Venue, error) {
+	venue.Name = strings.TrimSpace(venue.Name)
+	if !venue.IsValid() {
+		return nil, constants.ErrInvalidData
+	}
+
+	existing, err := s.venueRepository.GetVenueByName(ctx, venue.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check existing venue: %w", err)
+	}
+	if existing != nil {
+		return nil, constants.ErrRecordAlreadyExists
+	}
+
+	if err := s.venueRepository.CreateVenue(ctx, venue); err != nil {
+		return nil, err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionCreate, domain.AuditEntityVenue, venue.ID, nil, venue)
+	return venue, nil
+}
+
+// GetVenueByID retrieves a venue by ID.
+func (s *VenueDomainService) GetVenueByID(ctx context.Context, id uint64) (*domain.Venue, error) {
+	if id == 0 {
+		return nil, constants.ErrInvalidID
+	}
+
+	venue, err := s.venueRepository.GetVenueByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get venue by ID: %w", err)
+	}
+	if venue == nil {
+		return nil, constants.ErrRecordNotFound
+	}
+
+	return venue, nil
+}
+
+// GetPaginatedVenues retrieves paginated venues.
+func (s *VenueDomainService) GetPaginatedVenues(ctx context.Context, sort string, order string, page int, pageSize int) ([]domain.Venue, int64, error) {
+	return s.venueRepository.GetPaginatedVenues(ctx, sort, order, page, pageSize)
+}
+
+// UpdateVenue applies the non-empty fields of updates to an existing venue.
+func (s *VenueDomainService) UpdateVenue(ctx context.Context, id uint64, updates *domain.Venue) (*domain.Venue, error) {
+	existingVenue, err := s.GetVenueByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, existingVenue.Version) {
+		return nil, constants.ErrVersionMismatch
+	}
+
+	before := domain.AuditSnapshot(existingVenue)
+
+	if name := strings.TrimSpace(updates.Name); name != "" && !strings.EqualFold(name, existingVenue.Name) {
+		conflicting, err := s.venueRepository.GetVenueByName(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check venue name uniqueness: %w", err)
+		}
+		if conflicting != nil && conflicting.ID != id {
+			return nil, constants.ErrRecordAlreadyExists
+		}
+		existingVenue.Name = name
+	}
+	if updates.Address != "" {
+		existingVenue.Address = updates.Address
+	}
+	if updates.Capacity != 0 {
+		existingVenue.Capacity = updates.Capacity
+	}
+	if updates.Surface != "" {
+		existingVenue.Surface = updates.Surface
+	}
+	if updates.Latitude != nil {
+		existingVenue.Latitude = updates.Latitude
+	}
+	if updates.Longitude != nil {
+		existingVenue.Longitude = updates.Longitude
+	}
+
+	if !existingVenue.IsValid() {
+		return nil, constants.ErrInvalidData
+	}
+
+	if err := s.venueRepository.UpdateVenue(ctx, id, existingVenue); err != nil {
+		return nil, fmt.Errorf("failed to update venue: %w", err)
+	}
+
+	venue, err := s.venueRepository.GetVenueByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionUpdate, domain.AuditEntityVenue, id, before, venue)
+	return venue, nil
+}
+
+// DeleteVenue deletes a venue that is not referenced by any match, including matches in the trash.
+func (s *VenueDomainService) DeleteVenue(ctx context.Context, id uint64) error {
+	venue, err := s.GetVenueByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Only apply the change to the version the client last read
+	if !domain.VersionExpected(ctx, venue.Version) {
+		return constants.ErrVersionMismatch
+	}
+
+	// Business rule: venues with match history cannot be removed
+	matches, err := s.venueRepository.CountMatchesByVenueID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if matches > 0 {
+		return constants.ErrVenueInUse
+	}
+
+	if err := s.venueRepository.DeleteVenue(ctx, id); err != nil {
+		return err
+	}
+
+	s.auditor.Record(ctx, domain.AuditActionDelete, domain.AuditEntityVenue, id, venue, nil)
+	return nil
+}
@@ -0,0 +1,61 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/pkg/logger"
+)
+
+// writeTimeout bounds the write of an entry, which is not cancelled with the request
+// since the audited change has already been saved.
+const writeTimeout = 5 * time.Second
+
+// Recorder implements domain.Auditor by storing the entries in the audit repository.
+// The actor, request ID and IP address are taken from the context. Entries that cannot
+// be stored are logged with their content so the change is not lost.
+type Recorder struct {
+	repository domain.AuditRepository
+}
+
+// NewRecorder creates an auditor storing the entries in the repository.
+func NewRecorder(repository domain.AuditRepository) domain.Auditor {
+	return &Recorder{repository: repository}
+}
+
+func (r *Recorder) Record(ctx context.Context, action, entityType string, entityID any, before, after any) {
+	entry, err := domain.NewAuditEntry(action, entityType, entityID, before, after)
+	if err != nil {
+		logger.Error(ctx, "failed to build audit entry", "action", action, "entity_type", entityType, "entity_id", entityID, "error", err)
+		return
+	}
+	// Updates that leave the entity as it was are not worth an entry
+	if action == domain.AuditActionUpdate && len(entry.Changes) == 0 {
+		return
+	}
+
+	actor := domain.AuditActorFromContext(ctx)
+	if actor.UserID != "" {
+		entry.ActorID = &actor.UserID
+	}
+	if actor.APIKeyID != "" {
+		entry.APIKeyID = &actor.APIKeyID
+	}
+	entry.RequestID = actor.RequestID
+	entry.IPAddress = actor.IPAddress
+
+	writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), writeTimeout)
+	defer cancel()
+
+	if err := r.repository.CreateAuditEntry(writeCtx, entry); err != nil {
+		logger.Error(ctx, "failed to store audit entry",
+			"action", entry.Action,
+			"entity_type", entry.EntityType,
+			"entity_id", entry.EntityID,
+			"actor_id", actor.UserID,
+			"request_id", entry.RequestID,
+			"changes", entry.Changes,
+			"error", err)
+	}
+}
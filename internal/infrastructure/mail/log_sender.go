@@ -0,0 +1,54 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/pkg/logger"
+)
+
+// unsafeFileChars matches what cannot be kept from a recipient in a file name
+var unsafeFileChars = regexp.MustCompile(`[^a-zA-Z0-9@._-]+`)
+
+// LogSender implements domain.MailSender for development without an SMTP server.
+// Emails are written as .eml files to a directory, or logged with their body when
+// no directory is configured. Bodies may contain tokens, so it must not be used in production.
+type LogSender struct {
+	dir  string
+	from string
+}
+
+// NewLogSender creates a sender writing to dir, or to the log when dir is empty.
+func NewLogSender(dir, from string) domain.MailSender {
+	return &LogSender{dir: dir, from: from}
+}
+
+func (s *LogSender) Send(ctx context.Context, message *domain.MailMessage) error {
+	now := time.Now()
+	data, err := formatMessage(s.from, message, now)
+	if err != nil {
+		return err
+	}
+
+	if s.dir == "" {
+		logger.Info(ctx, "email not sent, logged instead", "to", message.To, "subject", message.Subject, "body", message.Body)
+		return nil
+	}
+
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create mail directory: %w", err)
+	}
+	name := fmt.Sprintf("%s-%s.eml", now.UTC().Format("20060102-150405.000000000"), unsafeFileChars.ReplaceAllString(message.To, "_"))
+	path := filepath.Join(s.dir, name)
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write email: %w", err)
+	}
+
+	logger.Info(ctx, "email not sent, written to file", "to", message.To, "subject", message.Subject, "path", path)
+	return nil
+}
@@ -0,0 +1,42 @@
+package mail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// formatMessage renders the message as a plain text RFC 5322 email.
+func formatMessage(from string, message *domain.MailMessage, date time.Time) ([]byte, error) {
+	if strings.ContainsAny(message.To+message.Subject, "\r\n") {
+		return nil, errors.New("mail headers must not contain line breaks")
+	}
+	if _, err := mail.ParseAddress(message.To); err != nil {
+		return nil, fmt.Errorf("invalid recipient: %w", err)
+	}
+
+	var buffer bytes.Buffer
+	fmt.Fprintf(&buffer, "From: %s\r\n", from)
+	fmt.Fprintf(&buffer, "To: %s\r\n", message.To)
+	fmt.Fprintf(&buffer, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&buffer, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buffer.WriteString("MIME-Version: 1.0\r\n")
+	buffer.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buffer.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	body := quotedprintable.NewWriter(&buffer)
+	if _, err := body.Write([]byte(message.Body)); err != nil {
+		return nil, err
+	}
+	if err := body.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// PKCEStore implements domain.PKCEStore in process memory.
+// It only works when a single API replica serves every OAuth callback.
+type PKCEStore struct {
+	mu      sync.Mutex
+	entries map[string]domain.PKCEParams
+	ttl     time.Duration
+}
+
+// NewPKCEStore creates an in-memory store whose entries expire after ttl.
+func NewPKCEStore(ttl time.Duration) domain.PKCEStore {
+	return &PKCEStore{
+		entries: make(map[string]domain.PKCEParams),
+		ttl:     ttl,
+	}
+}
+
+func (s *PKCEStore) SavePKCE(_ context.Context, state string, params *domain.PKCEParams) error {
+	entry := *params
+	entry.ExpiresAt = time.Now().Add(s.ttl)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[state] = entry
+	return nil
+}
+
+func (s *PKCEStore) TakePKCE(_ context.Context, state string) (*domain.PKCEParams, error) {
+	s.mu.Lock()
+	entry, ok := s.entries[state]
+	delete(s.entries, state)
+	s.mu.Unlock()
+
+	if !ok || entry.IsExpired(time.Now()) {
+		return nil, nil
+	}
+	return &entry, nil
+}
+
+func (s *PKCEStore) DeleteExpiredPKCE(_ context.Context, now time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted int64
+	for state, entry := range s.entries {
+		if entry.IsExpired(now) {
+			delete(s.entries, state)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/domain"
+)
+
+// RateLimitStore implements domain.RateLimitStore in process memory.
+// Each API replica then enforces the limits on its own.
+type RateLimitStore struct {
+	mu      sync.Mutex
+	buckets map[string]domain.RateLimitBucket
+}
+
+// NewRateLimitStore creates an empty in-memory rate limit store.
+func NewRateLimitStore() domain.RateLimitStore {
+	return &RateLimitStore{buckets: make(map[string]domain.RateLimitBucket)}
+}
+
+func (s *RateLimitStore) TakeRateLimitToken(_ context.Context, key string, policy domain.RateLimitPolicy, cost int, now time.Time) (domain.RateLimitDecision, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = policy.NewBucket(now)
+	}
+	decision := policy.Take(&bucket, cost, now)
+	s.buckets[key] = bucket
+	return decision, nil
+}
+
+func (s *RateLimitStore) DeleteIdleRateLimitBuckets(_ context.Context, before time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted int64
+	for key, bucket := range s.buckets {
+		if bucket.RefilledAt.Before(before) {
+			delete(s.buckets, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,269 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	persistenceMapper "github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+)
+
+// AccountRepositoryImpl implements domain.AccountRepository interface.
+type AccountRepositoryImpl struct {
+	db               *gorm.DB
+	mapper           *persistenceMapper.AccountPersistenceMapper
+	userMapper       *persistenceMapper.UserPersistenceMapper
+	playerMapper     *persistenceMapper.PlayerPersistenceMapper
+	predictionMapper *persistenceMapper.PredictionPersistenceMapper
+	mvpVoteMapper    *persistenceMapper.MVPVotePersistenceMapper
+	sessionMapper    *persistenceMapper.SessionPersistenceMapper
+	identityMapper   *persistenceMapper.UserIdentityPersistenceMapper
+	apiKeyMapper     *persistenceMapper.APIKeyPersistenceMapper
+	auditMapper      *persistenceMapper.AuditPersistenceMapper
+}
+
+func NewAccountRepository(db *gorm.DB) domain.AccountRepository {
+	return &AccountRepositoryImpl{
+		db:               db,
+		mapper:           persistenceMapper.NewAccountPersistenceMapper(),
+		userMapper:       persistenceMapper.NewUserPersistenceMapper(),
+		playerMapper:     persistenceMapper.NewPlayerPersistenceMapper(),
+		predictionMapper: persistenceMapper.NewPredictionPersistenceMapper(),
+		mvpVoteMapper:    persistenceMapper.NewMVPVotePersistenceMapper(),
+		sessionMapper:    persistenceMapper.NewSessionPersistenceMapper(),
+		identityMapper:   persistenceMapper.NewUserIdentityPersistenceMapper(),
+		apiKeyMapper:     persistenceMapper.NewAPIKeyPersistenceMapper(),
+		auditMapper:      persistenceMapper.NewAuditPersistenceMapper(),
+	}
+}
+
+func (ar *AccountRepositoryImpl) GetUserDataExport(ctx context.Context, userID string) (*domain.UserDataExport, error) {
+	var export *domain.UserDataExport
+
+	// Read everything in one transaction so the bundle is a consistent snapshot
+	err := ar.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user model.User
+		if err := tx.Preload("Role").First(&user, constants.QueryIDEquals, userID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return fmt.Errorf("error getting user: %w", err)
+		}
+		export = &domain.UserDataExport{User: *ar.userMapper.ModelToDomain(&user)}
+
+		var player model.Player
+		err := tx.Where("user_id = ?", userID).First(&player).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("error getting linked player: %w", err)
+		}
+		if err == nil {
+			export.Player = ar.playerMapper.ModelToDomain(&player)
+		}
+
+		var predictions []model.Prediction
+		if err := tx.Where("user_id = ?", userID).Order("created_at").Find(&predictions).Error; err != nil {
+			return fmt.Errorf("error getting predictions: %w", err)
+		}
+		export.Predictions = ar.predictionMapper.ModelListToDomain(predictions)
+
+		var votes []model.MVPVote
+		if err := tx.Where("user_id = ?", userID).Order("created_at").Find(&votes).Error; err != nil {
+			return fmt.Errorf("error getting MVP votes: %w", err)
+		}
+		export.MVPVotes = make([]domain.MVPVote, len(votes))
+		for i := range votes {
+			export.MVPVotes[i] = *ar.mvpVoteMapper.VoteToDomain(&votes[i])
+		}
+
+		var sessions []model.Session
+		if err := tx.Where("user_id = ?", userID).Order("created_at").Find(&sessions).Error; err != nil {
+			return fmt.Errorf("error getting sessions: %w", err)
+		}
+		export.Sessions = ar.sessionMapper.ToDomainList(sessions)
+
+		var identities []model.UserIdentity
+		if err := tx.Where("user_id = ?", userID).Order("created_at").Find(&identities).Error; err != nil {
+			return fmt.Errorf("error getting identities: %w", err)
+		}
+		export.Identities = make([]domain.UserIdentity, len(identities))
+		for i := range identities {
+			export.Identities[i] = *ar.identityMapper.ToDomain(&identities[i])
+		}
+
+		var apiKeys []model.APIKey
+		if err := tx.Preload("Scopes").Where("user_id = ?", userID).Order("created_at").Find(&apiKeys).Error; err != nil {
+			return fmt.Errorf("error getting API keys: %w", err)
+		}
+		export.APIKeys = ar.apiKeyMapper.ToDomainList(apiKeys)
+
+		var changes []model.UserProfileChange
+		if err := tx.Where("user_id = ?", userID).Order("created_at, id").Find(&changes).Error; err != nil {
+			return fmt.Errorf("error getting profile changes: %w", err)
+		}
+		export.ProfileChanges = make([]domain.UserProfileChange, len(changes))
+		for i := range changes {
+			export.ProfileChanges[i] = *ar.userMapper.ProfileChangeToDomain(&changes[i])
+		}
+
+		var auditEntries []model.AuditEntry
+		err = tx.Where("actor_id = ? OR (entity_type = ? AND entity_id = ?)", userID, domain.AuditEntityUser, userID).
+			Order("created_at, id").
+			Find(&auditEntries).Error
+		if err != nil {
+			return fmt.Errorf("error getting audit entries: %w", err)
+		}
+		export.AuditEntries = ar.auditMapper.ModelListToDomain(auditEntries)
+		for i := range export.AuditEntries {
+			// The IP address of an admin who changed the account is not the user's data
+			if actorID := export.AuditEntries[i].ActorID; actorID == nil || *actorID != userID {
+				export.AuditEntries[i].IPAddress = ""
+			}
+		}
+
+		var deletion model.AccountDeletion
+		err = tx.Where("user_id = ?", userID).First(&deletion).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("error getting account deletion: %w", err)
+		}
+		if err == nil {
+			export.Deletion = ar.mapper.DeletionToDomain(&deletion)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+func (ar *AccountRepositoryImpl) CreateAccountDeletion(ctx context.Context, deletion *domain.AccountDeletion) error {
+	if err := ar.db.WithContext(ctx).Create(ar.mapper.DeletionToModel(deletion)).Error; err != nil {
+		return fmt.Errorf("failed to create account deletion: %w", err)
+	}
+	return nil
+}
+
+func (ar *AccountRepositoryImpl) GetAccountDeletion(ctx context.Context, userID string) (*domain.AccountDeletion, error) {
+	var deletion model.AccountDeletion
+	err := ar.db.WithContext(ctx).Where("user_id = ?", userID).First(&deletion).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting account deletion: %w", err)
+	}
+	return ar.mapper.DeletionToDomain(&deletion), nil
+}
+
+func (ar *AccountRepositoryImpl) DeleteAccountDeletion(ctx context.Context, userID string) (bool, error) {
+	result := ar.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.AccountDeletion{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete account deletion: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (ar *AccountRepositoryImpl) GetDueAccountDeletions(ctx context.Context, now time.Time) ([]domain.AccountDeletion, error) {
+	var deletions []model.AccountDeletion
+	err := ar.db.WithContext(ctx).
+		Where("scheduled_at <= ?", now).
+		Order("scheduled_at").
+		Find(&deletions).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting due account deletions: %w", err)
+	}
+	return ar.mapper.DeletionListToDomain(deletions), nil
+}
+
+func (ar *AccountRepositoryImpl) AnonymizeUser(ctx context.Context, user *domain.User) error {
+	return ar.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var previous model.User
+		if err := tx.Select("username").First(&previous, constants.QueryIDEquals, user.ID).Error; err != nil {
+			return fmt.Errorf("error getting user: %w", err)
+		}
+
+		modelUser := ar.userMapper.DomainToModel(user)
+		modelUser.Version = user.Version + 1
+		err := tx.Model(&model.User{}).
+			Where(constants.QueryIDEquals, user.ID).
+			Select("*").
+			Omit("id", "created_at").
+			Updates(modelUser).Error
+		if err != nil {
+			return fmt.Errorf("failed to anonymize user: %w", err)
+		}
+
+		err = tx.Model(&model.Player{}).
+			Where("user_id = ?", user.ID).
+			Updates(map[string]any{
+				"user_id": nil,
+				"version": gorm.Expr("version + 1"),
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to unlink player: %w", err)
+		}
+
+		// The anonymized user is kept, so the records that identify the person are removed here
+		err = tx.Where("api_key_id IN (?)", tx.Model(&model.APIKey{}).Select("id").Where("user_id = ?", user.ID)).
+			Delete(&model.APIKeyScope{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to delete API key scopes: %w", err)
+		}
+		userRecords := []struct {
+			name  string
+			model any
+		}{
+			{"API keys", &model.APIKey{}},
+			{"refresh tokens", &model.RefreshToken{}},
+			{"sessions", &model.Session{}},
+			{"identities", &model.UserIdentity{}},
+			{"password credential", &model.PasswordCredential{}},
+			{"password reset tokens", &model.PasswordResetToken{}},
+			{"profile changes", &model.UserProfileChange{}},
+			{"account deletion", &model.AccountDeletion{}},
+			{"player claims", &model.PlayerClaim{}},
+		}
+		for _, record := range userRecords {
+			if err := tx.Where("user_id = ?", user.ID).Delete(record.model).Error; err != nil {
+				return fmt.Errorf("failed to delete %s: %w", record.name, err)
+			}
+		}
+
+		if err := tx.Where("username = ?", previous.Username).Delete(&model.LoginAttempt{}).Error; err != nil {
+			return fmt.Errorf("failed to delete login attempts: %w", err)
+		}
+		if err := tx.Where("email = ?", previous.Username).Delete(&model.Invitation{}).Error; err != nil {
+			return fmt.Errorf("failed to delete invitations: %w", err)
+		}
+
+		// The audit log keeps what was changed and by whom, without the person behind the account.
+		// The fields are sent as an array literal, since GORM expands slices into value lists.
+		personalFields := "{" + strings.Join(domain.UserPersonalFields, ",") + "}"
+		err = tx.Model(&model.AuditEntry{}).
+			Where("entity_type = ? AND entity_id = ?", domain.AuditEntityUser, user.ID).
+			Updates(map[string]any{
+				"before": gorm.Expr("before - ?::text[]", personalFields),
+				"after":  gorm.Expr("after - ?::text[]", personalFields),
+				"changes": gorm.Expr(`COALESCE((
+					SELECT jsonb_agg(CASE WHEN change->>'field' = ANY(?::text[]) THEN change - 'old' - 'new' ELSE change END ORDER BY position)
+					FROM jsonb_array_elements(changes) WITH ORDINALITY AS elements(change, position)
+				), '[]'::jsonb)`, personalFields),
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to redact audit entries: %w", err)
+		}
+		err = tx.Model(&model.AuditEntry{}).
+			Where("actor_id = ?", user.ID).
+			Update("ip_address", "").Error
+		if err != nil {
+			return fmt.Errorf("failed to redact audit entry IP addresses: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,94 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+)
+
+// APIKeyRepositoryImpl implements domain.APIKeyRepository interface.
+type APIKeyRepositoryImpl struct {
+	db     *gorm.DB
+	mapper *persistence.APIKeyPersistenceMapper
+}
+
+func NewAPIKeyRepository(db *gorm.DB) domain.APIKeyRepository {
+	return &APIKeyRepositoryImpl{
+		db:     db,
+		mapper: persistence.NewAPIKeyPersistenceMapper(),
+	}
+}
+
+func (ar *APIKeyRepositoryImpl) CreateAPIKey(ctx context.Context, apiKey *domain.APIKey) error {
+	modelAPIKey := ar.mapper.ToModel(apiKey)
+	if err := ar.db.WithContext(ctx).Create(modelAPIKey).Error; err != nil {
+		return fmt.Errorf("failed to create API key: %w", err)
+	}
+	apiKey.ID = modelAPIKey.ID
+	apiKey.CreatedAt = modelAPIKey.CreatedAt
+	return nil
+}
+
+func (ar *APIKeyRepositoryImpl) GetAPIKeyByID(ctx context.Context, id string) (*domain.APIKey, error) {
+	return ar.getAPIKey(ctx, "id = ?", id)
+}
+
+func (ar *APIKeyRepositoryImpl) GetAPIKeyByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error) {
+	return ar.getAPIKey(ctx, "prefix = ?", prefix)
+}
+
+func (ar *APIKeyRepositoryImpl) GetAPIKeysByUserID(ctx context.Context, userID string) ([]domain.APIKey, error) {
+	var apiKeys []model.APIKey
+	err := ar.db.WithContext(ctx).
+		Preload("Scopes").
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Order("created_at DESC").
+		Find(&apiKeys).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching API keys: %w", err)
+	}
+	return ar.mapper.ToDomainList(apiKeys), nil
+}
+
+func (ar *APIKeyRepositoryImpl) RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) error {
+	err := ar.db.WithContext(ctx).
+		Model(&model.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", revokedAt).Error
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+	return nil
+}
+
+func (ar *APIKeyRepositoryImpl) TouchAPIKey(ctx context.Context, id string, lastUsedAt time.Time) error {
+	err := ar.db.WithContext(ctx).
+		Model(&model.APIKey{}).
+		Where("id = ?", id).
+		Update("last_used_at", lastUsedAt).Error
+	if err != nil {
+		return fmt.Errorf("failed to update API key usage: %w", err)
+	}
+	return nil
+}
+
+// getAPIKey returns the first key matching the condition together with its scopes.
+func (ar *APIKeyRepositoryImpl) getAPIKey(ctx context.Context, query string, arg string) (*domain.APIKey, error) {
+	var apiKey model.APIKey
+	result := ar.db.WithContext(ctx).Preload("Scopes").Where(query, arg).First(&apiKey)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting API key: %w", result.Error)
+	}
+
+	return ar.mapper.ToDomain(&apiKey), nil
+}
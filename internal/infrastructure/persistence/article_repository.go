@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
 	"gorm.io/gorm"
@@ -138,16 +139,36 @@ func (ar *ArticleRepositoryImpl) GetArticlesBySeasonID(ctx context.Context, seas
 
 func (ar *ArticleRepositoryImpl) CreateArticle(ctx context.Context, article *domain.Article) error {
 	modelArticle := ar.mapper.DomainToModel(article)
-	return ar.db.WithContext(ctx).Create(modelArticle).Error
+	if err := ar.db.WithContext(ctx).Create(modelArticle).Error; err != nil {
+		return err
+	}
+
+	// Update domain entity with generated ID and timestamps
+	article.ID = modelArticle.ID
+	article.CreatedAt = modelArticle.CreatedAt
+	article.Version = modelArticle.Version
+	article.UpdatedAt = modelArticle.UpdatedAt
+	return nil
 }
 
 func (ar *ArticleRepositoryImpl) UpdateArticle(ctx context.Context, id uint64, article *domain.Article) error {
 	modelArticle := ar.mapper.DomainToModel(article)
-	return ar.db.WithContext(ctx).
+	modelArticle.Version = article.Version + 1
+	result := ar.db.WithContext(ctx).
 		Model(&model.Article{}).
 		Where(whereIDClause, id).
+		Where(constants.QueryVersionEquals, article.Version).
 		Select("*").
-		Updates(modelArticle).Error
+		Updates(modelArticle)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// Another request changed the record since it was read
+		return constants.ErrVersionMismatch
+	}
+	article.Version = modelArticle.Version
+	return nil
 }
 
 func (ar *ArticleRepositoryImpl) DeleteArticle(ctx context.Context, id uint64) error {
@@ -0,0 +1,72 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	persistenceMapper "github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+)
+
+// AuditRepositoryImpl implements domain.AuditRepository interface.
+type AuditRepositoryImpl struct {
+	db     *gorm.DB
+	mapper *persistenceMapper.AuditPersistenceMapper
+}
+
+func NewAuditRepository(db *gorm.DB) domain.AuditRepository {
+	return &AuditRepositoryImpl{
+		db:     db,
+		mapper: persistenceMapper.NewAuditPersistenceMapper(),
+	}
+}
+
+func (ar *AuditRepositoryImpl) CreateAuditEntry(ctx context.Context, entry *domain.AuditEntry) error {
+	entryModel, err := ar.mapper.DomainToModel(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+	if err := ar.db.WithContext(ctx).Create(entryModel).Error; err != nil {
+		return fmt.Errorf("failed to create audit entry: %w", err)
+	}
+	entry.ID = entryModel.ID
+	entry.CreatedAt = entryModel.CreatedAt
+	return nil
+}
+
+func (ar *AuditRepositoryImpl) GetPaginatedAuditEntries(ctx context.Context, filter domain.AuditFilter, page int, pageSize int) ([]domain.AuditEntry, int64, error) {
+	applyFilter := func(query *gorm.DB) *gorm.DB {
+		if filter.EntityType != "" {
+			query = query.Where("entity_type = ?", filter.EntityType)
+		}
+		if filter.EntityID != "" {
+			query = query.Where("entity_id = ?", filter.EntityID)
+		}
+		if filter.ActorID != "" {
+			query = query.Where("actor_id = ?", filter.ActorID)
+		}
+		if filter.Action != "" {
+			query = query.Where("action = ?", filter.Action)
+		}
+		return query
+	}
+
+	var total int64
+	if err := applyFilter(ar.db.WithContext(ctx).Model(&model.AuditEntry{})).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("error counting total audit entries: %w", err)
+	}
+
+	var entries []model.AuditEntry
+	err := applyFilter(ar.db.WithContext(ctx).Model(&model.AuditEntry{})).
+		Preload("Actor").
+		Order("created_at DESC, id DESC").
+		Offset(page * pageSize).
+		Limit(pageSize).
+		Find(&entries).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("error fetching audit entries: %w", err)
+	}
+	return ar.mapper.ModelListToDomain(entries), total, nil
+}
@@ -5,36 +5,33 @@ import (
 
 	"github.com/EdwinRincon/browersfc-api/adapter/mapper"
 	"github.com/EdwinRincon/browersfc-api/api/constants"
-	"github.com/EdwinRincon/browersfc-api/config"
 	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/EdwinRincon/browersfc-api/pkg/jwt"
+	"github.com/EdwinRincon/browersfc-api/pkg/security"
 )
 
 // AuthenticationRepository implements domain.AuthenticationRepository interface.
 // It handles the infrastructure concerns for authentication operations.
 type AuthenticationRepository struct {
 	jwtService     *jwt.JWTService
+	validator      *jwt.TokenValidator
 	mapper         *mapper.AuthenticationMapper
 	roleRepository domain.RoleRepository
 }
 
 // NewAuthenticationRepository creates a new AuthenticationRepository instance.
-func NewAuthenticationRepository(roleRepository domain.RoleRepository) *AuthenticationRepository {
-	// Get JWT secret from configuration
-	jwtSecret, err := config.GetJWTSecret()
-	if err != nil {
-		panic("Failed to get JWT secret: " + err.Error())
-	}
-
+// Keys are loaded once at startup and shared by the signer and the validator.
+func NewAuthenticationRepository(roleRepository domain.RoleRepository, jwtService *jwt.JWTService) *AuthenticationRepository {
 	return &AuthenticationRepository{
-		jwtService:     jwt.NewJWTService(string(jwtSecret)),
+		jwtService:     jwtService,
+		validator:      jwt.NewTokenValidator(jwtService.Keys),
 		mapper:         mapper.NewAuthenticationMapper(),
 		roleRepository: roleRepository,
 	}
 }
 
-// GenerateAccessToken creates a new JWT access token for the given user.
-func (r *AuthenticationRepository) GenerateAccessToken(ctx context.Context, user *domain.User) (string, error) {
+// GenerateAccessToken creates a new JWT access token for the given user and session.
+func (r *AuthenticationRepository) GenerateAccessToken(ctx context.Context, user *domain.User, sessionID string) (string, error) {
 	if user == nil {
 		return "", constants.ErrInvalidData
 	}
@@ -49,8 +46,83 @@ func (r *AuthenticationRepository) GenerateAccessToken(ctx context.Context, user
 		return "", constants.ErrRecordNotFound
 	}
 
-	// Generate token using the actual role name from database
-	return r.jwtService.GenerateToken(user.Username, role.Name)
+	// Generate token using the actual role name and permissions from database
+	return r.jwtService.GenerateToken(user.ID, user.Username, role.Name, sessionID, role.EffectivePermissions())
+}
+
+// GenerateRefreshToken creates a new opaque refresh token together with the hash to store.
+func (r *AuthenticationRepository) GenerateRefreshToken() (string, string, error) {
+	token, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return "", "", err
+	}
+	return token, security.HashToken(token), nil
+}
+
+// HashRefreshToken returns the hash under which a refresh token is stored.
+func (r *AuthenticationRepository) HashRefreshToken(token string) string {
+	return security.HashToken(token)
+}
+
+// GenerateAPIKey creates a new API key together with its lookup prefix and the hash to store.
+func (r *AuthenticationRepository) GenerateAPIKey() (string, string, string, error) {
+	key, prefix, err := security.GenerateAPIKey()
+	if err != nil {
+		return "", "", "", err
+	}
+	return key, prefix, security.HashToken(key), nil
+}
+
+// ParseAPIKey returns the lookup prefix and the hash of an API key.
+func (r *AuthenticationRepository) ParseAPIKey(key string) (string, string, bool) {
+	prefix, ok := security.ParseAPIKeyPrefix(key)
+	if !ok {
+		return "", "", false
+	}
+	return prefix, security.HashToken(key), true
+}
+
+// GeneratePasswordResetToken creates a new opaque password reset token together with the hash to store.
+func (r *AuthenticationRepository) GeneratePasswordResetToken() (string, string, error) {
+	token, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return "", "", err
+	}
+	return token, security.HashToken(token), nil
+}
+
+// HashPasswordResetToken returns the hash under which a password reset token is stored.
+func (r *AuthenticationRepository) HashPasswordResetToken(token string) string {
+	return security.HashToken(token)
+}
+
+// GenerateInvitationToken creates a new opaque invitation token together with the hash to store.
+func (r *AuthenticationRepository) GenerateInvitationToken() (string, string, error) {
+	token, err := security.GenerateOpaqueToken()
+	if err != nil {
+		return "", "", err
+	}
+	return token, security.HashToken(token), nil
+}
+
+// HashInvitationToken returns the hash under which an invitation token is stored.
+func (r *AuthenticationRepository) HashInvitationToken(token string) string {
+	return security.HashToken(token)
+}
+
+// HashPassword hashes a password with argon2id.
+func (r *AuthenticationRepository) HashPassword(password string) (string, error) {
+	return security.HashPassword(password)
+}
+
+// VerifyPassword checks a password against an argon2id or legacy bcrypt hash.
+func (r *AuthenticationRepository) VerifyPassword(passwordHash string, password string) (bool, bool, error) {
+	if passwordHash == "" {
+		// Spend the same time as a real check so unknown accounts cannot be told apart
+		security.VerifyDummyPassword(password)
+		return false, false, nil
+	}
+	return security.VerifyPassword(passwordHash, password)
 }
 
 // ValidateAccessToken validates a JWT token and returns authentication claims.
@@ -59,14 +131,7 @@ func (r *AuthenticationRepository) ValidateAccessToken(ctx context.Context, toke
 		return nil, constants.ErrInvalidData
 	}
 
-	// Create validator with JWT secret
-	jwtSecret, err := config.GetJWTSecret()
-	if err != nil {
-		return nil, err
-	}
-
-	validator := jwt.NewTokenValidator(jwtSecret)
-	appClaims, err := validator.ValidateToken(token)
+	appClaims, err := r.validator.ValidateToken(token)
 	if err != nil {
 		return nil, err
 	}
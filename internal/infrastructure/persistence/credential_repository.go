@@ -0,0 +1,117 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CredentialRepositoryImpl implements domain.CredentialRepository interface.
+type CredentialRepositoryImpl struct {
+	db     *gorm.DB
+	mapper *persistence.CredentialPersistenceMapper
+}
+
+func NewCredentialRepository(db *gorm.DB) domain.CredentialRepository {
+	return &CredentialRepositoryImpl{
+		db:     db,
+		mapper: persistence.NewCredentialPersistenceMapper(),
+	}
+}
+
+func (cr *CredentialRepositoryImpl) GetPasswordCredential(ctx context.Context, userID string) (*domain.PasswordCredential, error) {
+	var credential model.PasswordCredential
+	result := cr.db.WithContext(ctx).Where("user_id = ?", userID).First(&credential)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting password credential: %w", result.Error)
+	}
+
+	return cr.mapper.ToDomain(&credential), nil
+}
+
+func (cr *CredentialRepositoryImpl) SavePasswordCredential(ctx context.Context, credential *domain.PasswordCredential) error {
+	if err := savePasswordCredential(cr.db.WithContext(ctx), cr.mapper.ToModel(credential)); err != nil {
+		return fmt.Errorf("failed to save password credential: %w", err)
+	}
+	return nil
+}
+
+func (cr *CredentialRepositoryImpl) DeletePasswordCredential(ctx context.Context, userID string) error {
+	err := cr.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&model.PasswordCredential{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete password credential: %w", err)
+	}
+	return nil
+}
+
+func (cr *CredentialRepositoryImpl) CreatePasswordResetToken(ctx context.Context, token *domain.PasswordResetToken) error {
+	modelToken := cr.mapper.ResetTokenToModel(token)
+	if err := cr.db.WithContext(ctx).Create(modelToken).Error; err != nil {
+		return fmt.Errorf("failed to create password reset token: %w", err)
+	}
+	token.ID = modelToken.ID
+	token.CreatedAt = modelToken.CreatedAt
+	return nil
+}
+
+func (cr *CredentialRepositoryImpl) GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+	var token model.PasswordResetToken
+	result := cr.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&token)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting password reset token: %w", result.Error)
+	}
+
+	return cr.mapper.ResetTokenToDomain(&token), nil
+}
+
+func (cr *CredentialRepositoryImpl) ResetPassword(ctx context.Context, tokenID uint64, usedAt time.Time, credential *domain.PasswordCredential) error {
+	return cr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The conditional update makes concurrent redemptions of the same token fail
+		result := tx.Model(&model.PasswordResetToken{}).
+			Where("id = ? AND used_at IS NULL", tokenID).
+			Update("used_at", usedAt)
+		if result.Error != nil {
+			return fmt.Errorf("failed to mark password reset token as used: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return constants.ErrInvalidResetToken
+		}
+
+		if err := savePasswordCredential(tx, cr.mapper.ToModel(credential)); err != nil {
+			return fmt.Errorf("failed to save password credential: %w", err)
+		}
+		return nil
+	})
+}
+
+func (cr *CredentialRepositoryImpl) DeleteExpiredPasswordResetTokens(ctx context.Context, before time.Time) (int64, error) {
+	result := cr.db.WithContext(ctx).Where("expires_at < ?", before).Delete(&model.PasswordResetToken{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete expired password reset tokens: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// savePasswordCredential inserts the credential or replaces the stored hash.
+func savePasswordCredential(db *gorm.DB, credential *model.PasswordCredential) error {
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"password_hash", "updated_at"}),
+	}).Create(credential).Error
+}
@@ -0,0 +1,64 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	persistenceMapper "github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+)
+
+// EmailDomainRuleRepositoryImpl implements domain.EmailDomainRuleRepository interface.
+type EmailDomainRuleRepositoryImpl struct {
+	db     *gorm.DB
+	mapper *persistenceMapper.EmailDomainRulePersistenceMapper
+}
+
+func NewEmailDomainRuleRepository(db *gorm.DB) domain.EmailDomainRuleRepository {
+	return &EmailDomainRuleRepositoryImpl{
+		db:     db,
+		mapper: persistenceMapper.NewEmailDomainRulePersistenceMapper(),
+	}
+}
+
+func (er *EmailDomainRuleRepositoryImpl) GetEmailDomainRules(ctx context.Context) ([]domain.EmailDomainRule, error) {
+	var rules []model.EmailDomainRule
+	if err := er.db.WithContext(ctx).Order("domain").Find(&rules).Error; err != nil {
+		return nil, fmt.Errorf("error getting email domain rules: %w", err)
+	}
+	return er.mapper.ModelListToDomain(rules), nil
+}
+
+func (er *EmailDomainRuleRepositoryImpl) ReplaceEmailDomainRules(ctx context.Context, previous []domain.EmailDomainRule, rules []domain.EmailDomainRule) error {
+	return er.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(previous) > 0 {
+			ids := make([]uint64, len(previous))
+			for i, rule := range previous {
+				ids[i] = rule.ID
+			}
+			result := tx.Where("id IN ?", ids).Delete(&model.EmailDomainRule{})
+			if result.Error != nil {
+				return fmt.Errorf("failed to delete email domain rules: %w", result.Error)
+			}
+			if result.RowsAffected != int64(len(previous)) {
+				// Another request replaced the rules since they were read
+				return constants.ErrVersionMismatch
+			}
+		}
+		if len(rules) == 0 {
+			return nil
+		}
+
+		ruleModels := make([]*model.EmailDomainRule, len(rules))
+		for i := range rules {
+			ruleModels[i] = er.mapper.DomainToModel(&rules[i])
+		}
+		if err := tx.Create(ruleModels).Error; err != nil {
+			return fmt.Errorf("failed to create email domain rules: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,95 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// IdempotencyStoreImpl implements domain.IdempotencyStore in the database so that a retry
+// is recognized whichever API replica receives it.
+type IdempotencyStoreImpl struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyStore(db *gorm.DB) domain.IdempotencyStore {
+	return &IdempotencyStoreImpl{db: db}
+}
+
+func (is *IdempotencyStoreImpl) ClaimIdempotencyKey(ctx context.Context, request *domain.IdempotentRequest, now time.Time) (*domain.IdempotentRequest, error) {
+	// An expired request is overwritten in the same statement, so only one of two
+	// concurrent requests can claim the key
+	result := is.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "request_key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"fingerprint", "status_code", "content_type", "location", "etag", "body", "body_withheld", "expires_at", "created_at"}),
+		Where:     clause.Where{Exprs: []clause.Expression{gorm.Expr("idempotent_requests.expires_at <= ?", now)}},
+	}).Create(&model.IdempotentRequest{
+		RequestKey:  request.Key,
+		Fingerprint: request.Fingerprint,
+		ExpiresAt:   request.ExpiresAt,
+	})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to claim idempotency key: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return nil, nil
+	}
+
+	var existing model.IdempotentRequest
+	err := is.db.WithContext(ctx).Where("request_key = ?", request.Key).Take(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// The first request released the key in the meantime; report it as pending so the client retries
+		return &domain.IdempotentRequest{Key: request.Key, Fingerprint: request.Fingerprint}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	return &domain.IdempotentRequest{
+		Key:          existing.RequestKey,
+		Fingerprint:  existing.Fingerprint,
+		StatusCode:   existing.StatusCode,
+		ContentType:  existing.ContentType,
+		Location:     existing.Location,
+		ETag:         existing.ETag,
+		Body:         existing.Body,
+		BodyWithheld: existing.BodyWithheld,
+		ExpiresAt:    existing.ExpiresAt,
+	}, nil
+}
+
+func (is *IdempotencyStoreImpl) CompleteIdempotentRequest(ctx context.Context, request *domain.IdempotentRequest) error {
+	err := is.db.WithContext(ctx).Model(&model.IdempotentRequest{}).Where("request_key = ?", request.Key).Updates(map[string]interface{}{
+		"status_code":   request.StatusCode,
+		"content_type":  request.ContentType,
+		"location":      request.Location,
+		"etag":          request.ETag,
+		"body":          request.Body,
+		"body_withheld": request.BodyWithheld,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to store idempotent response: %w", err)
+	}
+	return nil
+}
+
+func (is *IdempotencyStoreImpl) ReleaseIdempotencyKey(ctx context.Context, key string) error {
+	if err := is.db.WithContext(ctx).Where("request_key = ?", key).Delete(&model.IdempotentRequest{}).Error; err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (is *IdempotencyStoreImpl) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error) {
+	result := is.db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&model.IdempotentRequest{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
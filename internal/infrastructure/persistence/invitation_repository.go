@@ -0,0 +1,169 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	persistenceMapper "github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+)
+
+// pendingInvitation matches the invitations that can still be accepted at a given time
+const pendingInvitation = "accepted_at IS NULL AND revoked_at IS NULL AND expires_at > ?"
+
+// InvitationRepositoryImpl implements domain.InvitationRepository interface.
+type InvitationRepositoryImpl struct {
+	db             *gorm.DB
+	mapper         *persistenceMapper.InvitationPersistenceMapper
+	userMapper     *persistenceMapper.UserPersistenceMapper
+	identityMapper *persistenceMapper.UserIdentityPersistenceMapper
+}
+
+func NewInvitationRepository(db *gorm.DB) domain.InvitationRepository {
+	return &InvitationRepositoryImpl{
+		db:             db,
+		mapper:         persistenceMapper.NewInvitationPersistenceMapper(),
+		userMapper:     persistenceMapper.NewUserPersistenceMapper(),
+		identityMapper: persistenceMapper.NewUserIdentityPersistenceMapper(),
+	}
+}
+
+func (ir *InvitationRepositoryImpl) CreateInvitation(ctx context.Context, invitation *domain.Invitation) error {
+	invitationModel := ir.mapper.DomainToModel(invitation)
+	err := ir.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		err := tx.Model(&model.Invitation{}).
+			Where("email = ? AND "+pendingInvitation, invitation.Email, now).
+			Update("revoked_at", now).Error
+		if err != nil {
+			return fmt.Errorf("failed to revoke previous invitations: %w", err)
+		}
+		return tx.Create(invitationModel).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create invitation: %w", err)
+	}
+	invitation.ID = invitationModel.ID
+	invitation.CreatedAt = invitationModel.CreatedAt
+	return nil
+}
+
+func (ir *InvitationRepositoryImpl) GetInvitationByID(ctx context.Context, id uint64) (*domain.Invitation, error) {
+	return ir.getInvitation(ctx, "id = ?", id)
+}
+
+func (ir *InvitationRepositoryImpl) GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*domain.Invitation, error) {
+	return ir.getInvitation(ctx, "token_hash = ?", tokenHash)
+}
+
+func (ir *InvitationRepositoryImpl) getInvitation(ctx context.Context, query string, arg any) (*domain.Invitation, error) {
+	var invitation model.Invitation
+	err := ir.db.WithContext(ctx).
+		Preload("Role").
+		Preload("Team").
+		Where(query, arg).
+		First(&invitation).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting invitation: %w", err)
+	}
+	return ir.mapper.ModelToDomain(&invitation), nil
+}
+
+func (ir *InvitationRepositoryImpl) GetPaginatedInvitations(ctx context.Context, filter domain.InvitationFilter, now time.Time, page int, pageSize int) ([]domain.Invitation, int64, error) {
+	applyFilter := func(query *gorm.DB) *gorm.DB {
+		switch filter.Status {
+		case domain.InvitationStatusPending:
+			query = query.Where(pendingInvitation, now)
+		case domain.InvitationStatusAccepted:
+			query = query.Where("accepted_at IS NOT NULL")
+		case domain.InvitationStatusRevoked:
+			query = query.Where("accepted_at IS NULL AND revoked_at IS NOT NULL")
+		case domain.InvitationStatusExpired:
+			query = query.Where("accepted_at IS NULL AND revoked_at IS NULL AND expires_at <= ?", now)
+		}
+		if filter.Email != "" {
+			query = query.Where("email = ?", filter.Email)
+		}
+		return query
+	}
+
+	var total int64
+	if err := applyFilter(ir.db.WithContext(ctx).Model(&model.Invitation{})).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("error counting total invitations: %w", err)
+	}
+
+	var invitations []model.Invitation
+	err := applyFilter(ir.db.WithContext(ctx).Model(&model.Invitation{})).
+		Preload("Role").
+		Preload("Team").
+		Order("created_at DESC, id DESC").
+		Offset(page * pageSize).
+		Limit(pageSize).
+		Find(&invitations).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("error fetching invitations: %w", err)
+	}
+	return ir.mapper.ModelListToDomain(invitations), total, nil
+}
+
+func (ir *InvitationRepositoryImpl) RevokeInvitation(ctx context.Context, id uint64, revokedAt time.Time) error {
+	result := ir.db.WithContext(ctx).
+		Model(&model.Invitation{}).
+		Where("id = ? AND accepted_at IS NULL AND revoked_at IS NULL", id).
+		Update("revoked_at", revokedAt)
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke invitation: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return constants.ErrInvitationNotUsable
+	}
+	return nil
+}
+
+func (ir *InvitationRepositoryImpl) DeleteInvitation(ctx context.Context, id uint64) error {
+	if err := ir.db.WithContext(ctx).Delete(&model.Invitation{}, id).Error; err != nil {
+		return fmt.Errorf("failed to delete invitation: %w", err)
+	}
+	return nil
+}
+
+func (ir *InvitationRepositoryImpl) AcceptInvitation(ctx context.Context, invitationID uint64, user *domain.User, identity *domain.UserIdentity, acceptedAt time.Time) error {
+	userModel := ir.userMapper.DomainToModel(user)
+	err := ir.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(userModel).Error; err != nil {
+			return fmt.Errorf("failed to create user: %w", err)
+		}
+
+		// Consume the invitation only if it is still pending, so a token is accepted once
+		result := tx.Model(&model.Invitation{}).
+			Where("id = ? AND "+pendingInvitation, invitationID, acceptedAt).
+			Updates(map[string]any{"accepted_at": acceptedAt, "accepted_by_id": userModel.ID})
+		if result.Error != nil {
+			return fmt.Errorf("failed to accept invitation: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return constants.ErrInvitationNotUsable
+		}
+
+		identity.UserID = userModel.ID
+		if err := tx.Create(ir.identityMapper.ToModel(identity)).Error; err != nil {
+			return fmt.Errorf("failed to create user identity: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	user.ID = userModel.ID
+	user.CreatedAt = userModel.CreatedAt
+	user.UpdatedAt = userModel.UpdatedAt
+	return nil
+}
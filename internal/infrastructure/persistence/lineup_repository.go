@@ -26,7 +26,16 @@ func NewLineupRepository(db *gorm.DB) domain.LineupRepository {
 
 func (r *LineupRepositoryImpl) CreateLineup(ctx context.Context, lineup *domain.Lineup) error {
 	lineupModel := r.mapper.DomainToModel(lineup)
-	return r.db.WithContext(ctx).Create(lineupModel).Error
+	if err := r.db.WithContext(ctx).Create(lineupModel).Error; err != nil {
+		return err
+	}
+
+	// Update domain entity with generated ID and timestamps
+	lineup.ID = lineupModel.ID
+	lineup.CreatedAt = lineupModel.CreatedAt
+	lineup.Version = lineupModel.Version
+	lineup.UpdatedAt = lineupModel.UpdatedAt
+	return nil
 }
 
 func (r *LineupRepositoryImpl) GetLineupByID(ctx context.Context, id uint64) (*domain.Lineup, error) {
@@ -71,6 +80,7 @@ func (r *LineupRepositoryImpl) GetLineupsByPlayerID(ctx context.Context, playerI
 		Preload(constants.PreloadMatchHomeTeam).
 		Preload(constants.PreloadMatchAwayTeam).
 		Where("player_id = ?", playerID).
+		Where(constants.QueryMatchNotDeleted).
 		Find(&lineupModels)
 
 	if result.Error != nil {
@@ -85,13 +95,14 @@ func (r *LineupRepositoryImpl) GetPaginatedLineups(ctx context.Context, sort str
 	var total int64
 
 	// Count total records
-	countQuery := r.db.WithContext(ctx).Model(&model.Lineup{})
+	countQuery := r.db.WithContext(ctx).Model(&model.Lineup{}).Where(constants.QueryMatchNotDeleted)
 	if err := countQuery.Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("error counting total lineups: %w", err)
 	}
 
 	// Build the data query with eager loading
 	query := r.db.WithContext(ctx).Model(&model.Lineup{}).
+		Where(constants.QueryMatchNotDeleted).
 		Preload("Player").
 		Preload("Match").
 		Preload(constants.PreloadMatchHomeTeam).
@@ -123,11 +134,22 @@ func (r *LineupRepositoryImpl) GetPaginatedLineups(ctx context.Context, sort str
 
 func (r *LineupRepositoryImpl) UpdateLineup(ctx context.Context, id uint64, lineup *domain.Lineup) error {
 	lineupModel := r.mapper.DomainToModel(lineup)
-	return r.db.WithContext(ctx).
+	lineupModel.Version = lineup.Version + 1
+	result := r.db.WithContext(ctx).
 		Model(&model.Lineup{}).
 		Where(constants.QueryIDEquals, id).
+		Where(constants.QueryVersionEquals, lineup.Version).
 		Select("*").
-		Updates(lineupModel).Error
+		Updates(lineupModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// Another request changed the record since it was read
+		return constants.ErrVersionMismatch
+	}
+	lineup.Version = lineupModel.Version
+	return nil
 }
 
 func (r *LineupRepositoryImpl) DeleteLineup(ctx context.Context, id uint64) error {
@@ -0,0 +1,75 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+)
+
+// LoginAttemptRepositoryImpl implements domain.LoginAttemptRepository interface.
+type LoginAttemptRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewLoginAttemptRepository(db *gorm.DB) domain.LoginAttemptRepository {
+	return &LoginAttemptRepositoryImpl{db: db}
+}
+
+func (lr *LoginAttemptRepositoryImpl) StartLoginAttempt(ctx context.Context, attempt *domain.LoginAttempt, since time.Time, maxFailures int) error {
+	return lr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Serialize the attempts of the address, so each one counts the attempts started before it
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", "login_attempts:"+attempt.IPAddress).Error; err != nil {
+			return fmt.Errorf("failed to lock login attempts: %w", err)
+		}
+
+		modelAttempt := &model.LoginAttempt{
+			IPAddress: attempt.IPAddress,
+			Username:  attempt.Username,
+			Succeeded: false,
+			CreatedAt: attempt.CreatedAt,
+		}
+		if err := tx.Create(modelAttempt).Error; err != nil {
+			return fmt.Errorf("failed to record login attempt: %w", err)
+		}
+
+		var failures int64
+		err := tx.Model(&model.LoginAttempt{}).
+			Where("ip_address = ? AND succeeded = ? AND created_at > ?", attempt.IPAddress, false, since).
+			Count(&failures).Error
+		if err != nil {
+			return fmt.Errorf("failed to count failed login attempts: %w", err)
+		}
+		if failures > int64(maxFailures) {
+			// Rolls the attempt back, so the lockout ends once the real failures age out
+			return constants.ErrTooManyLoginAttempts
+		}
+
+		attempt.ID = modelAttempt.ID
+		attempt.Succeeded = false
+		return nil
+	})
+}
+
+func (lr *LoginAttemptRepositoryImpl) MarkLoginAttemptSucceeded(ctx context.Context, id uint64) error {
+	err := lr.db.WithContext(ctx).
+		Model(&model.LoginAttempt{}).
+		Where("id = ?", id).
+		Update("succeeded", true).Error
+	if err != nil {
+		return fmt.Errorf("failed to record successful login attempt: %w", err)
+	}
+	return nil
+}
+
+func (lr *LoginAttemptRepositoryImpl) DeleteLoginAttemptsBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := lr.db.WithContext(ctx).Where("created_at < ?", before).Delete(&model.LoginAttempt{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete login attempts: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
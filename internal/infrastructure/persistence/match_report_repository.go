@@ -0,0 +1,358 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MatchReportRepositoryImpl implements domain.MatchReportRepository interface.
+type MatchReportRepositoryImpl struct {
+	db          *gorm.DB
+	mapper      *persistence.MatchReportPersistenceMapper
+	statsMapper *persistence.PlayerStatsPersistenceMapper
+}
+
+func NewMatchReportRepository(db *gorm.DB) domain.MatchReportRepository {
+	return &MatchReportRepositoryImpl{
+		db:          db,
+		mapper:      persistence.NewMatchReportPersistenceMapper(),
+		statsMapper: persistence.NewPlayerStatsPersistenceMapper(),
+	}
+}
+
+func (mrr *MatchReportRepositoryImpl) CreateMatchReport(ctx context.Context, report *domain.MatchReport) error {
+	modelReport := mrr.mapper.DomainToModel(report)
+	if err := mrr.db.WithContext(ctx).Create(modelReport).Error; err != nil {
+		return fmt.Errorf("failed to create match report: %w", err)
+	}
+
+	// Update domain entity with generated IDs and timestamps
+	*report = *mrr.mapper.ModelToDomain(modelReport)
+	return nil
+}
+
+// GetMatchReportByID retrieves a report with its match, submitter and events
+func (mrr *MatchReportRepositoryImpl) GetMatchReportByID(ctx context.Context, id uint64) (*domain.MatchReport, error) {
+	var report model.MatchReport
+	result := mrr.db.WithContext(ctx).
+		Preload("Match").
+		Preload("Match.HomeTeam").
+		Preload("Match.AwayTeam").
+		Preload("SubmittedBy").
+		Preload("Events", func(db *gorm.DB) *gorm.DB {
+			return db.Order("minute ASC, id ASC")
+		}).
+		Preload("Events.Player").
+		Where(constants.QueryIDEquals, id).
+		First(&report)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting match report by ID: %w", result.Error)
+	}
+
+	return mrr.mapper.ModelToDomain(&report), nil
+}
+
+// GetPaginatedMatchReports retrieves a filtered, paginated list of reports with total count.
+func (mrr *MatchReportRepositoryImpl) GetPaginatedMatchReports(ctx context.Context, filter domain.MatchReportFilter, sort string, order string, page int, pageSize int) ([]domain.MatchReport, int64, error) {
+	var reports []model.MatchReport
+	var total int64
+
+	applyFilter := func(query *gorm.DB) *gorm.DB {
+		if filter.Status != "" {
+			query = query.Where("match_reports.status = ?", filter.Status)
+		}
+		if filter.MatchID != 0 {
+			query = query.Where("match_reports.match_id = ?", filter.MatchID)
+		}
+		if filter.SubmittedByID != "" {
+			query = query.Where("match_reports.submitted_by_id = ?", filter.SubmittedByID)
+		}
+		return query
+	}
+
+	// Count total records
+	countQuery := applyFilter(mrr.db.WithContext(ctx).Model(&model.MatchReport{}))
+	if err := countQuery.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("error counting total match reports: %w", err)
+	}
+
+	// Build the data query
+	query := applyFilter(mrr.db.WithContext(ctx).Model(&model.MatchReport{})).
+		Preload("Match").
+		Preload("Match.HomeTeam").
+		Preload("Match.AwayTeam").
+		Preload("SubmittedBy")
+
+	// Apply sorting (safe and validated)
+	col, raw, err := BuildOrderClause(EntityMatchReport, sort, order)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error building sort clause: %w", err)
+	}
+
+	if raw != "" {
+		query = query.Order(raw)
+	} else {
+		query = query.Order(col)
+	}
+
+	// Apply pagination
+	offset := page * pageSize
+	query = query.Offset(offset).Limit(pageSize)
+
+	// Execute the query
+	if err := query.Find(&reports).Error; err != nil {
+		return nil, 0, fmt.Errorf("error fetching match reports: %w", err)
+	}
+
+	return mrr.mapper.ModelListToDomain(reports), total, nil
+}
+
+// HasApprovedReport reports whether the match already has an approved report
+func (mrr *MatchReportRepositoryImpl) HasApprovedReport(ctx context.Context, matchID uint64) (bool, error) {
+	var total int64
+	err := mrr.db.WithContext(ctx).
+		Model(&model.MatchReport{}).
+		Where("match_id = ? AND status = ?", matchID, domain.MatchReportStatusApproved).
+		Count(&total).Error
+	if err != nil {
+		return false, fmt.Errorf("error checking approved match reports: %w", err)
+	}
+	return total > 0, nil
+}
+
+// UpdateMatchReport replaces the report content and its events in a single transaction
+func (mrr *MatchReportRepositoryImpl) UpdateMatchReport(ctx context.Context, report *domain.MatchReport) error {
+	modelReport := mrr.mapper.DomainToModel(report)
+
+	return mrr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.MatchReport{}).
+			Where("id = ? AND status <> ?", report.ID, domain.MatchReportStatusApproved).
+			Select("status", "home_goals", "away_goals", "attendance", "incidents", "review_comments", "reviewed_by_id", "reviewed_at").
+			Updates(modelReport)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update match report: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return constants.ErrMatchReportApproved
+		}
+
+		if err := tx.Where("report_id = ?", report.ID).Delete(&model.MatchReportEvent{}).Error; err != nil {
+			return fmt.Errorf("failed to delete match report events: %w", err)
+		}
+
+		if len(modelReport.Events) > 0 {
+			if err := tx.Create(&modelReport.Events).Error; err != nil {
+				return fmt.Errorf("failed to create match report events: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// RejectMatchReport stores the review of a report that is still pending
+func (mrr *MatchReportRepositoryImpl) RejectMatchReport(ctx context.Context, report *domain.MatchReport) error {
+	result := mrr.db.WithContext(ctx).
+		Model(&model.MatchReport{}).
+		Where("id = ? AND status = ?", report.ID, domain.MatchReportStatusPending).
+		Updates(map[string]interface{}{
+			"status":          domain.MatchReportStatusRejected,
+			"review_comments": report.ReviewComments,
+			"reviewed_by_id":  report.ReviewedByID,
+			"reviewed_at":     report.ReviewedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to reject match report: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return constants.ErrMatchReportNotPending
+	}
+	return nil
+}
+
+// ApproveMatchReport marks the report approved and applies the match result,
+// player stats and standings changes atomically.
+func (mrr *MatchReportRepositoryImpl) ApproveMatchReport(ctx context.Context, approval *domain.MatchReportApproval) error {
+	report := approval.Report
+	match := approval.Match
+
+	return mrr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Guard against concurrent reviews: only a pending report can be approved
+		result := tx.Model(&model.MatchReport{}).
+			Where("id = ? AND status = ?", report.ID, domain.MatchReportStatusPending).
+			Updates(map[string]interface{}{
+				"status":          domain.MatchReportStatusApproved,
+				"review_comments": report.ReviewComments,
+				"reviewed_by_id":  report.ReviewedByID,
+				"reviewed_at":     report.ReviewedAt,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to approve match report: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return constants.ErrMatchReportNotPending
+		}
+
+		err := tx.Model(&model.Match{}).
+			Where(constants.QueryIDEquals, match.ID).
+			Updates(map[string]interface{}{
+				"home_goals": match.HomeGoals,
+				"away_goals": match.AwayGoals,
+				"version":    gorm.Expr("version + 1"),
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to update match result: %w", err)
+		}
+
+		if len(approval.PlayerStats) > 0 {
+			stats := make([]model.PlayerStat, len(approval.PlayerStats))
+			for i := range approval.PlayerStats {
+				stats[i] = *mrr.statsMapper.DomainToModel(&approval.PlayerStats[i])
+			}
+
+			err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "player_id"}, {Name: "match_id"}},
+				DoUpdates: append(clause.AssignmentColumns([]string{"team_id", "goals", "assists", "yellow_cards", "red_cards", "updated_at"}),
+					clause.Assignment{Column: clause.Column{Name: "version"}, Value: gorm.Expr("player_stats.version + 1")}),
+			}).Create(&stats).Error
+			if err != nil {
+				return fmt.Errorf("failed to save player stats: %w", err)
+			}
+		}
+
+		for _, change := range approval.Standings {
+			if err := applyStandingChange(tx, change); err != nil {
+				return err
+			}
+		}
+
+		if len(approval.Standings) > 0 {
+			if err := recalculateSeasonRanks(tx, match.SeasonID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// applyStandingChange adds the increments to the team's season standings row,
+// creating it when the team has no standings for the season yet.
+func applyStandingChange(tx *gorm.DB, change domain.StandingChange) error {
+	stat := model.TeamStat{
+		SeasonID:     change.SeasonID,
+		TeamID:       change.TeamID,
+		Wins:         change.Wins,
+		Draws:        change.Draws,
+		Losses:       change.Losses,
+		GoalsFor:     change.GoalsFor,
+		GoalsAgainst: change.GoalsAgainst,
+		Points:       change.Points,
+	}
+
+	err := tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "season_id"}, {Name: "team_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"wins":          gorm.Expr("team_stats.wins + EXCLUDED.wins"),
+			"draws":         gorm.Expr("team_stats.draws + EXCLUDED.draws"),
+			"losses":        gorm.Expr("team_stats.losses + EXCLUDED.losses"),
+			"goals_for":     gorm.Expr("team_stats.goals_for + EXCLUDED.goals_for"),
+			"goals_against": gorm.Expr("team_stats.goals_against + EXCLUDED.goals_against"),
+			"points":        gorm.Expr("team_stats.points + EXCLUDED.points"),
+			"updated_at":    gorm.Expr("EXCLUDED.updated_at"),
+			"version":       gorm.Expr("team_stats.version + 1"),
+		}),
+	}).Create(&stat).Error
+	if err != nil {
+		return fmt.Errorf("failed to update team standings: %w", err)
+	}
+	return nil
+}
+
+// revertStandingChange subtracts the increments from the team's season standings row.
+func revertStandingChange(tx *gorm.DB, change domain.StandingChange) error {
+	err := tx.Model(&model.TeamStat{}).
+		Where("season_id = ? AND team_id = ?", change.SeasonID, change.TeamID).
+		Updates(map[string]interface{}{
+			"wins":          gorm.Expr("wins - ?", change.Wins),
+			"draws":         gorm.Expr("draws - ?", change.Draws),
+			"losses":        gorm.Expr("losses - ?", change.Losses),
+			"goals_for":     gorm.Expr("goals_for - ?", change.GoalsFor),
+			"goals_against": gorm.Expr("goals_against - ?", change.GoalsAgainst),
+			"points":        gorm.Expr("points - ?", change.Points),
+			"version":       gorm.Expr("version + 1"),
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to revert team standings: %w", err)
+	}
+	return nil
+}
+
+// approvedStandings returns the standings changes that approving a report of the match
+// applied, or none when no report of the match was approved.
+func approvedStandings(tx *gorm.DB, match *model.Match) ([]domain.StandingChange, error) {
+	var report model.MatchReport
+	err := tx.Where("match_id = ? AND status = ?", match.ID, domain.MatchReportStatusApproved).First(&report).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting approved match report: %w", err)
+	}
+
+	approved := domain.MatchReport{HomeGoals: report.HomeGoals, AwayGoals: report.AwayGoals}
+	return approved.StandingsFor(&domain.Match{
+		SeasonID:   match.SeasonID,
+		HomeTeamID: match.HomeTeamID,
+		AwayTeamID: match.AwayTeamID,
+	}), nil
+}
+
+// setMatchStandings removes the approved result of the match from the standings when it is
+// moved to the trash, and adds it back when it is restored.
+func setMatchStandings(tx *gorm.DB, match *model.Match, counted bool) error {
+	changes, err := approvedStandings(tx, match)
+	if err != nil || len(changes) == 0 {
+		return err
+	}
+
+	for _, change := range changes {
+		if counted {
+			err = applyStandingChange(tx, change)
+		} else {
+			err = revertStandingChange(tx, change)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return recalculateSeasonRanks(tx, match.SeasonID)
+}
+
+// recalculateSeasonRanks reorders the season table by points, goal difference and goals scored
+func recalculateSeasonRanks(tx *gorm.DB, seasonID uint64) error {
+	err := tx.Exec(`
+		UPDATE team_stats SET rank = ranked.position
+		FROM (
+			SELECT id, ROW_NUMBER() OVER (
+				ORDER BY points DESC, (goals_for - goals_against) DESC, goals_for DESC, team_id ASC
+			) AS position
+			FROM team_stats
+			WHERE season_id = ?
+		) AS ranked
+		WHERE team_stats.id = ranked.id`, seasonID).Error
+	if err != nil {
+		return fmt.Errorf("failed to recalculate season ranks: %w", err)
+	}
+	return nil
+}
@@ -26,7 +26,16 @@ func NewMatchRepository(db *gorm.DB) domain.MatchRepository {
 
 func (mr *MatchRepositoryImpl) CreateMatch(ctx context.Context, match *domain.Match) error {
 	model := mr.mapper.DomainToModel(match)
-	return mr.db.WithContext(ctx).Create(model).Error
+	if err := mr.db.WithContext(ctx).Create(model).Error; err != nil {
+		return err
+	}
+
+	// Update domain entity with generated ID and timestamps
+	match.ID = model.ID
+	match.CreatedAt = model.CreatedAt
+	match.Version = model.Version
+	match.UpdatedAt = model.UpdatedAt
+	return nil
 }
 
 // GetMatchByID retrieves a match by its ID with basic preloads
@@ -37,6 +46,7 @@ func (mr *MatchRepositoryImpl) GetMatchByID(ctx context.Context, id uint64) (*do
 		Preload("HomeTeam").
 		Preload("AwayTeam").
 		Preload("MVPPlayer").
+		Preload("Venue").
 		Where(constants.QueryIDEquals, id).
 		First(&match)
 
@@ -57,6 +67,7 @@ func (mr *MatchRepositoryImpl) GetDetailedMatchByID(ctx context.Context, id uint
 		Preload("HomeTeam").
 		Preload("AwayTeam").
 		Preload("MVPPlayer").
+		Preload("Venue").
 		Preload("Lineups").
 		Preload("Lineups.Player").
 		Preload("PlayerStats").
@@ -131,6 +142,7 @@ func (mr *MatchRepositoryImpl) GetMatchesBySeasonID(ctx context.Context, seasonI
 		Preload("HomeTeam").
 		Preload("AwayTeam").
 		Preload("Season").
+		Preload("Venue").
 		Where("season_id = ?", seasonID)
 
 	// Apply sorting (safe and validated)
@@ -175,6 +187,7 @@ func (mr *MatchRepositoryImpl) GetMatchesByTeamID(ctx context.Context, teamID ui
 		Preload("HomeTeam").
 		Preload("AwayTeam").
 		Preload("Season").
+		Preload("Venue").
 		Where("home_team_id = ? OR away_team_id = ?", teamID, teamID)
 
 	// Apply sorting (safe and validated)
@@ -201,6 +214,49 @@ func (mr *MatchRepositoryImpl) GetMatchesByTeamID(ctx context.Context, teamID ui
 	return mr.mapper.ModelListToDomain(matches), total, nil
 }
 
+// GetMatchesByVenueID retrieves matches played at a specific venue with pagination
+func (mr *MatchRepositoryImpl) GetMatchesByVenueID(ctx context.Context, venueID uint64, sort string, order string, page int, pageSize int) ([]domain.Match, int64, error) {
+	var matches []model.Match
+	var total int64
+
+	// Count total records for this venue
+	countQuery := mr.db.WithContext(ctx).Model(&model.Match{}).Where("venue_id = ?", venueID)
+	if err := countQuery.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("error counting matches for venue: %w", err)
+	}
+
+	// Build the data query
+	query := mr.db.WithContext(ctx).Model(&model.Match{}).
+		Preload("HomeTeam").
+		Preload("AwayTeam").
+		Preload("Season").
+		Preload("Venue").
+		Where("venue_id = ?", venueID)
+
+	// Apply sorting (safe and validated)
+	col, raw, err := BuildOrderClause(EntityMatch, sort, order)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error building sort clause: %w", err)
+	}
+
+	if raw != "" {
+		query = query.Order(raw)
+	} else {
+		query = query.Order(col)
+	}
+
+	// Apply pagination
+	offset := page * pageSize
+	query = query.Offset(offset).Limit(pageSize)
+
+	// Execute the query
+	if err := query.Find(&matches).Error; err != nil {
+		return nil, 0, fmt.Errorf("error fetching matches by venue: %w", err)
+	}
+
+	return mr.mapper.ModelListToDomain(matches), total, nil
+}
+
 // GetNextMatchByTeamID retrieves the next scheduled match for a team
 func (mr *MatchRepositoryImpl) GetNextMatchByTeamID(ctx context.Context, teamID uint64) (*domain.Match, error) {
 	var match model.Match
@@ -208,6 +264,7 @@ func (mr *MatchRepositoryImpl) GetNextMatchByTeamID(ctx context.Context, teamID
 		Preload("HomeTeam").
 		Preload("AwayTeam").
 		Preload("Season").
+		Preload("Venue").
 		Where("(home_team_id = ? OR away_team_id = ?)", teamID, teamID).
 		Where("`date` >= CURRENT_DATE").
 		Where("status = ?", "scheduled").
@@ -226,12 +283,38 @@ func (mr *MatchRepositoryImpl) GetNextMatchByTeamID(ctx context.Context, teamID
 // UpdateMatch updates an existing match
 func (mr *MatchRepositoryImpl) UpdateMatch(ctx context.Context, id uint64, match *domain.Match) error {
 	modelMatch := mr.mapper.DomainToModel(match)
-	return mr.db.WithContext(ctx).
+	modelMatch.Version = match.Version + 1
+	result := mr.db.WithContext(ctx).
 		Model(&model.Match{}).
 		Where(constants.QueryIDEquals, id).
-		Updates(modelMatch).Error
+		Where(constants.QueryVersionEquals, match.Version).
+		Updates(modelMatch)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// Another request changed the record since it was read
+		return constants.ErrVersionMismatch
+	}
+	match.Version = modelMatch.Version
+	return nil
 }
 
+// DeleteMatch moves the match to the trash and takes its approved result out of the standings.
 func (mr *MatchRepositoryImpl) DeleteMatch(ctx context.Context, id uint64) error {
-	return mr.db.WithContext(ctx).Delete(&model.Match{}, id).Error
+	return mr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var match model.Match
+		err := tx.Where(constants.QueryIDEquals, id).First(&match).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error getting match: %w", err)
+		}
+
+		if err := setMatchStandings(tx, &match, false); err != nil {
+			return err
+		}
+		return tx.Delete(&match).Error
+	})
 }
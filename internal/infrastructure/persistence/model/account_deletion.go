@@ -0,0 +1,14 @@
+package model
+
+import (
+	"time"
+)
+
+// AccountDeletion is a pending request of a user to erase their account.
+type AccountDeletion struct {
+	UserID      string    `gorm:"type:char(36);primaryKey" json:"user_id"`
+	RequestedAt time.Time `gorm:"type:timestamp;not null" json:"requested_at"`
+	ScheduledAt time.Time `gorm:"type:timestamp;not null;index" json:"scheduled_at"`
+
+	User *User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"user,omitempty" swaggerignore:"true"`
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// APIKey is a hashed personal API key; the prefix identifies the key without revealing it.
+type APIKey struct {
+	ID         string        `gorm:"type:char(36);primaryKey" json:"id"`
+	UserID     string        `gorm:"type:char(36);not null;index" json:"user_id"`
+	Name       string        `gorm:"type:varchar(100);not null" json:"name"`
+	Prefix     string        `gorm:"type:varchar(16);not null;uniqueIndex" json:"prefix"`
+	KeyHash    string        `gorm:"type:char(64);not null" json:"-"`
+	Scopes     []APIKeyScope `gorm:"foreignKey:APIKeyID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"scopes,omitempty"`
+	ExpiresAt  *time.Time    `gorm:"type:timestamp" json:"expires_at,omitempty"`
+	LastUsedAt *time.Time    `gorm:"type:timestamp" json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time    `gorm:"type:timestamp" json:"revoked_at,omitempty"`
+
+	User *User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"user,omitempty" swaggerignore:"true"`
+
+	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+}
+
+// APIKeyScope grants a single permission to an API key.
+type APIKeyScope struct {
+	APIKeyID   string `gorm:"type:char(36);primaryKey" json:"api_key_id"`
+	Permission string `gorm:"type:varchar(50);primaryKey" json:"permission"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID.
+func (k *APIKey) BeforeCreate(tx *gorm.DB) error {
+	if k.ID == "" {
+		k.ID = uuid.New().String()
+	}
+	return nil
+}
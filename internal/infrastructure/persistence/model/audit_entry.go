@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+)
+
+// AuditEntry is a change made to an entity. The states and changes are stored as JSON.
+type AuditEntry struct {
+	ID         uint64  `gorm:"primaryKey" json:"id"`
+	ActorID    *string `gorm:"type:char(36);index" json:"actor_id,omitempty"`
+	APIKeyID   *string `gorm:"type:char(36)" json:"api_key_id,omitempty"`
+	Action     string  `gorm:"type:varchar(7);not null;check:action IN ('create','update','delete','restore')" json:"action"`
+	EntityType string  `gorm:"type:varchar(20);not null;index:idx_audit_entries_entity" json:"entity_type"`
+	EntityID   string  `gorm:"type:varchar(64);not null;index:idx_audit_entries_entity" json:"entity_id"`
+	Before     *string `gorm:"type:jsonb" json:"before,omitempty"`
+	After      *string `gorm:"type:jsonb" json:"after,omitempty"`
+	Changes    string  `gorm:"type:jsonb;not null" json:"changes"`
+	RequestID  string  `gorm:"type:varchar(64)" json:"request_id"`
+	IPAddress  string  `gorm:"type:varchar(45)" json:"ip_address"`
+
+	Actor *User `gorm:"foreignKey:ActorID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"actor,omitempty" swaggerignore:"true"`
+
+	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime;index" json:"created_at"`
+}
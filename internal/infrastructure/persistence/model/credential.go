@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+)
+
+// PasswordCredential stores the password hash of a user with local credentials.
+type PasswordCredential struct {
+	UserID       string `gorm:"type:char(36);primaryKey" json:"user_id"`
+	PasswordHash string `gorm:"type:varchar(255);not null" json:"-"`
+
+	User *User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"user,omitempty" swaggerignore:"true"`
+
+	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"type:timestamp;autoUpdateTime" json:"updated_at"`
+}
+
+// PasswordResetToken stores the hash of a single-use password reset token.
+type PasswordResetToken struct {
+	ID        uint64     `gorm:"primaryKey" json:"id"`
+	UserID    string     `gorm:"type:char(36);not null;index" json:"user_id"`
+	TokenHash string     `gorm:"type:char(64);not null;uniqueIndex" json:"-"`
+	ExpiresAt time.Time  `gorm:"type:timestamp;not null;index" json:"expires_at"`
+	UsedAt    *time.Time `gorm:"type:timestamp" json:"used_at,omitempty"`
+
+	User *User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"user,omitempty" swaggerignore:"true"`
+
+	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+}
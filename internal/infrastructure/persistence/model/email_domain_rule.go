@@ -0,0 +1,15 @@
+package model
+
+import (
+	"time"
+)
+
+// EmailDomainRule allows or blocks the email domain of new accounts.
+type EmailDomainRule struct {
+	ID          uint64  `gorm:"primaryKey" json:"id"`
+	Domain      string  `gorm:"type:varchar(253);not null;uniqueIndex" json:"domain"`
+	Kind        string  `gorm:"type:varchar(5);not null;check:kind IN ('allow','block')" json:"kind"`
+	CreatedByID *string `gorm:"type:char(36)" json:"created_by_id,omitempty"` // Empty for the default rules
+
+	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+)
+
+// IdempotentRequest stores an admin request sent with an Idempotency-Key header and its response.
+type IdempotentRequest struct {
+	RequestKey   string    `gorm:"type:varchar(64);primaryKey" json:"-"`
+	Fingerprint  string    `gorm:"type:varchar(64);not null" json:"-"`
+	StatusCode   int       `gorm:"not null;default:0" json:"status_code"`
+	ContentType  string    `gorm:"type:varchar(100);not null;default:''" json:"content_type"`
+	Location     string    `gorm:"type:varchar(255);not null;default:''" json:"location"`
+	ETag         string    `gorm:"column:etag;type:varchar(100);not null;default:''" json:"etag"`
+	Body         []byte    `gorm:"type:bytea" json:"-"`
+	BodyWithheld bool      `gorm:"not null;default:false" json:"body_withheld"`
+	ExpiresAt    time.Time `gorm:"type:timestamp;not null;index" json:"expires_at"`
+	CreatedAt    time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+}
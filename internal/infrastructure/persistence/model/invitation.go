@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+)
+
+// Invitation stores an account invitation and the hash of its single-use token.
+type Invitation struct {
+	ID           uint64     `gorm:"primaryKey" json:"id"`
+	Email        string     `gorm:"type:varchar(100);not null;index" json:"email"`
+	RoleID       uint64     `gorm:"not null" json:"role_id"`
+	TeamID       *uint64    `json:"team_id,omitempty"`
+	TokenHash    string     `gorm:"type:char(64);not null;uniqueIndex" json:"-"`
+	InvitedByID  string     `gorm:"type:char(36);not null" json:"invited_by_id"`
+	ExpiresAt    time.Time  `gorm:"type:timestamp;not null;index" json:"expires_at"`
+	AcceptedAt   *time.Time `gorm:"type:timestamp" json:"accepted_at,omitempty"`
+	AcceptedByID *string    `gorm:"type:char(36)" json:"accepted_by_id,omitempty"`
+	RevokedAt    *time.Time `gorm:"type:timestamp" json:"revoked_at,omitempty"`
+
+	Role       *Role `gorm:"foreignKey:RoleID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"role,omitempty" swaggerignore:"true"`
+	Team       *Team `gorm:"foreignKey:TeamID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"team,omitempty" swaggerignore:"true"`
+	InvitedBy  *User `gorm:"foreignKey:InvitedByID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"invited_by,omitempty" swaggerignore:"true"`
+	AcceptedBy *User `gorm:"foreignKey:AcceptedByID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"accepted_by,omitempty" swaggerignore:"true"`
+
+	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+}
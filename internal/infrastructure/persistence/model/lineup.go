@@ -12,6 +12,7 @@ type Lineup struct {
 	MatchID   uint64    `gorm:"index;not null;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;" json:"match_id" form:"match_id" binding:"required"`
 	Match     *Match    `gorm:"foreignKey:MatchID" json:"match,omitempty" form:"match"`
 	Starting  bool      `gorm:"default:false" json:"starting" form:"starting"`
+	Version   uint64    `gorm:"not null;default:1" json:"version"`
 	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at" form:"created_at"`
 	UpdatedAt time.Time `gorm:"type:timestamp;autoUpdateTime" json:"updated_at" form:"updated_at"`
 }
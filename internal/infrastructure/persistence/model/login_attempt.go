@@ -0,0 +1,14 @@
+package model
+
+import (
+	"time"
+)
+
+// LoginAttempt records a password login used to enforce the lockout policy.
+type LoginAttempt struct {
+	ID        uint64    `gorm:"primaryKey" json:"id"`
+	IPAddress string    `gorm:"type:varchar(45);not null;index:idx_login_attempts_ip_created" json:"ip_address"`
+	Username  string    `gorm:"type:varchar(100)" json:"username"`
+	Succeeded bool      `gorm:"not null" json:"succeeded"`
+	CreatedAt time.Time `gorm:"type:timestamp;not null;index:idx_login_attempts_ip_created" json:"created_at"`
+}
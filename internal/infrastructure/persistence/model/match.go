@@ -2,13 +2,16 @@ package model
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type Match struct {
 	ID          uint64       `gorm:"primaryKey" json:"id" form:"id"`
 	Status      string       `gorm:"type:varchar(11);not null;check:status IN ('scheduled','in_progress','completed','postponed','cancelled')" json:"status" form:"status" binding:"required,oneof=scheduled in_progress completed postponed cancelled"`
 	Kickoff     time.Time    `gorm:"type:timestamp;not null" json:"kickoff" form:"kickoff" binding:"required"`
-	Location    string       `gorm:"type:varchar(35);not null" json:"location" form:"location" binding:"required,max=35"`
+	VenueID     *uint64      `gorm:"index" json:"venue_id" form:"venue_id"`
+	Venue       *Venue       `gorm:"foreignKey:VenueID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;" json:"venue,omitempty" form:"venue" swaggerignore:"true"`
 	HomeGoals   uint8        `gorm:"not null;default:0" json:"home_goals" form:"home_goals"`
 	AwayGoals   uint8        `gorm:"not null;default:0" json:"away_goals" form:"away_goals"`
 	HomeTeamID  uint64       `gorm:"index;not null" json:"home_team_id" form:"home_team_id" binding:"required"`
@@ -22,6 +25,8 @@ type Match struct {
 	MVPPlayerID *uint64      `gorm:"index" json:"mvp_player_id" form:"mvp_player_id"`
 	MVPPlayer   *Player      `gorm:"foreignKey:MVPPlayerID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"mvp_player,omitempty" form:"mvp_player" swaggerignore:"true"`
 
-	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at" form:"created_at"`
-	UpdatedAt time.Time `gorm:"type:timestamp;autoUpdateTime" json:"updated_at" form:"updated_at"`
+	Version   uint64         `gorm:"not null;default:1" json:"version"`
+	CreatedAt time.Time      `gorm:"type:timestamp;autoCreateTime" json:"created_at" form:"created_at"`
+	UpdatedAt time.Time      `gorm:"type:timestamp;autoUpdateTime" json:"updated_at" form:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"type:timestamp;index" json:"-"`
 }
@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+)
+
+// MatchReport is the official post-match report submitted by a referee or coach.
+type MatchReport struct {
+	ID             uint64     `gorm:"primaryKey" json:"id"`
+	MatchID        uint64     `gorm:"index;not null" json:"match_id"`
+	SubmittedByID  string     `gorm:"type:char(36);index;not null" json:"submitted_by_id"`
+	Status         string     `gorm:"type:varchar(8);not null;default:'pending';index;check:status IN ('pending','approved','rejected')" json:"status"`
+	HomeGoals      uint8      `gorm:"not null;default:0" json:"home_goals"`
+	AwayGoals      uint8      `gorm:"not null;default:0" json:"away_goals"`
+	Attendance     uint32     `gorm:"not null;default:0" json:"attendance"`
+	Incidents      string     `gorm:"type:text" json:"incidents"`
+	ReviewComments string     `gorm:"type:text" json:"review_comments"`
+	ReviewedByID   *string    `gorm:"type:char(36)" json:"reviewed_by_id,omitempty"`
+	ReviewedAt     *time.Time `gorm:"type:timestamp" json:"reviewed_at,omitempty"`
+
+	Match       *Match             `gorm:"foreignKey:MatchID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"match,omitempty" swaggerignore:"true"`
+	SubmittedBy *User              `gorm:"foreignKey:SubmittedByID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;" json:"submitted_by,omitempty" swaggerignore:"true"`
+	ReviewedBy  *User              `gorm:"foreignKey:ReviewedByID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"reviewed_by,omitempty" swaggerignore:"true"`
+	Events      []MatchReportEvent `gorm:"foreignKey:ReportID;constraint:OnDelete:CASCADE;" json:"events,omitempty" swaggerignore:"true"`
+
+	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"type:timestamp;autoUpdateTime" json:"updated_at"`
+}
+
+// MatchReportEvent is a scorer or card entry of a match report.
+type MatchReportEvent struct {
+	ID             uint64  `gorm:"primaryKey" json:"id"`
+	ReportID       uint64  `gorm:"index;not null" json:"report_id"`
+	Type           string  `gorm:"type:varchar(11);not null;check:type IN ('goal','own_goal','yellow_card','red_card')" json:"type"`
+	PlayerID       uint64  `gorm:"index;not null" json:"player_id"`
+	TeamID         uint64  `gorm:"not null" json:"team_id"`
+	AssistPlayerID *uint64 `json:"assist_player_id,omitempty"`
+	Minute         uint8   `gorm:"type:smallint;not null;default:0" json:"minute"`
+
+	Player       *Player `gorm:"foreignKey:PlayerID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;" json:"player,omitempty" swaggerignore:"true"`
+	AssistPlayer *Player `gorm:"foreignKey:AssistPlayerID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"assist_player,omitempty" swaggerignore:"true"`
+	Team         *Team   `gorm:"foreignKey:TeamID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;" json:"team,omitempty" swaggerignore:"true"`
+}
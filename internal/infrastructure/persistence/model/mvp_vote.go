@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+)
+
+// MVPPoll is the fan vote for the most valuable player of a match.
+type MVPPoll struct {
+	ID             uint64     `gorm:"primaryKey" json:"id"`
+	MatchID        uint64     `gorm:"not null;uniqueIndex" json:"match_id"`
+	OpensAt        time.Time  `gorm:"type:timestamp;not null" json:"opens_at"`
+	ClosesAt       time.Time  `gorm:"type:timestamp;not null;index" json:"closes_at"`
+	ClosedAt       *time.Time `gorm:"type:timestamp" json:"closed_at,omitempty"`
+	WinnerPlayerID *uint64    `json:"winner_player_id,omitempty"`
+
+	Match        *Match  `gorm:"foreignKey:MatchID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"match,omitempty" swaggerignore:"true"`
+	WinnerPlayer *Player `gorm:"foreignKey:WinnerPlayerID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"winner_player,omitempty" swaggerignore:"true"`
+
+	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"type:timestamp;autoUpdateTime" json:"updated_at"`
+}
+
+// MVPVote is a user's MVP vote for a match.
+type MVPVote struct {
+	ID       uint64 `gorm:"primaryKey" json:"id"`
+	MatchID  uint64 `gorm:"not null;uniqueIndex:idx_mvp_vote_match_user" json:"match_id"`
+	UserID   string `gorm:"type:char(36);not null;uniqueIndex:idx_mvp_vote_match_user;index" json:"user_id"`
+	PlayerID uint64 `gorm:"not null;index" json:"player_id"`
+
+	Match  *Match  `gorm:"foreignKey:MatchID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"match,omitempty" swaggerignore:"true"`
+	User   *User   `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"user,omitempty" swaggerignore:"true"`
+	Player *Player `gorm:"foreignKey:PlayerID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"player,omitempty" swaggerignore:"true"`
+
+	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+}
@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+)
+
+// OAuthState stores the PKCE parameters of a pending OAuth login under its state.
+type OAuthState struct {
+	State        string    `gorm:"type:varchar(64);primaryKey" json:"-"`
+	Verifier     string    `gorm:"type:varchar(128);not null" json:"-"`
+	Provider     string    `gorm:"type:varchar(50);not null" json:"provider"`
+	Nonce        string    `gorm:"type:varchar(64);not null" json:"-"`
+	InvitationID uint64    `gorm:"not null;default:0" json:"invitation_id,omitempty"`
+	ExpiresAt    time.Time `gorm:"type:timestamp;not null;index" json:"expires_at"`
+	CreatedAt    time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+)
+
+// PlayerClaim is a user's request to be linked to a player profile.
+// A user has at most one pending claim at a time.
+type PlayerClaim struct {
+	ID             uint64     `gorm:"primaryKey" json:"id"`
+	UserID         string     `gorm:"type:char(36);not null;index;uniqueIndex:idx_player_claims_pending_user,where:status = 'pending'" json:"user_id"`
+	PlayerID       uint64     `gorm:"not null;index" json:"player_id"`
+	Status         string     `gorm:"type:varchar(8);not null;default:'pending';index;check:status IN ('pending','approved','rejected')" json:"status"`
+	Message        string     `gorm:"type:varchar(500)" json:"message"`
+	ReviewComments string     `gorm:"type:text" json:"review_comments"`
+	ReviewedByID   *string    `gorm:"type:char(36)" json:"reviewed_by_id,omitempty"`
+	ReviewedAt     *time.Time `gorm:"type:timestamp" json:"reviewed_at,omitempty"`
+
+	User       *User   `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"user,omitempty" swaggerignore:"true"`
+	Player     *Player `gorm:"foreignKey:PlayerID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"player,omitempty" swaggerignore:"true"`
+	ReviewedBy *User   `gorm:"foreignKey:ReviewedByID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"reviewed_by,omitempty" swaggerignore:"true"`
+
+	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"type:timestamp;autoUpdateTime" json:"updated_at"`
+}
@@ -18,6 +18,7 @@ type PlayerTeam struct {
 	StartDate time.Time  `gorm:"type:timestamp;uniqueIndex:idx_player_team_unique;not null" json:"start_date"`
 	EndDate   *time.Time `gorm:"type:timestamp" json:"end_date,omitempty"`
 
+	Version   uint64    `gorm:"not null;default:1" json:"version"`
 	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at,omitempty"`
 	UpdatedAt time.Time `gorm:"type:timestamp;autoUpdateTime" json:"updated_at,omitempty"`
 }
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+)
+
+// Prediction is a user's guess of the final score of a match.
+type Prediction struct {
+	ID        uint64     `gorm:"primaryKey" json:"id"`
+	UserID    string     `gorm:"type:char(36);not null;uniqueIndex:idx_prediction_user_match" json:"user_id"`
+	MatchID   uint64     `gorm:"not null;index;uniqueIndex:idx_prediction_user_match" json:"match_id"`
+	HomeGoals uint8      `gorm:"type:smallint;not null;default:0" json:"home_goals"`
+	AwayGoals uint8      `gorm:"type:smallint;not null;default:0" json:"away_goals"`
+	Points    *uint8     `gorm:"type:smallint" json:"points,omitempty"`
+	Exact     bool       `gorm:"not null;default:false" json:"exact"`
+	ScoredAt  *time.Time `gorm:"type:timestamp" json:"scored_at,omitempty"`
+
+	User  *User  `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"user,omitempty" swaggerignore:"true"`
+	Match *Match `gorm:"foreignKey:MatchID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"match,omitempty" swaggerignore:"true"`
+
+	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"type:timestamp;autoUpdateTime" json:"updated_at"`
+}
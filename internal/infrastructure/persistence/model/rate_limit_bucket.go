@@ -0,0 +1,12 @@
+package model
+
+import (
+	"time"
+)
+
+// RateLimitBucket stores the tokens left to a client under a rate limit policy.
+type RateLimitBucket struct {
+	BucketKey  string    `gorm:"type:varchar(191);primaryKey" json:"bucket_key"`
+	Tokens     float64   `gorm:"type:double precision;not null" json:"tokens"`
+	RefilledAt time.Time `gorm:"type:timestamp;not null;index" json:"refilled_at"`
+}
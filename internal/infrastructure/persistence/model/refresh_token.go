@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+)
+
+// RefreshToken stores the hash of an opaque refresh token and the family it belongs to.
+// The family ID is the ID of the session the token was issued for.
+type RefreshToken struct {
+	ID        uint64     `gorm:"primaryKey" json:"id"`
+	UserID    string     `gorm:"type:char(36);not null;index" json:"user_id"`
+	FamilyID  string     `gorm:"type:char(36);not null;index" json:"family_id"`
+	TokenHash string     `gorm:"type:char(64);not null;uniqueIndex" json:"-"`
+	ExpiresAt time.Time  `gorm:"type:timestamp;not null;index" json:"expires_at"`
+	UsedAt    *time.Time `gorm:"type:timestamp" json:"used_at,omitempty"`
+	RevokedAt *time.Time `gorm:"type:timestamp" json:"revoked_at,omitempty"`
+
+	User *User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"user,omitempty" swaggerignore:"true"`
+
+	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+}
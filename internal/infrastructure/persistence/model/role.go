@@ -8,6 +8,15 @@ type Role struct {
 	ID          uint64         `gorm:"primaryKey" json:"id" form:"id"`
 	Name        string         `gorm:"type:varchar(20);not null;uniqueIndex" json:"name" form:"name" binding:"required,max=20"`
 	Description string      `gorm:"type:varchar(100)" json:"description,omitempty" form:"description"`
+	Permissions []RolePermission `gorm:"foreignKey:RoleID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"permissions,omitempty"`
+	Version     uint64      `gorm:"not null;default:1" json:"version"`
 	CreatedAt   time.Time   `gorm:"type:timestamp;autoCreateTime" json:"created_at,omitempty"`
 	UpdatedAt   time.Time   `gorm:"type:timestamp;autoUpdateTime" json:"updated_at,omitempty"`
 }
+
+// RolePermission grants a single permission to a role.
+type RolePermission struct {
+	RoleID     uint64    `gorm:"primaryKey" json:"role_id"`
+	Permission string    `gorm:"type:varchar(50);primaryKey" json:"permission"`
+	CreatedAt  time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at,omitempty"`
+}
@@ -15,6 +15,7 @@ type Season struct {
 	TeamStats   []TeamStat     `gorm:"foreignKey:SeasonID" json:"team_stats" swaggerignore:"true"`
 	PlayerTeams []PlayerTeam   `gorm:"foreignKey:SeasonID" json:"player_teams" swaggerignore:"true"`
 	PlayerStats []PlayerStat `gorm:"foreignKey:SeasonID" json:"player_stats" swaggerignore:"true"`
+	Version     uint64       `gorm:"not null;default:1" json:"version"`
 	CreatedAt   time.Time    `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
 	UpdatedAt   time.Time    `gorm:"type:timestamp;autoUpdateTime" json:"updated_at"`
 }
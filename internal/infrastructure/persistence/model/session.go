@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Session is a signed-in device of a user; its ID is the family ID of its refresh tokens.
+type Session struct {
+	ID         string     `gorm:"type:char(36);primaryKey" json:"id"`
+	UserID     string     `gorm:"type:char(36);not null;index" json:"user_id"`
+	UserAgent  string     `gorm:"type:varchar(255)" json:"user_agent"`
+	IPAddress  string     `gorm:"type:varchar(45)" json:"ip_address"`
+	LastSeenAt time.Time  `gorm:"type:timestamp;not null" json:"last_seen_at"`
+	ExpiresAt  time.Time  `gorm:"type:timestamp;not null;index" json:"expires_at"`
+	RevokedAt  *time.Time `gorm:"type:timestamp" json:"revoked_at,omitempty"`
+
+	User *User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"user,omitempty" swaggerignore:"true"`
+
+	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+}
+
+// BeforeCreate will set a UUID rather than numeric ID.
+func (s *Session) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == "" {
+		s.ID = uuid.New().String()
+	}
+	return nil
+}
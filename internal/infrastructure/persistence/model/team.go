@@ -2,17 +2,21 @@ package model
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type Team struct {
 	ID          uint64    `gorm:"primaryKey" json:"id"`
-	FullName       string    `gorm:"type:varchar(35);not null;uniqueIndex" json:"full_name" form:"full_name" binding:"required,max=35"`
-	ShortName      string    `gorm:"type:varchar(5);unique;not null" json:"short_name" form:"short_name" binding:"required,max=5"`
+	FullName       string    `gorm:"type:varchar(35);not null;uniqueIndex:idx_teams_full_name_active,where:deleted_at IS NULL" json:"full_name" form:"full_name" binding:"required,max=35"`
+	ShortName      string    `gorm:"type:varchar(5);not null;uniqueIndex:idx_teams_short_name_active,where:deleted_at IS NULL" json:"short_name" form:"short_name" binding:"required,max=5"`
 	PrimaryColor   string    `gorm:"type:varchar(10);not null" json:"primary_color" form:"primary_color" binding:"required,max=10"`
 	SecondaryColor string    `gorm:"type:varchar(10);not null" json:"secondary_color" form:"secondary_color" binding:"required,max=10"`
 	Shield         string    `gorm:"type:varchar(200);not null" json:"shield" form:"shield" binding:"required,url"`
 	NextMatchID *uint64   `gorm:"index" json:"next_match_id,omitempty"`
 	NextMatch   *Match    `gorm:"foreignKey:NextMatchID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"next_match,omitempty" swaggerignore:"true"`
+	HomeVenueID *uint64   `gorm:"index" json:"home_venue_id,omitempty"`
+	HomeVenue   *Venue    `gorm:"foreignKey:HomeVenueID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"home_venue,omitempty" swaggerignore:"true"`
 
 	PlayerTeams []PlayerTeam `json:"player_teams,omitempty" swaggerignore:"true"`
 	HomeMatches []Match      `gorm:"foreignKey:HomeTeamID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;" json:"home_matches,omitempty" swaggerignore:"true"`
@@ -20,6 +24,8 @@ type Team struct {
 	TeamStats   []TeamStat   `gorm:"foreignKey:TeamID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"team_stats,omitempty" swaggerignore:"true"`
 	PlayerStats []PlayerStat `gorm:"foreignKey:TeamID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;" json:"player_stats,omitempty" swaggerignore:"true"`
 
-	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at,omitempty"`
-	UpdatedAt time.Time `gorm:"type:timestamp;autoUpdateTime" json:"updated_at,omitempty"`
+	Version   uint64         `gorm:"not null;default:1" json:"version"`
+	CreatedAt time.Time      `gorm:"type:timestamp;autoCreateTime" json:"created_at,omitempty"`
+	UpdatedAt time.Time      `gorm:"type:timestamp;autoUpdateTime" json:"updated_at,omitempty"`
+	DeletedAt gorm.DeletedAt `gorm:"type:timestamp;index" json:"-"`
 }
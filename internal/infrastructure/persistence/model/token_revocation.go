@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+)
+
+// RevokedToken is an access token, identified by its jti claim, revoked before its expiry.
+type RevokedToken struct {
+	TokenID   string    `gorm:"type:char(36);primaryKey" json:"token_id"`
+	UserID    string    `gorm:"type:char(36);not null;index" json:"user_id"`
+	ExpiresAt time.Time `gorm:"type:timestamp;not null;index" json:"expires_at"`
+	RevokedAt time.Time `gorm:"type:timestamp;not null" json:"revoked_at"`
+
+	User *User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"user,omitempty" swaggerignore:"true"`
+}
+
+// UserTokenRevocation invalidates every token of a user issued at or before RevokedBefore.
+type UserTokenRevocation struct {
+	UserID        string    `gorm:"type:char(36);primaryKey" json:"user_id"`
+	RevokedBefore time.Time `gorm:"type:timestamp;not null;index" json:"revoked_before"`
+
+	User *User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"user,omitempty" swaggerignore:"true"`
+}
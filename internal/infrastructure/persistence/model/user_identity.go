@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+)
+
+// UserIdentity links a user to an account at an external OpenID Connect provider.
+type UserIdentity struct {
+	ID          uint64    `gorm:"primaryKey" json:"id"`
+	UserID      string    `gorm:"type:char(36);not null;index" json:"user_id"`
+	Provider    string    `gorm:"type:varchar(30);not null;uniqueIndex:idx_user_identities_provider_subject" json:"provider"`
+	Subject     string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_user_identities_provider_subject" json:"subject"`
+	Email       string    `gorm:"type:varchar(100)" json:"email"`
+	LastLoginAt time.Time `gorm:"type:timestamp;not null" json:"last_login_at"`
+
+	User *User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"user,omitempty" swaggerignore:"true"`
+
+	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+}
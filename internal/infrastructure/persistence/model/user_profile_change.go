@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+)
+
+// UserProfileChange records a profile field changed by a user on their own account.
+type UserProfileChange struct {
+	ID        uint64    `gorm:"primaryKey" json:"id"`
+	UserID    string    `gorm:"type:char(36);not null;index:idx_user_profile_changes_user_created" json:"user_id"`
+	ChangedBy string    `gorm:"type:char(36);not null" json:"changed_by"`
+	Field     string    `gorm:"type:varchar(30);not null" json:"field"`
+	OldValue  string    `gorm:"type:varchar(255)" json:"old_value"`
+	NewValue  string    `gorm:"type:varchar(255)" json:"new_value"`
+	IPAddress string    `gorm:"type:varchar(45)" json:"ip_address"`
+	CreatedAt time.Time `gorm:"type:timestamp;not null;index:idx_user_profile_changes_user_created" json:"created_at"`
+
+	User *User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"user,omitempty" swaggerignore:"true"`
+}
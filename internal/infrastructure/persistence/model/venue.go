@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+)
+
+type Venue struct {
+	ID        uint64   `gorm:"primaryKey" json:"id"`
+	Name      string   `gorm:"type:varchar(100);not null;uniqueIndex" json:"name" form:"name" binding:"required,max=100"`
+	Address   string   `gorm:"type:varchar(200)" json:"address" form:"address" binding:"max=200"`
+	Capacity  uint32   `gorm:"not null;default:0" json:"capacity" form:"capacity"`
+	Surface   string   `gorm:"type:varchar(15);check:surface IN ('','natural_grass','artificial_turf','hybrid','indoor')" json:"surface" form:"surface"`
+	Latitude  *float64 `gorm:"type:numeric(9,6)" json:"latitude,omitempty" form:"latitude"`
+	Longitude *float64 `gorm:"type:numeric(9,6)" json:"longitude,omitempty" form:"longitude"`
+
+	Matches []Match `gorm:"foreignKey:VenueID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;" json:"matches,omitempty" swaggerignore:"true"`
+
+	Version   uint64    `gorm:"not null;default:1" json:"version"`
+	CreatedAt time.Time `gorm:"type:timestamp;autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"type:timestamp;autoUpdateTime" json:"updated_at"`
+}
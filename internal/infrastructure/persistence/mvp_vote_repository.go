@@ -0,0 +1,210 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MVPVoteRepositoryImpl implements domain.MVPVoteRepository interface.
+type MVPVoteRepositoryImpl struct {
+	db     *gorm.DB
+	mapper *persistence.MVPVotePersistenceMapper
+}
+
+func NewMVPVoteRepository(db *gorm.DB) domain.MVPVoteRepository {
+	return &MVPVoteRepositoryImpl{
+		db:     db,
+		mapper: persistence.NewMVPVotePersistenceMapper(),
+	}
+}
+
+// CreatePoll stores the poll unless the match already has one
+func (vr *MVPVoteRepositoryImpl) CreatePoll(ctx context.Context, poll *domain.MVPPoll) error {
+	modelPoll := vr.mapper.PollToModel(poll)
+	err := vr.db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "match_id"}}, DoNothing: true}).
+		Create(modelPoll).Error
+	if err != nil {
+		return fmt.Errorf("failed to create MVP poll: %w", err)
+	}
+	return nil
+}
+
+func (vr *MVPVoteRepositoryImpl) GetPollByMatchID(ctx context.Context, matchID uint64) (*domain.MVPPoll, error) {
+	var poll model.MVPPoll
+	result := vr.db.WithContext(ctx).Where("match_id = ?", matchID).First(&poll)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting MVP poll by match ID: %w", result.Error)
+	}
+
+	return vr.mapper.PollToDomain(&poll), nil
+}
+
+// GetPollsToClose returns the polls whose voting window has ended but are not closed yet
+func (vr *MVPVoteRepositoryImpl) GetPollsToClose(ctx context.Context, now time.Time) ([]domain.MVPPoll, error) {
+	var polls []model.MVPPoll
+	err := vr.db.WithContext(ctx).
+		Where("closed_at IS NULL AND closes_at <= ?", now).
+		Order("closes_at ASC").
+		Find(&polls).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching MVP polls to close: %w", err)
+	}
+	return vr.mapper.PollListToDomain(polls), nil
+}
+
+// CreateVote stores the vote, returning constants.ErrRecordAlreadyExists when the user already voted
+func (vr *MVPVoteRepositoryImpl) CreateVote(ctx context.Context, vote *domain.MVPVote) error {
+	modelVote := vr.mapper.VoteToModel(vote)
+	result := vr.db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "match_id"}, {Name: "user_id"}}, DoNothing: true}).
+		Create(modelVote)
+	if result.Error != nil {
+		return fmt.Errorf("failed to create MVP vote: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return constants.ErrRecordAlreadyExists
+	}
+
+	*vote = *vr.mapper.VoteToDomain(modelVote)
+	return nil
+}
+
+func (vr *MVPVoteRepositoryImpl) GetVoteByUserAndMatch(ctx context.Context, userID string, matchID uint64) (*domain.MVPVote, error) {
+	var vote model.MVPVote
+	result := vr.db.WithContext(ctx).
+		Where("user_id = ? AND match_id = ?", userID, matchID).
+		First(&vote)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting MVP vote: %w", result.Error)
+	}
+
+	return vr.mapper.VoteToDomain(&vote), nil
+}
+
+// GetTalliesByMatchID counts the votes received by each voted player
+func (vr *MVPVoteRepositoryImpl) GetTalliesByMatchID(ctx context.Context, matchID uint64) ([]domain.MVPTally, error) {
+	var tallies []domain.MVPTally
+	err := vr.db.WithContext(ctx).
+		Model(&model.MVPVote{}).
+		Select("player_id, COUNT(*) AS votes, MIN(created_at) AS first_vote_at").
+		Where("match_id = ?", matchID).
+		Group("player_id").
+		Order("votes DESC, first_vote_at ASC").
+		Scan(&tallies).Error
+	if err != nil {
+		return nil, fmt.Errorf("error counting MVP votes: %w", err)
+	}
+	return tallies, nil
+}
+
+// ClosePoll applies the poll result atomically. Closing an already closed poll is a no-op,
+// so concurrent sweepers cannot count the same MVP twice.
+func (vr *MVPVoteRepositoryImpl) ClosePoll(ctx context.Context, poll *domain.MVPPoll, seasonID uint64) error {
+	return vr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.MVPPoll{}).
+			Where("id = ? AND closed_at IS NULL", poll.ID).
+			Updates(map[string]interface{}{
+				"closed_at":        poll.ClosedAt,
+				"winner_player_id": poll.WinnerPlayerID,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to close MVP poll: %w", result.Error)
+		}
+		if result.RowsAffected == 0 || poll.WinnerPlayerID == nil {
+			return nil
+		}
+
+		winnerID := *poll.WinnerPlayerID
+		var match model.Match
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "mvp_player_id").
+			Take(&match, constants.QueryIDEquals, poll.MatchID).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get match: %w", err)
+		}
+		// An MVP an admin already set loses the count, unless they also won the poll
+		previousMVPID := match.MVPPlayerID
+		if previousMVPID != nil && *previousMVPID == winnerID {
+			previousMVPID = nil
+		}
+		alreadyCounted := match.MVPPlayerID != nil && previousMVPID == nil
+
+		err = tx.Model(&model.Match{}).
+			Where(constants.QueryIDEquals, poll.MatchID).
+			Updates(map[string]interface{}{
+				"mvp_player_id": winnerID,
+				"version":       gorm.Expr("version + 1"),
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to set match MVP: %w", err)
+		}
+
+		err = tx.Model(&model.PlayerStat{}).
+			Where("match_id = ? AND player_id <> ? AND is_mvp", poll.MatchID, winnerID).
+			Updates(map[string]interface{}{
+				"is_mvp":  false,
+				"version": gorm.Expr("version + 1"),
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to reset player stats MVP flag: %w", err)
+		}
+
+		stat := model.PlayerStat{
+			PlayerID: winnerID,
+			MatchID:  poll.MatchID,
+			SeasonID: seasonID,
+			IsMVP:    true,
+		}
+		err = tx.Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "player_id"}, {Name: "match_id"}},
+			DoUpdates: append(clause.AssignmentColumns([]string{"is_mvp", "updated_at"}),
+				clause.Assignment{Column: clause.Column{Name: "version"}, Value: gorm.Expr("player_stats.version + 1")}),
+		}).Create(&stat).Error
+		if err != nil {
+			return fmt.Errorf("failed to flag player stats as MVP: %w", err)
+		}
+
+		if !alreadyCounted {
+			err = tx.Model(&model.Player{}).
+				Where(constants.QueryIDEquals, winnerID).
+				Updates(map[string]interface{}{
+					"mvp_count": gorm.Expr("mvp_count + 1"),
+					"version":   gorm.Expr("version + 1"),
+				}).Error
+			if err != nil {
+				return fmt.Errorf("failed to increment player MVP count: %w", err)
+			}
+		}
+
+		if previousMVPID != nil {
+			err = tx.Model(&model.Player{}).
+				Where(constants.QueryIDEquals, *previousMVPID).
+				Updates(map[string]interface{}{
+					"mvp_count": gorm.Expr("GREATEST(mvp_count - 1, 0)"),
+					"version":   gorm.Expr("version + 1"),
+				}).Error
+			if err != nil {
+				return fmt.Errorf("failed to decrement previous MVP count: %w", err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,74 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PKCEStoreImpl implements domain.PKCEStore in the database so that every API replica
+// can complete a login started on another one.
+type PKCEStoreImpl struct {
+	db  *gorm.DB
+	ttl time.Duration
+}
+
+// NewPKCEStore creates a database-backed store whose entries expire after ttl.
+func NewPKCEStore(db *gorm.DB, ttl time.Duration) domain.PKCEStore {
+	return &PKCEStoreImpl{db: db, ttl: ttl}
+}
+
+func (ps *PKCEStoreImpl) SavePKCE(ctx context.Context, state string, params *domain.PKCEParams) error {
+	oauthState := &model.OAuthState{
+		State:        state,
+		Verifier:     params.Verifier,
+		Provider:     params.Provider,
+		Nonce:        params.Nonce,
+		InvitationID: params.InvitationID,
+		ExpiresAt:    time.Now().Add(ps.ttl),
+	}
+	if err := ps.db.WithContext(ctx).Create(oauthState).Error; err != nil {
+		return fmt.Errorf("failed to save OAuth state: %w", err)
+	}
+	return nil
+}
+
+func (ps *PKCEStoreImpl) TakePKCE(ctx context.Context, state string) (*domain.PKCEParams, error) {
+	// Deleting with RETURNING lets a single callback consume the state even when replayed concurrently
+	var oauthStates []model.OAuthState
+	result := ps.db.WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("state = ?", state).
+		Delete(&oauthStates)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to take OAuth state: %w", result.Error)
+	}
+	if len(oauthStates) == 0 {
+		return nil, nil
+	}
+
+	params := &domain.PKCEParams{
+		Verifier:     oauthStates[0].Verifier,
+		Provider:     oauthStates[0].Provider,
+		Nonce:        oauthStates[0].Nonce,
+		InvitationID: oauthStates[0].InvitationID,
+		ExpiresAt:    oauthStates[0].ExpiresAt,
+	}
+	if params.IsExpired(time.Now()) {
+		return nil, nil
+	}
+	return params, nil
+}
+
+func (ps *PKCEStoreImpl) DeleteExpiredPKCE(ctx context.Context, now time.Time) (int64, error) {
+	result := ps.db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&model.OAuthState{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete expired OAuth states: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,167 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	persistenceMapper "github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+)
+
+// PlayerClaimRepositoryImpl implements domain.PlayerClaimRepository interface.
+type PlayerClaimRepositoryImpl struct {
+	db     *gorm.DB
+	mapper *persistenceMapper.PlayerClaimPersistenceMapper
+}
+
+func NewPlayerClaimRepository(db *gorm.DB) domain.PlayerClaimRepository {
+	return &PlayerClaimRepositoryImpl{
+		db:     db,
+		mapper: persistenceMapper.NewPlayerClaimPersistenceMapper(),
+	}
+}
+
+func (pcr *PlayerClaimRepositoryImpl) CreatePlayerClaim(ctx context.Context, claim *domain.PlayerClaim) error {
+	claimModel := pcr.mapper.DomainToModel(claim)
+	if err := pcr.db.WithContext(ctx).Create(claimModel).Error; err != nil {
+		return fmt.Errorf("failed to create player claim: %w", err)
+	}
+	claim.ID = claimModel.ID
+	claim.CreatedAt = claimModel.CreatedAt
+	claim.UpdatedAt = claimModel.UpdatedAt
+	return nil
+}
+
+func (pcr *PlayerClaimRepositoryImpl) GetPlayerClaimByID(ctx context.Context, id uint64) (*domain.PlayerClaim, error) {
+	var claim model.PlayerClaim
+	err := pcr.db.WithContext(ctx).
+		Preload("User").
+		Preload("Player").
+		First(&claim, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting player claim by ID: %w", err)
+	}
+	return pcr.mapper.ModelToDomain(&claim), nil
+}
+
+func (pcr *PlayerClaimRepositoryImpl) GetPaginatedPlayerClaims(ctx context.Context, filter domain.PlayerClaimFilter, page int, pageSize int) ([]domain.PlayerClaim, int64, error) {
+	applyFilter := func(query *gorm.DB) *gorm.DB {
+		if filter.Status != "" {
+			query = query.Where("status = ?", filter.Status)
+		}
+		if filter.UserID != "" {
+			query = query.Where("user_id = ?", filter.UserID)
+		}
+		return query
+	}
+
+	var total int64
+	if err := applyFilter(pcr.db.WithContext(ctx).Model(&model.PlayerClaim{})).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("error counting total player claims: %w", err)
+	}
+
+	// Oldest first, so the review queue is worked through in order
+	var claims []model.PlayerClaim
+	err := applyFilter(pcr.db.WithContext(ctx).Model(&model.PlayerClaim{})).
+		Preload("User").
+		Preload("Player").
+		Order("created_at ASC, id ASC").
+		Offset(page * pageSize).
+		Limit(pageSize).
+		Find(&claims).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("error fetching player claims: %w", err)
+	}
+	return pcr.mapper.ModelListToDomain(claims), total, nil
+}
+
+func (pcr *PlayerClaimRepositoryImpl) HasPendingPlayerClaim(ctx context.Context, userID string) (bool, error) {
+	var count int64
+	err := pcr.db.WithContext(ctx).
+		Model(&model.PlayerClaim{}).
+		Where("user_id = ? AND status = ?", userID, domain.PlayerClaimStatusPending).
+		Count(&count).Error
+	if err != nil {
+		return false, fmt.Errorf("error checking pending player claims: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (pcr *PlayerClaimRepositoryImpl) RejectPlayerClaim(ctx context.Context, claim *domain.PlayerClaim) error {
+	return pcr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return pcr.saveReview(tx, claim)
+	})
+}
+
+func (pcr *PlayerClaimRepositoryImpl) ApprovePlayerClaim(ctx context.Context, claim *domain.PlayerClaim, roleID uint64, otherClaimsComments string) error {
+	return pcr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := pcr.saveReview(tx, claim); err != nil {
+			return err
+		}
+
+		// Link only if nobody claimed the player in the meantime
+		result := tx.Model(&model.Player{}).
+			Where("id = ? AND user_id IS NULL", claim.PlayerID).
+			Updates(map[string]any{
+				"user_id": claim.UserID,
+				"version": gorm.Expr("version + 1"),
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to link player: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return constants.ErrPlayerAlreadyLinked
+		}
+
+		if roleID != 0 {
+			err := tx.Model(&model.User{}).
+				Where(constants.QueryIDEquals, claim.UserID).
+				Updates(map[string]any{
+					"role_id": roleID,
+					"version": gorm.Expr("version + 1"),
+				}).Error
+			if err != nil {
+				return fmt.Errorf("failed to grant player role: %w", err)
+			}
+		}
+
+		err := tx.Model(&model.PlayerClaim{}).
+			Where("player_id = ? AND status = ? AND id <> ?", claim.PlayerID, domain.PlayerClaimStatusPending, claim.ID).
+			Updates(map[string]any{
+				"status":          domain.PlayerClaimStatusRejected,
+				"review_comments": otherClaimsComments,
+				"reviewed_by_id":  claim.ReviewedByID,
+				"reviewed_at":     claim.ReviewedAt,
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to reject other player claims: %w", err)
+		}
+		return nil
+	})
+}
+
+// saveReview stores the review of a claim that must still be pending.
+func (pcr *PlayerClaimRepositoryImpl) saveReview(tx *gorm.DB, claim *domain.PlayerClaim) error {
+	result := tx.Model(&model.PlayerClaim{}).
+		Where("id = ? AND status = ?", claim.ID, domain.PlayerClaimStatusPending).
+		Updates(map[string]any{
+			"status":          claim.Status,
+			"review_comments": claim.ReviewComments,
+			"reviewed_by_id":  claim.ReviewedByID,
+			"reviewed_at":     claim.ReviewedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to review player claim: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return constants.ErrPlayerClaimNotPending
+	}
+	return nil
+}
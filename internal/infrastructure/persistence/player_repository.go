@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
 	"gorm.io/gorm"
@@ -24,6 +25,7 @@ type PlayerRepository interface {
 	CreatePlayer(ctx context.Context, player *domain.Player) error
 	GetPlayerByID(ctx context.Context, id uint64) (*domain.Player, error)
 	GetPlayerByNickName(ctx context.Context, nickName string) (*domain.Player, error)
+	GetPlayerByUserID(ctx context.Context, userID string) (*domain.Player, error)
 	GetPaginatedPlayers(ctx context.Context, sort string, order string, page int, pageSize int) ([]domain.Player, int64, error)
 	UpdatePlayer(ctx context.Context, id uint64, player *domain.Player) error
 	DeletePlayer(ctx context.Context, id uint64) error
@@ -77,6 +79,22 @@ func (pr *PlayerRepositoryImpl) GetPlayerByID(ctx context.Context, id uint64) (*
 }
 
 // GetPaginatedPlayers retrieves a paginated list of players with their teams, user and total count.
+func (pr *PlayerRepositoryImpl) GetPlayerByUserID(ctx context.Context, userID string) (*domain.Player, error) {
+	var player model.Player
+	result := pr.db.WithContext(ctx).
+		Preload(PreloadPlayerTeamsTeam).
+		Where("user_id = ?", userID).
+		First(&player)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting player by user ID: %w", result.Error)
+	}
+	return pr.mapper.ModelToDomain(&player), nil
+}
+
 func (pr *PlayerRepositoryImpl) GetPaginatedPlayers(ctx context.Context, sort string, order string, page int, pageSize int) ([]domain.Player, int64, error) {
 	var players []model.Player
 	var total int64
@@ -118,16 +136,36 @@ func (pr *PlayerRepositoryImpl) GetPaginatedPlayers(ctx context.Context, sort st
 
 func (pr *PlayerRepositoryImpl) CreatePlayer(ctx context.Context, player *domain.Player) error {
 	modelPlayer := pr.mapper.DomainToModel(player)
-	return pr.db.WithContext(ctx).Create(modelPlayer).Error
+	if err := pr.db.WithContext(ctx).Create(modelPlayer).Error; err != nil {
+		return err
+	}
+
+	// Update domain entity with generated ID and timestamps
+	player.ID = modelPlayer.ID
+	player.CreatedAt = modelPlayer.CreatedAt
+	player.Version = modelPlayer.Version
+	player.UpdatedAt = modelPlayer.UpdatedAt
+	return nil
 }
 
 func (pr *PlayerRepositoryImpl) UpdatePlayer(ctx context.Context, id uint64, player *domain.Player) error {
 	modelPlayer := pr.mapper.DomainToModel(player)
-	return pr.db.WithContext(ctx).
+	modelPlayer.Version = player.Version + 1
+	result := pr.db.WithContext(ctx).
 		Model(&model.Player{}).
 		Where(WhereIDEquals, id).
+		Where(constants.QueryVersionEquals, player.Version).
 		Select("*").
-		Updates(modelPlayer).Error
+		Updates(modelPlayer)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// Another request changed the record since it was read
+		return constants.ErrVersionMismatch
+	}
+	player.Version = modelPlayer.Version
+	return nil
 }
 
 func (pr *PlayerRepositoryImpl) DeletePlayer(ctx context.Context, id uint64) error {
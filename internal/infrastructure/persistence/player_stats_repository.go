@@ -7,6 +7,7 @@ import (
 
 	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
 	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/domain"
 	"gorm.io/gorm"
 )
@@ -25,7 +26,16 @@ func NewPlayerStatsRepository(db *gorm.DB) *PlayerStatsRepositoryImpl {
 
 func (psr *PlayerStatsRepositoryImpl) CreatePlayerStat(ctx context.Context, playerStat *domain.PlayerStat) error {
 	modelPlayerStat := psr.mapper.DomainToModel(playerStat)
-	return psr.db.WithContext(ctx).Create(modelPlayerStat).Error
+	if err := psr.db.WithContext(ctx).Create(modelPlayerStat).Error; err != nil {
+		return err
+	}
+
+	// Update domain entity with generated ID and timestamps
+	playerStat.ID = modelPlayerStat.ID
+	playerStat.CreatedAt = modelPlayerStat.CreatedAt
+	playerStat.Version = modelPlayerStat.Version
+	playerStat.UpdatedAt = modelPlayerStat.UpdatedAt
+	return nil
 }
 
 func (psr *PlayerStatsRepositoryImpl) GetPlayerStatByID(ctx context.Context, id uint64) (*domain.PlayerStat, error) {
@@ -57,6 +67,7 @@ func (psr *PlayerStatsRepositoryImpl) GetPlayerStatsByPlayerID(ctx context.Conte
 		Preload("Season").
 		Preload("Team").
 		Where("player_id = ?", playerID).
+		Where(constants.QueryMatchNotDeleted).
 		Find(&playerStats)
 
 	if result.Error != nil {
@@ -91,6 +102,7 @@ func (psr *PlayerStatsRepositoryImpl) GetPlayerStatsBySeasonID(ctx context.Conte
 		Preload("Team").
 		Preload("Season").
 		Where("season_id = ?", seasonID).
+		Where(constants.QueryMatchNotDeleted).
 		Find(&playerStats)
 
 	if result.Error != nil {
@@ -105,13 +117,14 @@ func (psr *PlayerStatsRepositoryImpl) GetPaginatedPlayerStats(ctx context.Contex
 	var total int64
 
 	// Count total records
-	countQuery := psr.db.WithContext(ctx).Model(&model.PlayerStat{})
+	countQuery := psr.db.WithContext(ctx).Model(&model.PlayerStat{}).Where(constants.QueryMatchNotDeleted)
 	if err := countQuery.Count(&total).Error; err != nil {
 		return nil, 0, fmt.Errorf("error counting total player stats: %w", err)
 	}
 
 	// Build the data query with eager loading
 	query := psr.db.WithContext(ctx).Model(&model.PlayerStat{}).
+		Where(constants.QueryMatchNotDeleted).
 		Preload("Player").
 		Preload("Match").
 		Preload("Season").
@@ -143,11 +156,22 @@ func (psr *PlayerStatsRepositoryImpl) GetPaginatedPlayerStats(ctx context.Contex
 
 func (psr *PlayerStatsRepositoryImpl) UpdatePlayerStat(ctx context.Context, id uint64, playerStat *domain.PlayerStat) error {
 	modelPlayerStat := psr.mapper.DomainToModel(playerStat)
-	return psr.db.WithContext(ctx).
+	modelPlayerStat.Version = playerStat.Version + 1
+	result := psr.db.WithContext(ctx).
 		Model(&model.PlayerStat{}).
 		Where("id = ?", id).
+		Where(constants.QueryVersionEquals, playerStat.Version).
 		Select("*").
-		Updates(modelPlayerStat).Error
+		Updates(modelPlayerStat)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// Another request changed the record since it was read
+		return constants.ErrVersionMismatch
+	}
+	playerStat.Version = modelPlayerStat.Version
+	return nil
 }
 
 func (psr *PlayerStatsRepositoryImpl) DeletePlayerStat(ctx context.Context, id uint64) error {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
 	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/domain"
 	"gorm.io/gorm"
 )
@@ -161,16 +162,27 @@ func (r *PlayerTeamRepositoryImpl) GetPaginatedPlayerTeams(ctx context.Context,
 // UpdatePlayerTeam updates an existing player-team relationship
 func (r *PlayerTeamRepositoryImpl) UpdatePlayerTeam(ctx context.Context, playerTeam *domain.PlayerTeam) error {
 	modelPlayerTeam := r.mapper.DomainToModel(playerTeam)
-	return r.db.WithContext(ctx).
+	result := r.db.WithContext(ctx).
 		Model(&model.PlayerTeam{}).
 		Where("id = ?", modelPlayerTeam.ID).
+		Where(constants.QueryVersionEquals, modelPlayerTeam.Version).
 		Updates(map[string]interface{}{
 			"player_id":  modelPlayerTeam.PlayerID,
 			"team_id":    modelPlayerTeam.TeamID,
 			"season_id":  modelPlayerTeam.SeasonID,
 			"start_date": modelPlayerTeam.StartDate,
 			"end_date":   modelPlayerTeam.EndDate,
-		}).Error
+			"version":    modelPlayerTeam.Version + 1,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// Another request changed the record since it was read
+		return constants.ErrVersionMismatch
+	}
+	playerTeam.Version++
+	return nil
 }
 
 // DeletePlayerTeam soft-deletes a player-team relationship
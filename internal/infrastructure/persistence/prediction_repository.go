@@ -0,0 +1,196 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+)
+
+// PredictionRepositoryImpl implements domain.PredictionRepository interface.
+type PredictionRepositoryImpl struct {
+	db     *gorm.DB
+	mapper *persistence.PredictionPersistenceMapper
+}
+
+func NewPredictionRepository(db *gorm.DB) domain.PredictionRepository {
+	return &PredictionRepositoryImpl{
+		db:     db,
+		mapper: persistence.NewPredictionPersistenceMapper(),
+	}
+}
+
+func (pr *PredictionRepositoryImpl) CreatePrediction(ctx context.Context, prediction *domain.Prediction) error {
+	modelPrediction := pr.mapper.DomainToModel(prediction)
+	if err := pr.db.WithContext(ctx).Create(modelPrediction).Error; err != nil {
+		return fmt.Errorf("failed to create prediction: %w", err)
+	}
+
+	// Update domain entity with generated ID and timestamps
+	*prediction = *pr.mapper.ModelToDomain(modelPrediction)
+	return nil
+}
+
+func (pr *PredictionRepositoryImpl) GetPredictionByID(ctx context.Context, id uint64) (*domain.Prediction, error) {
+	var prediction model.Prediction
+	result := pr.db.WithContext(ctx).
+		Preload("Match").
+		Preload(constants.PreloadMatchHomeTeam).
+		Preload(constants.PreloadMatchAwayTeam).
+		Where(constants.QueryIDEquals, id).
+		First(&prediction)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting prediction by ID: %w", result.Error)
+	}
+
+	return pr.mapper.ModelToDomain(&prediction), nil
+}
+
+func (pr *PredictionRepositoryImpl) GetPredictionByUserAndMatch(ctx context.Context, userID string, matchID uint64) (*domain.Prediction, error) {
+	var prediction model.Prediction
+	result := pr.db.WithContext(ctx).
+		Where("user_id = ? AND match_id = ?", userID, matchID).
+		First(&prediction)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting prediction by user and match: %w", result.Error)
+	}
+
+	return pr.mapper.ModelToDomain(&prediction), nil
+}
+
+// GetPaginatedPredictionsByUser retrieves a user's predictions with their matches.
+func (pr *PredictionRepositoryImpl) GetPaginatedPredictionsByUser(ctx context.Context, userID string, sort string, order string, page int, pageSize int) ([]domain.Prediction, int64, error) {
+	var predictions []model.Prediction
+	var total int64
+
+	// Count total records
+	countQuery := pr.db.WithContext(ctx).Model(&model.Prediction{}).Where("user_id = ?", userID)
+	if err := countQuery.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("error counting total predictions: %w", err)
+	}
+
+	// Build the data query
+	query := pr.db.WithContext(ctx).Model(&model.Prediction{}).
+		Preload("Match").
+		Preload(constants.PreloadMatchHomeTeam).
+		Preload(constants.PreloadMatchAwayTeam).
+		Where("user_id = ?", userID)
+
+	// Apply sorting (safe and validated)
+	col, raw, err := BuildOrderClause(EntityPrediction, sort, order)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error building sort clause: %w", err)
+	}
+
+	if raw != "" {
+		query = query.Order(raw)
+	} else {
+		query = query.Order(col)
+	}
+
+	// Apply pagination
+	offset := page * pageSize
+	query = query.Offset(offset).Limit(pageSize)
+
+	// Execute the query
+	if err := query.Find(&predictions).Error; err != nil {
+		return nil, 0, fmt.Errorf("error fetching predictions: %w", err)
+	}
+
+	return pr.mapper.ModelListToDomain(predictions), total, nil
+}
+
+func (pr *PredictionRepositoryImpl) GetPredictionsByMatchID(ctx context.Context, matchID uint64) ([]domain.Prediction, error) {
+	var predictions []model.Prediction
+	if err := pr.db.WithContext(ctx).Where("match_id = ?", matchID).Find(&predictions).Error; err != nil {
+		return nil, fmt.Errorf("error fetching predictions for match: %w", err)
+	}
+	return pr.mapper.ModelListToDomain(predictions), nil
+}
+
+func (pr *PredictionRepositoryImpl) UpdatePrediction(ctx context.Context, id uint64, prediction *domain.Prediction) error {
+	modelPrediction := pr.mapper.DomainToModel(prediction)
+	return pr.db.WithContext(ctx).
+		Model(&model.Prediction{}).
+		Where(constants.QueryIDEquals, id).
+		Select("home_goals", "away_goals").
+		Updates(modelPrediction).Error
+}
+
+func (pr *PredictionRepositoryImpl) DeletePrediction(ctx context.Context, id uint64) error {
+	result := pr.db.WithContext(ctx).Delete(&model.Prediction{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete prediction: %w", result.Error)
+	}
+	return nil
+}
+
+// SavePredictionScores stores the points of the given predictions in a single transaction.
+func (pr *PredictionRepositoryImpl) SavePredictionScores(ctx context.Context, predictions []domain.Prediction) error {
+	if len(predictions) == 0 {
+		return nil
+	}
+
+	return pr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, prediction := range predictions {
+			err := tx.Model(&model.Prediction{}).
+				Where(constants.QueryIDEquals, prediction.ID).
+				Updates(map[string]interface{}{
+					"points":    prediction.Points,
+					"exact":     prediction.Exact,
+					"scored_at": prediction.ScoredAt,
+				}).Error
+			if err != nil {
+				return fmt.Errorf("failed to save prediction score: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// GetSeasonLeaderboard ranks users with the given role by their total prediction points in the season.
+// Ties are broken by the number of exact scores; tied users share the same rank.
+func (pr *PredictionRepositoryImpl) GetSeasonLeaderboard(ctx context.Context, seasonID uint64, roleName string, page int, pageSize int) ([]domain.PredictionLeaderboardEntry, int64, error) {
+	scored := pr.db.WithContext(ctx).
+		Table("predictions").
+		Joins("JOIN matches ON matches.id = predictions.match_id AND matches.deleted_at IS NULL").
+		Joins("JOIN users ON users.id = predictions.user_id").
+		Joins("JOIN roles ON roles.id = users.role_id").
+		Where("matches.season_id = ? AND roles.name = ? AND predictions.points IS NOT NULL", seasonID, roleName)
+
+	var total int64
+	if err := scored.Session(&gorm.Session{}).Distinct("predictions.user_id").Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("error counting leaderboard users: %w", err)
+	}
+
+	var entries []domain.PredictionLeaderboardEntry
+	err := scored.Session(&gorm.Session{}).
+		Select(`RANK() OVER (ORDER BY SUM(predictions.points) DESC, COUNT(*) FILTER (WHERE predictions.exact) DESC) AS rank,
+			users.id AS user_id, users.name AS name, users.last_name AS last_name, users.img_profile AS img_profile,
+			SUM(predictions.points) AS points,
+			COUNT(*) FILTER (WHERE predictions.exact) AS exact_scores,
+			COUNT(*) AS predictions`).
+		Group("users.id, users.name, users.last_name, users.img_profile").
+		Order("rank ASC, users.name ASC, users.id ASC").
+		Offset(page * pageSize).
+		Limit(pageSize).
+		Scan(&entries).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("error fetching prediction leaderboard: %w", err)
+	}
+
+	return entries, total, nil
+}
@@ -0,0 +1,64 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// RateLimitStoreImpl implements domain.RateLimitStore in the database so that the
+// limits hold across every API replica.
+type RateLimitStoreImpl struct {
+	db *gorm.DB
+}
+
+func NewRateLimitStore(db *gorm.DB) domain.RateLimitStore {
+	return &RateLimitStoreImpl{db: db}
+}
+
+func (rs *RateLimitStoreImpl) TakeRateLimitToken(ctx context.Context, key string, policy domain.RateLimitPolicy, cost int, now time.Time) (domain.RateLimitDecision, error) {
+	var decision domain.RateLimitDecision
+	err := rs.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Create the full bucket first so that concurrent requests lock the same row
+		initial := policy.NewBucket(now)
+		err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.RateLimitBucket{
+			BucketKey:  key,
+			Tokens:     initial.Tokens,
+			RefilledAt: initial.RefilledAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		var row model.RateLimitBucket
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("bucket_key = ?", key).Take(&row).Error
+		if err != nil {
+			return err
+		}
+
+		bucket := domain.RateLimitBucket{Tokens: row.Tokens, RefilledAt: row.RefilledAt}
+		decision = policy.Take(&bucket, cost, now)
+
+		return tx.Model(&model.RateLimitBucket{}).Where("bucket_key = ?", key).Updates(map[string]interface{}{
+			"tokens":      bucket.Tokens,
+			"refilled_at": bucket.RefilledAt,
+		}).Error
+	})
+	if err != nil {
+		return domain.RateLimitDecision{}, fmt.Errorf("failed to take rate limit token: %w", err)
+	}
+	return decision, nil
+}
+
+func (rs *RateLimitStoreImpl) DeleteIdleRateLimitBuckets(ctx context.Context, before time.Time) (int64, error) {
+	result := rs.db.WithContext(ctx).Where("refilled_at < ?", before).Delete(&model.RateLimitBucket{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete idle rate limit buckets: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
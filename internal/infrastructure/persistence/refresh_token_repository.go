@@ -0,0 +1,88 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+)
+
+// RefreshTokenRepositoryImpl implements domain.RefreshTokenRepository interface.
+type RefreshTokenRepositoryImpl struct {
+	db     *gorm.DB
+	mapper *persistence.RefreshTokenPersistenceMapper
+}
+
+func NewRefreshTokenRepository(db *gorm.DB) domain.RefreshTokenRepository {
+	return &RefreshTokenRepositoryImpl{
+		db:     db,
+		mapper: persistence.NewRefreshTokenPersistenceMapper(),
+	}
+}
+
+func (rr *RefreshTokenRepositoryImpl) CreateRefreshToken(ctx context.Context, token *domain.RefreshToken) error {
+	modelToken := rr.mapper.ToModel(token)
+	if err := rr.db.WithContext(ctx).Create(modelToken).Error; err != nil {
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+	token.ID = modelToken.ID
+	token.CreatedAt = modelToken.CreatedAt
+	return nil
+}
+
+func (rr *RefreshTokenRepositoryImpl) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	var token model.RefreshToken
+	result := rr.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&token)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting refresh token by hash: %w", result.Error)
+	}
+
+	return rr.mapper.ToDomain(&token), nil
+}
+
+// RotateRefreshToken marks the token as used and stores its replacement atomically
+func (rr *RefreshTokenRepositoryImpl) RotateRefreshToken(ctx context.Context, usedID uint64, usedAt time.Time, replacement *domain.RefreshToken) error {
+	modelToken := rr.mapper.ToModel(replacement)
+	err := rr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Only an unused, unrevoked token can be rotated; losing the race means reuse
+		result := tx.Model(&model.RefreshToken{}).
+			Where("id = ? AND used_at IS NULL AND revoked_at IS NULL", usedID).
+			Update("used_at", usedAt)
+		if result.Error != nil {
+			return fmt.Errorf("failed to mark refresh token as used: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return constants.ErrRefreshTokenReused
+		}
+
+		if err := tx.Create(modelToken).Error; err != nil {
+			return fmt.Errorf("failed to create refresh token: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	replacement.ID = modelToken.ID
+	replacement.CreatedAt = modelToken.CreatedAt
+	return nil
+}
+
+func (rr *RefreshTokenRepositoryImpl) DeleteExpiredRefreshTokens(ctx context.Context, before time.Time) (int64, error) {
+	result := rr.db.WithContext(ctx).Where("expires_at < ?", before).Delete(&model.RefreshToken{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete expired refresh tokens: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
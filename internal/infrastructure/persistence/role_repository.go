@@ -7,6 +7,7 @@ import (
 
 	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
 	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/domain"
 	"gorm.io/gorm"
 )
@@ -26,6 +27,7 @@ func NewRoleRepository(db *gorm.DB) domain.RoleRepository {
 func (rr *RoleRepositoryImpl) GetRoleByName(ctx context.Context, name string) (*domain.Role, error) {
 	var roleModel model.Role
 	err := rr.db.WithContext(ctx).
+		Preload("Permissions").
 		Where("name = ?", name).
 		First(&roleModel).Error
 
@@ -41,7 +43,7 @@ func (rr *RoleRepositoryImpl) GetRoleByName(ctx context.Context, name string) (*
 
 func (rr *RoleRepositoryImpl) GetRoleByID(ctx context.Context, id uint64) (*domain.Role, error) {
 	var roleModel model.Role
-	result := rr.db.WithContext(ctx).Where("id = ?", id).First(&roleModel)
+	result := rr.db.WithContext(ctx).Preload("Permissions").Where("id = ?", id).First(&roleModel)
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return nil, nil
 	}
@@ -67,15 +69,62 @@ func (rr *RoleRepositoryImpl) CreateRole(ctx context.Context, role *domain.Role)
 func (rr *RoleRepositoryImpl) UpdateRole(ctx context.Context, role *domain.Role) error {
 	result := rr.db.WithContext(ctx).Model(&model.Role{}).
 		Where("id = ?", role.ID).
+		Where(constants.QueryVersionEquals, role.Version).
 		Updates(map[string]interface{}{
 			"name":        role.Name,
 			"description": role.Description,
+			"version":     role.Version + 1,
 		})
-	return result.Error
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// Another request changed the record since it was read
+		return constants.ErrVersionMismatch
+	}
+	role.Version++
+	return nil
+}
+
+// SetRolePermissions replaces the permissions granted to the role and moves it to the next version.
+func (rr *RoleRepositoryImpl) SetRolePermissions(ctx context.Context, role *domain.Role, permissions []string) error {
+	err := rr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Role{}).
+			Where("id = ?", role.ID).
+			Where(constants.QueryVersionEquals, role.Version).
+			Update("version", role.Version+1)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update role version: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			// Another request changed the record since it was read
+			return constants.ErrVersionMismatch
+		}
+
+		if err := tx.Where("role_id = ?", role.ID).Delete(&model.RolePermission{}).Error; err != nil {
+			return fmt.Errorf("failed to clear role permissions: %w", err)
+		}
+		if len(permissions) == 0 {
+			return nil
+		}
+		if err := tx.Create(rr.mapper.PermissionsToModel(role.ID, permissions)).Error; err != nil {
+			return fmt.Errorf("failed to grant role permissions: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	role.Version++
+	return nil
 }
 
 func (rr *RoleRepositoryImpl) DeleteRole(ctx context.Context, id uint64) error {
-	return rr.db.WithContext(ctx).Delete(&model.Role{}, id).Error
+	err := rr.db.WithContext(ctx).Delete(&model.Role{}, id).Error
+	if isForeignKeyViolation(err) {
+		return constants.ErrRecordInUse
+	}
+	return err
 }
 
 func (rr *RoleRepositoryImpl) GetPaginatedRoles(ctx context.Context, sort string, order string, page int, pageSize int) ([]domain.Role, int64, error) {
@@ -87,7 +136,7 @@ func (rr *RoleRepositoryImpl) GetPaginatedRoles(ctx context.Context, sort string
 		return nil, 0, err
 	}
 
-	dataQuery := rr.db.WithContext(ctx).Model(&model.Role{})
+	dataQuery := rr.db.WithContext(ctx).Model(&model.Role{}).Preload("Permissions")
 
 	// Apply sorting (safe and validated)
 	col, raw, err := BuildOrderClause(EntityRole, sort, order)
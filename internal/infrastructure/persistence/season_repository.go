@@ -7,6 +7,7 @@ import (
 
 	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
 	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/domain"
 	"gorm.io/gorm"
 )
@@ -40,12 +41,24 @@ func (sr *SeasonRepositoryImpl) CreateSeason(ctx context.Context, season *domain
 		}
 	}
 
-	return sr.db.WithContext(ctx).Create(modelSeason).Error
+	if err := sr.db.WithContext(ctx).Create(modelSeason).Error; err != nil {
+		return err
+	}
+
+	// Update domain entity with generated ID and timestamps
+	season.ID = modelSeason.ID
+	season.CreatedAt = modelSeason.CreatedAt
+	season.Version = modelSeason.Version
+	season.UpdatedAt = modelSeason.UpdatedAt
+	return nil
 }
 
 // clearCurrentSeasons sets IsCurrent=false for all seasons
 func (sr *SeasonRepositoryImpl) clearCurrentSeasons(ctx context.Context) error {
-	return sr.db.WithContext(ctx).Model(&model.Season{}).Where("is_current = ?", true).Update("is_current", false).Error
+	return sr.db.WithContext(ctx).Model(&model.Season{}).Where("is_current = ?", true).Updates(map[string]interface{}{
+		"is_current": false,
+		"version":    gorm.Expr("version + 1"),
+	}).Error
 }
 
 func (sr *SeasonRepositoryImpl) GetSeasonByID(ctx context.Context, id uint64) (*domain.Season, error) {
@@ -55,7 +68,7 @@ func (sr *SeasonRepositoryImpl) GetSeasonByID(ctx context.Context, id uint64) (*
 		Preload("Articles").
 		Preload("TeamStats").
 		Preload("PlayerTeams").
-		Preload("PlayerStats").
+		Preload("PlayerStats", constants.QueryMatchNotDeleted).
 		Where(whereID, id).
 		First(&season)
 
@@ -157,36 +170,67 @@ func (sr *SeasonRepositoryImpl) UpdateSeason(ctx context.Context, id uint64, sea
 		}
 	}
 
-	return sr.db.WithContext(ctx).
+	modelSeason.Version = season.Version + 1
+	result := sr.db.WithContext(ctx).
 		Model(&model.Season{}).
 		Where(whereID, id).
+		Where(constants.QueryVersionEquals, season.Version).
 		Select("*").
-		Updates(modelSeason).Error
+		Updates(modelSeason)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// Another request changed the record since it was read
+		return constants.ErrVersionMismatch
+	}
+	season.Version = modelSeason.Version
+	return nil
 }
 
 func (sr *SeasonRepositoryImpl) DeleteSeason(ctx context.Context, id uint64) error {
-	return sr.db.WithContext(ctx).Delete(&model.Season{}, id).Error
-}
-
-func (sr *SeasonRepositoryImpl) SetCurrentSeason(ctx context.Context, id uint64) error {
-	// First, clear current flag from all seasons
-	if err := sr.clearCurrentSeasons(ctx); err != nil {
-		return fmt.Errorf("failed to clear current season flag: %w", err)
+	err := sr.db.WithContext(ctx).Delete(&model.Season{}, id).Error
+	if isForeignKeyViolation(err) {
+		return constants.ErrRecordInUse
 	}
+	return err
+}
 
-	// Then set the specified season as current
-	result := sr.db.WithContext(ctx).
-		Model(&model.Season{}).
-		Where(whereID, id).
-		Update("is_current", true)
-
-	if result.Error != nil {
-		return fmt.Errorf("failed to set current season: %w", result.Error)
-	}
+func (sr *SeasonRepositoryImpl) SetCurrentSeason(ctx context.Context, season *domain.Season) error {
+	err := sr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// First, clear current flag from the other seasons
+		err := tx.Model(&model.Season{}).
+			Where("is_current = ? AND id <> ?", true, season.ID).
+			Updates(map[string]interface{}{
+				"is_current": false,
+				"version":    gorm.Expr("version + 1"),
+			}).Error
+		if err != nil {
+			return fmt.Errorf("failed to clear current season flag: %w", err)
+		}
 
-	if result.RowsAffected == 0 {
-		return fmt.Errorf("season with id %d not found", id)
+		// Then set the specified season as current
+		result := tx.Model(&model.Season{}).
+			Where(whereID, season.ID).
+			Where(constants.QueryVersionEquals, season.Version).
+			Updates(map[string]interface{}{
+				"is_current": true,
+				"version":    season.Version + 1,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to set current season: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			// Another request changed the record since it was read
+			return constants.ErrVersionMismatch
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
+	season.IsCurrent = true
+	season.Version++
 	return nil
 }
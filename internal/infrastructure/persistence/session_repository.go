@@ -0,0 +1,123 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+)
+
+// SessionRepositoryImpl implements domain.SessionRepository interface.
+type SessionRepositoryImpl struct {
+	db     *gorm.DB
+	mapper *persistence.SessionPersistenceMapper
+}
+
+func NewSessionRepository(db *gorm.DB) domain.SessionRepository {
+	return &SessionRepositoryImpl{
+		db:     db,
+		mapper: persistence.NewSessionPersistenceMapper(),
+	}
+}
+
+func (sr *SessionRepositoryImpl) CreateSession(ctx context.Context, session *domain.Session) error {
+	modelSession := sr.mapper.ToModel(session)
+	if err := sr.db.WithContext(ctx).Create(modelSession).Error; err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+	session.ID = modelSession.ID
+	session.CreatedAt = modelSession.CreatedAt
+	return nil
+}
+
+func (sr *SessionRepositoryImpl) GetSessionByID(ctx context.Context, id string) (*domain.Session, error) {
+	var session model.Session
+	result := sr.db.WithContext(ctx).Where("id = ?", id).First(&session)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting session by ID: %w", result.Error)
+	}
+
+	return sr.mapper.ToDomain(&session), nil
+}
+
+func (sr *SessionRepositoryImpl) GetActiveSessionsByUserID(ctx context.Context, userID string, now time.Time) ([]domain.Session, error) {
+	var sessions []model.Session
+	err := sr.db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, now).
+		Order("created_at ASC").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching active sessions: %w", err)
+	}
+	return sr.mapper.ToDomainList(sessions), nil
+}
+
+func (sr *SessionRepositoryImpl) TouchSession(ctx context.Context, id string, lastSeenAt, expiresAt time.Time) error {
+	err := sr.db.WithContext(ctx).
+		Model(&model.Session{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"last_seen_at": lastSeenAt, "expires_at": expiresAt}).Error
+	if err != nil {
+		return fmt.Errorf("failed to update session activity: %w", err)
+	}
+	return nil
+}
+
+func (sr *SessionRepositoryImpl) RevokeSessions(ctx context.Context, ids []string, revokedAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return sr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.Session{}).
+			Where("id IN ? AND revoked_at IS NULL", ids).
+			Update("revoked_at", revokedAt).Error
+		if err != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+
+		err = tx.Model(&model.RefreshToken{}).
+			Where("family_id IN ? AND revoked_at IS NULL", ids).
+			Update("revoked_at", revokedAt).Error
+		if err != nil {
+			return fmt.Errorf("failed to revoke session refresh tokens: %w", err)
+		}
+		return nil
+	})
+}
+
+func (sr *SessionRepositoryImpl) RevokeUserSessions(ctx context.Context, userID string, revokedAt time.Time) error {
+	return sr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&model.Session{}).
+			Where("user_id = ? AND revoked_at IS NULL", userID).
+			Update("revoked_at", revokedAt).Error
+		if err != nil {
+			return fmt.Errorf("failed to revoke user sessions: %w", err)
+		}
+
+		err = tx.Model(&model.RefreshToken{}).
+			Where("user_id = ? AND revoked_at IS NULL", userID).
+			Update("revoked_at", revokedAt).Error
+		if err != nil {
+			return fmt.Errorf("failed to revoke user refresh tokens: %w", err)
+		}
+		return nil
+	})
+}
+
+func (sr *SessionRepositoryImpl) DeleteExpiredSessions(ctx context.Context, before time.Time) (int64, error) {
+	result := sr.db.WithContext(ctx).Where("expires_at < ?", before).Delete(&model.Session{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete expired sessions: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
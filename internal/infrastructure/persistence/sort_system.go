@@ -14,14 +14,17 @@ const (
 	EntityArticle     EntityType = "article"
 	EntityLineup      EntityType = "lineup"
 	EntityMatch       EntityType = "match"
+	EntityMatchReport EntityType = "match_report"
 	EntityPlayer      EntityType = "player"
 	EntityPlayerStats EntityType = "player_stats"
 	EntityPlayerTeam  EntityType = "player_team"
+	EntityPrediction  EntityType = "prediction"
 	EntityRole        EntityType = "role"
 	EntitySeason      EntityType = "season"
 	EntityTeam        EntityType = "team"
 	EntityTeamStats   EntityType = "team_stats"
 	EntityUser        EntityType = "user"
+	EntityVenue       EntityType = "venue"
 )
 
 // SortOrder indicates the direction of sorting (ascending or descending).
@@ -71,7 +74,7 @@ var EntitySortConfig = map[EntityType]map[string]SortConfig{
 		"id":            {SQLFragment: "matches.id", IsRelation: false},
 		"status":        {SQLFragment: "matches.status", IsRelation: false},
 		"kickoff":       {SQLFragment: "matches.kickoff", IsRelation: false},
-		"location":      {SQLFragment: "matches.location", IsRelation: false},
+		"venue_id":      {SQLFragment: "matches.venue_id", IsRelation: false},
 		"home_goals":    {SQLFragment: "matches.home_goals", IsRelation: false},
 		"away_goals":    {SQLFragment: "matches.away_goals", IsRelation: false},
 		"home_team_id":  {SQLFragment: "matches.home_team_id", IsRelation: false},
@@ -83,6 +86,16 @@ var EntitySortConfig = map[EntityType]map[string]SortConfig{
 		"season":        {SQLFragment: "(SELECT year FROM seasons WHERE seasons.id = matches.season_id)", IsRelation: true},
 		"home_team":     {SQLFragment: "(SELECT short_name FROM teams WHERE teams.id = matches.home_team_id)", IsRelation: true},
 		"away_team":     {SQLFragment: "(SELECT short_name FROM teams WHERE teams.id = matches.away_team_id)", IsRelation: true},
+		"venue":         {SQLFragment: "(SELECT name FROM venues WHERE venues.id = matches.venue_id)", IsRelation: true},
+	},
+	EntityMatchReport: {
+		"id":          {SQLFragment: "match_reports.id", IsRelation: false},
+		"match_id":    {SQLFragment: "match_reports.match_id", IsRelation: false},
+		"status":      {SQLFragment: "match_reports.status", IsRelation: false},
+		"reviewed_at": {SQLFragment: "match_reports.reviewed_at", IsRelation: false},
+		"created_at":  {SQLFragment: "match_reports.created_at", IsRelation: false},
+		"updated_at":  {SQLFragment: "match_reports.updated_at", IsRelation: false},
+		"kickoff":     {SQLFragment: "(SELECT kickoff FROM matches WHERE matches.id = match_reports.match_id)", IsRelation: true},
 	},
 	EntityPlayer: {
 		"id":                {SQLFragment: "players.id", IsRelation: false},
@@ -135,6 +148,14 @@ var EntitySortConfig = map[EntityType]map[string]SortConfig{
 		"player_name": {SQLFragment: "(SELECT nick_name FROM players WHERE players.id = player_teams.player_id)", IsRelation: true},
 		"team_name":   {SQLFragment: "(SELECT short_name FROM teams WHERE teams.id = player_teams.team_id)", IsRelation: true},
 	},
+	EntityPrediction: {
+		"id":         {SQLFragment: "predictions.id", IsRelation: false},
+		"match_id":   {SQLFragment: "predictions.match_id", IsRelation: false},
+		"points":     {SQLFragment: "predictions.points", IsRelation: false},
+		"created_at": {SQLFragment: "predictions.created_at", IsRelation: false},
+		"updated_at": {SQLFragment: "predictions.updated_at", IsRelation: false},
+		"kickoff":    {SQLFragment: "(SELECT kickoff FROM matches WHERE matches.id = predictions.match_id)", IsRelation: true},
+	},
 	EntityRole: {
 		"id":          {SQLFragment: "roles.id", IsRelation: false},
 		"name":        {SQLFragment: "roles.name", IsRelation: false},
@@ -159,6 +180,7 @@ var EntitySortConfig = map[EntityType]map[string]SortConfig{
 		"secondary_color": {SQLFragment: "teams.secondary_color", IsRelation: false},
 		"shield":          {SQLFragment: "teams.shield", IsRelation: false},
 		"next_match_id":   {SQLFragment: "teams.next_match_id", IsRelation: false},
+		"home_venue_id":   {SQLFragment: "teams.home_venue_id", IsRelation: false},
 		"created_at":      {SQLFragment: "teams.created_at", IsRelation: false},
 		"updated_at":      {SQLFragment: "teams.updated_at", IsRelation: false},
 	},
@@ -193,6 +215,15 @@ var EntitySortConfig = map[EntityType]map[string]SortConfig{
 		"updated_at":  {SQLFragment: "users.updated_at", IsRelation: false},
 		"role_name":   {SQLFragment: "(SELECT name FROM roles WHERE roles.id = users.role_id)", IsRelation: true},
 	},
+	EntityVenue: {
+		"id":         {SQLFragment: "venues.id", IsRelation: false},
+		"name":       {SQLFragment: "venues.name", IsRelation: false},
+		"address":    {SQLFragment: "venues.address", IsRelation: false},
+		"capacity":   {SQLFragment: "venues.capacity", IsRelation: false},
+		"surface":    {SQLFragment: "venues.surface", IsRelation: false},
+		"created_at": {SQLFragment: "venues.created_at", IsRelation: false},
+		"updated_at": {SQLFragment: "venues.updated_at", IsRelation: false},
+	},
 }
 
 // BuildOrderClause validates inputs and returns a safe GORM clause for ORDER BY.
@@ -7,6 +7,7 @@ import (
 
 	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
 	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/domain"
 	"gorm.io/gorm"
 )
@@ -37,7 +38,11 @@ func (tr *TeamRepositoryImpl) CreateTeam(ctx context.Context, team *domain.Team)
 
 func (tr *TeamRepositoryImpl) GetTeamByID(ctx context.Context, id uint64) (*domain.Team, error) {
 	var team model.Team
-	result := tr.db.WithContext(ctx).Preload("NextMatch").First(&team, id)
+	result := tr.db.WithContext(ctx).
+		Preload("NextMatch").
+		Preload("NextMatch.Venue").
+		Preload("HomeVenue").
+		First(&team, id)
 
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return nil, nil
@@ -76,8 +81,11 @@ func (tr *TeamRepositoryImpl) GetPaginatedTeams(ctx context.Context, sort string
 		return nil, 0, fmt.Errorf("error counting total teams: %w", err)
 	}
 
-	// Build the data query with preloading NextMatch
-	query := tr.db.WithContext(ctx).Model(&model.Team{}).Preload("NextMatch")
+	// Build the data query with preloading NextMatch and HomeVenue
+	query := tr.db.WithContext(ctx).Model(&model.Team{}).
+		Preload("NextMatch").
+		Preload("NextMatch.Venue").
+		Preload("HomeVenue")
 
 	// Apply sorting (safe and validated)
 	col, raw, err := BuildOrderClause(EntityTeam, sort, order)
@@ -105,10 +113,20 @@ func (tr *TeamRepositoryImpl) GetPaginatedTeams(ctx context.Context, sort string
 
 func (tr *TeamRepositoryImpl) UpdateTeam(ctx context.Context, team *domain.Team) error {
 	modelTeam := tr.mapper.DomainToModel(team)
-	result := tr.db.WithContext(ctx).Save(modelTeam)
+	modelTeam.Version = team.Version + 1
+	result := tr.db.WithContext(ctx).
+		Model(&model.Team{}).
+		Where(constants.QueryIDEquals, modelTeam.ID).
+		Where(constants.QueryVersionEquals, team.Version).
+		Select("*").
+		Updates(modelTeam)
 	if result.Error != nil {
 		return fmt.Errorf("failed to update team: %w", result.Error)
 	}
+	if result.RowsAffected == 0 {
+		// Another request changed the record since it was read
+		return constants.ErrVersionMismatch
+	}
 
 	// Update domain entity with new timestamps
 	*team = *tr.mapper.ModelToDomain(modelTeam)
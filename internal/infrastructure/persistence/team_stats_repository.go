@@ -7,6 +7,7 @@ import (
 
 	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
 	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
 	"github.com/EdwinRincon/browersfc-api/domain"
 	"gorm.io/gorm"
 )
@@ -25,7 +26,16 @@ func NewTeamStatsRepository(db *gorm.DB) *TeamStatsRepositoryImpl {
 
 func (tsr *TeamStatsRepositoryImpl) CreateTeamStats(ctx context.Context, teamStats *domain.TeamStats) error {
 	modelTeamStats := tsr.mapper.DomainToModel(teamStats)
-	return tsr.db.WithContext(ctx).Create(modelTeamStats).Error
+	if err := tsr.db.WithContext(ctx).Create(modelTeamStats).Error; err != nil {
+		return err
+	}
+
+	// Update domain entity with generated ID and timestamps
+	teamStats.ID = modelTeamStats.ID
+	teamStats.CreatedAt = modelTeamStats.CreatedAt
+	teamStats.Version = modelTeamStats.Version
+	teamStats.UpdatedAt = modelTeamStats.UpdatedAt
+	return nil
 }
 
 func (tsr *TeamStatsRepositoryImpl) GetTeamStatsByID(ctx context.Context, id uint64) (*domain.TeamStats, error) {
@@ -131,11 +141,22 @@ func (tsr *TeamStatsRepositoryImpl) GetPaginatedTeamStats(ctx context.Context, s
 
 func (tsr *TeamStatsRepositoryImpl) UpdateTeamStats(ctx context.Context, id uint64, teamStats *domain.TeamStats) error {
 	modelTeamStats := tsr.mapper.DomainToModel(teamStats)
-	return tsr.db.WithContext(ctx).
+	modelTeamStats.Version = teamStats.Version + 1
+	result := tsr.db.WithContext(ctx).
 		Model(&model.TeamStat{}).
 		Where("id = ?", id).
+		Where(constants.QueryVersionEquals, teamStats.Version).
 		Select("*").
-		Updates(modelTeamStats).Error
+		Updates(modelTeamStats)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// Another request changed the record since it was read
+		return constants.ErrVersionMismatch
+	}
+	teamStats.Version = modelTeamStats.Version
+	return nil
 }
 
 func (tsr *TeamStatsRepositoryImpl) DeleteTeamStats(ctx context.Context, id uint64) error {
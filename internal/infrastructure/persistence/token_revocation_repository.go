@@ -0,0 +1,89 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TokenRevocationRepositoryImpl implements domain.TokenRevocationRepository interface.
+type TokenRevocationRepositoryImpl struct {
+	db     *gorm.DB
+	mapper *persistence.TokenRevocationPersistenceMapper
+}
+
+func NewTokenRevocationRepository(db *gorm.DB) domain.TokenRevocationRepository {
+	return &TokenRevocationRepositoryImpl{
+		db:     db,
+		mapper: persistence.NewTokenRevocationPersistenceMapper(),
+	}
+}
+
+// RevokeToken stores the revoked token unless it was already revoked
+func (tr *TokenRevocationRepositoryImpl) RevokeToken(ctx context.Context, token *domain.RevokedToken) error {
+	err := tr.db.WithContext(ctx).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "token_id"}}, DoNothing: true}).
+		Create(tr.mapper.ToModel(token)).Error
+	if err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+	return nil
+}
+
+// RevokeUserTokens creates or moves forward the revocation cutoff of the user
+func (tr *TokenRevocationRepositoryImpl) RevokeUserTokens(ctx context.Context, userID string, revokedBefore time.Time) error {
+	// Tokens carry their issue time in whole seconds
+	revocation := &model.UserTokenRevocation{UserID: userID, RevokedBefore: revokedBefore.Truncate(time.Second)}
+	err := tr.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"revoked_before"}),
+		}).
+		Create(revocation).Error
+	if err != nil {
+		return fmt.Errorf("failed to revoke user tokens: %w", err)
+	}
+	return nil
+}
+
+func (tr *TokenRevocationRepositoryImpl) IsTokenRevoked(ctx context.Context, claims *domain.AuthenticationClaims) (bool, error) {
+	var revoked bool
+	err := tr.db.WithContext(ctx).Raw(
+		`SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE token_id = ?)
+			OR EXISTS (SELECT 1 FROM sessions WHERE id = ? AND revoked_at IS NOT NULL)
+			OR EXISTS (SELECT 1 FROM user_token_revocations WHERE user_id = ? AND revoked_before > ?)`,
+		claims.TokenID, claims.SessionID, claims.UserID, claims.IssuedAt,
+	).Scan(&revoked).Error
+	if err != nil {
+		return false, fmt.Errorf("error checking token revocation: %w", err)
+	}
+	return revoked, nil
+}
+
+func (tr *TokenRevocationRepositoryImpl) DeleteExpiredRevocations(ctx context.Context, now, revokedBefore time.Time) (int64, error) {
+	var deleted int64
+	err := tr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("expires_at < ?", now).Delete(&model.RevokedToken{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete expired revoked tokens: %w", result.Error)
+		}
+		deleted += result.RowsAffected
+
+		result = tx.Where("revoked_before < ?", revokedBefore).Delete(&model.UserTokenRevocation{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete expired user token revocations: %w", result.Error)
+		}
+		deleted += result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
@@ -0,0 +1,259 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// PostgreSQL error codes for unique and foreign key constraint violations.
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+)
+
+// TrashRepositoryImpl implements domain.TrashRepository interface over the soft deleted tables.
+type TrashRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewTrashRepository(db *gorm.DB) domain.TrashRepository {
+	return &TrashRepositoryImpl{db: db}
+}
+
+func (tr *TrashRepositoryImpl) GetPaginatedTrash(ctx context.Context, filter domain.TrashFilter, page int, pageSize int) ([]domain.TrashItem, int64, error) {
+	db := tr.db.WithContext(ctx)
+
+	var subqueries []any
+	for _, entityType := range []string{domain.AuditEntityArticle, domain.AuditEntityMatch, domain.AuditEntityPlayer, domain.AuditEntityTeam} {
+		if filter.EntityType == "" || filter.EntityType == entityType {
+			subqueries = append(subqueries, trashQuery(db, entityType))
+		}
+	}
+	if len(subqueries) == 0 {
+		return []domain.TrashItem{}, 0, nil
+	}
+	union := "(" + strings.Repeat("? UNION ALL ", len(subqueries)-1) + "?) AS trash"
+
+	var total int64
+	if err := db.Table(union, subqueries...).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("error counting trash items: %w", err)
+	}
+
+	var items []domain.TrashItem
+	err := db.Table(union, subqueries...).
+		Order("deleted_at DESC, entity_type, entity_id").
+		Offset(page * pageSize).
+		Limit(pageSize).
+		Scan(&items).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("error fetching trash items: %w", err)
+	}
+	return items, total, nil
+}
+
+func (tr *TrashRepositoryImpl) RestoreTrashItem(ctx context.Context, entityType string, id uint64) (bool, error) {
+	value := trashModel(entityType)
+	if value == nil {
+		return false, nil
+	}
+
+	var restored bool
+	err := tr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Model(value).
+			Where("id = ? AND deleted_at IS NOT NULL", id).
+			Update("deleted_at", nil)
+		if isUniqueViolation(result.Error) {
+			// Another record took the name or title while this one was in the trash
+			return constants.ErrRecordAlreadyExists
+		}
+		if result.Error != nil {
+			return fmt.Errorf("failed to restore %s: %w", entityType, result.Error)
+		}
+		restored = result.RowsAffected > 0
+
+		// A restored match counts in the standings again
+		if restored && entityType == domain.AuditEntityMatch {
+			var match model.Match
+			if err := tx.Where(constants.QueryIDEquals, id).First(&match).Error; err != nil {
+				return fmt.Errorf("error getting restored match: %w", err)
+			}
+			return setMatchStandings(tx, &match, true)
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return restored, nil
+}
+
+func (tr *TrashRepositoryImpl) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	var purged int64
+	err := tr.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Matches go first so the players and teams they referenced can be purged in the same run
+		for _, purge := range []func(tx *gorm.DB, deletedBefore time.Time) (int64, error){purgeArticles, purgeMatches, purgePlayers, purgeTeams} {
+			count, err := purge(tx, deletedBefore)
+			if err != nil {
+				return err
+			}
+			purged += count
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+// trashQuery selects the deleted records of the entity type as trash items.
+func trashQuery(db *gorm.DB, entityType string) *gorm.DB {
+	switch entityType {
+	case domain.AuditEntityArticle:
+		return db.Unscoped().Model(&model.Article{}).
+			Select("CAST(? AS varchar) AS entity_type, id AS entity_id, title AS label, deleted_at", entityType).
+			Where("deleted_at IS NOT NULL")
+	case domain.AuditEntityMatch:
+		return db.Unscoped().Model(&model.Match{}).
+			Select("CAST(? AS varchar) AS entity_type, matches.id AS entity_id, CONCAT(home.short_name, ' vs ', away.short_name, ' ', TO_CHAR(matches.kickoff, 'YYYY-MM-DD')) AS label, matches.deleted_at", entityType).
+			Joins("LEFT JOIN teams AS home ON home.id = matches.home_team_id").
+			Joins("LEFT JOIN teams AS away ON away.id = matches.away_team_id").
+			Where("matches.deleted_at IS NOT NULL")
+	case domain.AuditEntityPlayer:
+		return db.Unscoped().Model(&model.Player{}).
+			Select("CAST(? AS varchar) AS entity_type, id AS entity_id, nick_name AS label, deleted_at", entityType).
+			Where("deleted_at IS NOT NULL")
+	default:
+		return db.Unscoped().Model(&model.Team{}).
+			Select("CAST(? AS varchar) AS entity_type, id AS entity_id, full_name AS label, deleted_at", entityType).
+			Where("deleted_at IS NOT NULL")
+	}
+}
+
+// trashModel returns the persistence model of the entity type, or nil when it has no trash.
+func trashModel(entityType string) any {
+	switch entityType {
+	case domain.AuditEntityArticle:
+		return &model.Article{}
+	case domain.AuditEntityMatch:
+		return &model.Match{}
+	case domain.AuditEntityPlayer:
+		return &model.Player{}
+	case domain.AuditEntityTeam:
+		return &model.Team{}
+	}
+	return nil
+}
+
+func purgeArticles(tx *gorm.DB, deletedBefore time.Time) (int64, error) {
+	result := tx.Unscoped().Where("deleted_at < ?", deletedBefore).Delete(&model.Article{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to purge articles: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// purgeMatches deletes the expired matches together with the records that belong to them.
+func purgeMatches(tx *gorm.DB, deletedBefore time.Time) (int64, error) {
+	expired := tx.Unscoped().Model(&model.Match{}).Select("id").Where("deleted_at < ?", deletedBefore)
+	expiredReports := tx.Model(&model.MatchReport{}).Select("id").Where("match_id IN (?)", expired)
+
+	if err := tx.Where("report_id IN (?)", expiredReports).Delete(&model.MatchReportEvent{}).Error; err != nil {
+		return 0, fmt.Errorf("failed to purge match report events: %w", err)
+	}
+	for _, dependent := range []any{&model.MatchReport{}, &model.Lineup{}, &model.PlayerStat{}, &model.Prediction{}, &model.MVPVote{}, &model.MVPPoll{}} {
+		if err := tx.Where("match_id IN (?)", expired).Delete(dependent).Error; err != nil {
+			return 0, fmt.Errorf("failed to purge match records: %w", err)
+		}
+	}
+	if err := tx.Unscoped().Model(&model.Team{}).Where("next_match_id IN (?)", expired).UpdateColumn("next_match_id", nil).Error; err != nil {
+		return 0, fmt.Errorf("failed to unlink purged matches: %w", err)
+	}
+
+	result := tx.Unscoped().Where("deleted_at < ?", deletedBefore).Delete(&model.Match{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to purge matches: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// purgePlayers deletes the expired players that no lineup, statistic, team membership or
+// match report event refers to. The others stay in the trash.
+func purgePlayers(tx *gorm.DB, deletedBefore time.Time) (int64, error) {
+	expired := tx.Unscoped().Model(&model.Player{}).Select("id").
+		Where("deleted_at < ?", deletedBefore).
+		Where("NOT EXISTS (SELECT 1 FROM lineups WHERE lineups.player_id = players.id)").
+		Where("NOT EXISTS (SELECT 1 FROM player_stats WHERE player_stats.player_id = players.id)").
+		Where("NOT EXISTS (SELECT 1 FROM player_teams WHERE player_teams.player_id = players.id)").
+		Where("NOT EXISTS (SELECT 1 FROM match_report_events WHERE match_report_events.player_id = players.id)")
+
+	for _, dependent := range []any{&model.PlayerClaim{}, &model.MVPVote{}} {
+		if err := tx.Where("player_id IN (?)", expired).Delete(dependent).Error; err != nil {
+			return 0, fmt.Errorf("failed to purge player records: %w", err)
+		}
+	}
+	unlinks := []struct {
+		value  any
+		column string
+	}{
+		{&model.Match{}, "mvp_player_id"},
+		{&model.MVPPoll{}, "winner_player_id"},
+		{&model.MatchReportEvent{}, "assist_player_id"},
+	}
+	for _, unlink := range unlinks {
+		if err := tx.Unscoped().Model(unlink.value).Where(unlink.column+" IN (?)", expired).UpdateColumn(unlink.column, nil).Error; err != nil {
+			return 0, fmt.Errorf("failed to unlink purged players: %w", err)
+		}
+	}
+
+	result := tx.Unscoped().Where("id IN (?)", expired).Delete(&model.Player{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to purge players: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// purgeTeams deletes the expired teams that no match, squad, standing or match report event
+// refers to. The others stay in the trash.
+func purgeTeams(tx *gorm.DB, deletedBefore time.Time) (int64, error) {
+	expired := tx.Unscoped().Model(&model.Team{}).Select("id").
+		Where("deleted_at < ?", deletedBefore).
+		Where("NOT EXISTS (SELECT 1 FROM matches WHERE matches.home_team_id = teams.id OR matches.away_team_id = teams.id)").
+		Where("NOT EXISTS (SELECT 1 FROM player_teams WHERE player_teams.team_id = teams.id)").
+		Where("NOT EXISTS (SELECT 1 FROM team_stats WHERE team_stats.team_id = teams.id)").
+		Where("NOT EXISTS (SELECT 1 FROM match_report_events WHERE match_report_events.team_id = teams.id)")
+
+	for _, unlink := range []any{&model.PlayerStat{}, &model.User{}, &model.Invitation{}} {
+		if err := tx.Model(unlink).Where("team_id IN (?)", expired).UpdateColumn("team_id", nil).Error; err != nil {
+			return 0, fmt.Errorf("failed to unlink purged teams: %w", err)
+		}
+	}
+
+	result := tx.Unscoped().Where("id IN (?)", expired).Delete(&model.Team{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to purge teams: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// isUniqueViolation reports whether err was caused by a unique constraint.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation
+}
+
+// isForeignKeyViolation reports whether err was caused by a foreign key, such as deleting a
+// record that other records still refer to.
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation
+}
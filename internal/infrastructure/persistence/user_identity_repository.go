@@ -0,0 +1,63 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+)
+
+// UserIdentityRepositoryImpl implements domain.UserIdentityRepository interface.
+type UserIdentityRepositoryImpl struct {
+	db     *gorm.DB
+	mapper *persistence.UserIdentityPersistenceMapper
+}
+
+func NewUserIdentityRepository(db *gorm.DB) domain.UserIdentityRepository {
+	return &UserIdentityRepositoryImpl{
+		db:     db,
+		mapper: persistence.NewUserIdentityPersistenceMapper(),
+	}
+}
+
+func (ir *UserIdentityRepositoryImpl) CreateIdentity(ctx context.Context, identity *domain.UserIdentity) error {
+	modelIdentity := ir.mapper.ToModel(identity)
+	if err := ir.db.WithContext(ctx).Create(modelIdentity).Error; err != nil {
+		return fmt.Errorf("failed to create user identity: %w", err)
+	}
+	identity.ID = modelIdentity.ID
+	identity.CreatedAt = modelIdentity.CreatedAt
+	return nil
+}
+
+func (ir *UserIdentityRepositoryImpl) GetIdentity(ctx context.Context, provider, subject string) (*domain.UserIdentity, error) {
+	var identity model.UserIdentity
+	result := ir.db.WithContext(ctx).
+		Where("provider = ? AND subject = ?", provider, subject).
+		First(&identity)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting user identity: %w", result.Error)
+	}
+
+	return ir.mapper.ToDomain(&identity), nil
+}
+
+func (ir *UserIdentityRepositoryImpl) TouchIdentity(ctx context.Context, id uint64, lastLoginAt time.Time) error {
+	err := ir.db.WithContext(ctx).
+		Model(&model.UserIdentity{}).
+		Where("id = ?", id).
+		Update("last_login_at", lastLoginAt).Error
+	if err != nil {
+		return fmt.Errorf("failed to update user identity: %w", err)
+	}
+	return nil
+}
@@ -47,7 +47,7 @@ func (ur *UserRepositoryImpl) GetUserByUsername(ctx context.Context, username st
 func (ur *UserRepositoryImpl) GetUserByID(ctx context.Context, id string) (*domain.User, error) {
 	var userModel model.User
 	result := ur.db.WithContext(ctx).
-		Preload("Role").
+		Preload("Role.Permissions").
 		Where(constants.QueryIDEquals, id).
 		First(&userModel)
 
@@ -102,18 +102,89 @@ func (ur *UserRepositoryImpl) GetPaginatedUsers(ctx context.Context, sort string
 
 func (ur *UserRepositoryImpl) CreateUser(ctx context.Context, user *domain.User) error {
 	userModel := ur.mapper.DomainToModel(user)
-	return ur.db.WithContext(ctx).Create(userModel).Error
+	if err := ur.db.WithContext(ctx).Create(userModel).Error; err != nil {
+		return err
+	}
+	user.ID = userModel.ID
+	user.CreatedAt = userModel.CreatedAt
+	user.Version = userModel.Version
+	user.UpdatedAt = userModel.UpdatedAt
+	return nil
 }
 
 func (ur *UserRepositoryImpl) UpdateUser(ctx context.Context, id string, user *domain.User) error {
 	userModel := ur.mapper.DomainToModel(user)
-	return ur.db.WithContext(ctx).
+	userModel.Version = user.Version + 1
+	result := ur.db.WithContext(ctx).
 		Model(&model.User{}).
 		Where(constants.QueryIDEquals, id).
+		Where(constants.QueryVersionEquals, user.Version).
 		Select("*").
-		Updates(userModel).Error
+		Updates(userModel)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// Another request changed the record since it was read
+		return constants.ErrVersionMismatch
+	}
+	user.Version = userModel.Version
+	return nil
 }
 
 func (ur *UserRepositoryImpl) DeleteUser(ctx context.Context, id string) error {
-	return ur.db.WithContext(ctx).Delete(&model.User{}, constants.QueryIDEquals, id).Error
+	err := ur.db.WithContext(ctx).Delete(&model.User{}, constants.QueryIDEquals, id).Error
+	if isForeignKeyViolation(err) {
+		return constants.ErrRecordInUse
+	}
+	return err
+}
+
+func (ur *UserRepositoryImpl) UpdateUserProfile(ctx context.Context, user *domain.User, changes []domain.UserProfileChange) error {
+	return ur.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Only the profile columns, so a concurrent role or team change is not overwritten
+		result := tx.Model(&model.User{}).
+			Where(constants.QueryIDEquals, user.ID).
+			Updates(map[string]interface{}{
+				"name":        user.Name,
+				"last_name":   user.LastName,
+				"birthdate":   user.Birthdate,
+				"img_profile": user.ImgProfile,
+				"img_banner":  user.ImgBanner,
+				"version":     gorm.Expr("version + 1"),
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update user profile: %w", result.Error)
+		}
+		user.Version++
+
+		if len(changes) == 0 {
+			return nil
+		}
+		modelChanges := make([]model.UserProfileChange, len(changes))
+		for i := range changes {
+			modelChanges[i] = *ur.mapper.ProfileChangeToModel(&changes[i])
+		}
+		if err := tx.Create(&modelChanges).Error; err != nil {
+			return fmt.Errorf("failed to record user profile changes: %w", err)
+		}
+		return nil
+	})
+}
+
+func (ur *UserRepositoryImpl) GetUserProfileChanges(ctx context.Context, userID string) ([]domain.UserProfileChange, error) {
+	var modelChanges []model.UserProfileChange
+	err := ur.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").
+		Find(&modelChanges).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting user profile changes: %w", err)
+	}
+
+	changes := make([]domain.UserProfileChange, len(modelChanges))
+	for i := range modelChanges {
+		changes[i] = *ur.mapper.ProfileChangeToDomain(&modelChanges[i])
+	}
+	return changes, nil
 }
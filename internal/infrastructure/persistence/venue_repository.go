@@ -0,0 +1,151 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/EdwinRincon/browersfc-api/adapter/persistence"
+	"github.com/EdwinRincon/browersfc-api/api/constants"
+	"github.com/EdwinRincon/browersfc-api/domain"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"gorm.io/gorm"
+)
+
+// VenueRepositoryImpl implements domain.VenueRepository interface.
+type VenueRepositoryImpl struct {
+	db     *gorm.DB
+	mapper *persistence.VenuePersistenceMapper
+}
+
+func NewVenueRepository(db *gorm.DB) domain.VenueRepository {
+	return &VenueRepositoryImpl{
+		db:     db,
+		mapper: persistence.NewVenuePersistenceMapper(),
+	}
+}
+
+func (vr *VenueRepositoryImpl) CreateVenue(ctx context.Context, venue *domain.Venue) error {
+	modelVenue := vr.mapper.DomainToModel(venue)
+	if err := vr.db.WithContext(ctx).Create(modelVenue).Error; err != nil {
+		return fmt.Errorf("failed to create venue: %w", err)
+	}
+
+	// Update domain entity with generated ID and timestamps
+	*venue = *vr.mapper.ModelToDomain(modelVenue)
+	return nil
+}
+
+func (vr *VenueRepositoryImpl) GetVenueByID(ctx context.Context, id uint64) (*domain.Venue, error) {
+	var venue model.Venue
+	result := vr.db.WithContext(ctx).
+		Where(constants.QueryIDEquals, id).
+		First(&venue)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting venue by ID: %w", result.Error)
+	}
+
+	return vr.mapper.ModelToDomain(&venue), nil
+}
+
+// GetVenueByName looks up a venue by name ignoring case and surrounding whitespace
+func (vr *VenueRepositoryImpl) GetVenueByName(ctx context.Context, name string) (*domain.Venue, error) {
+	var venue model.Venue
+	result := vr.db.WithContext(ctx).
+		Where("LOWER(TRIM(name)) = ?", strings.ToLower(strings.TrimSpace(name))).
+		First(&venue)
+
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting venue by name: %w", result.Error)
+	}
+
+	return vr.mapper.ModelToDomain(&venue), nil
+}
+
+// GetPaginatedVenues retrieves a paginated list of venues with total count.
+func (vr *VenueRepositoryImpl) GetPaginatedVenues(ctx context.Context, sort string, order string, page int, pageSize int) ([]domain.Venue, int64, error) {
+	var venues []model.Venue
+	var total int64
+
+	// Count total records
+	countQuery := vr.db.WithContext(ctx).Model(&model.Venue{})
+	if err := countQuery.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("error counting total venues: %w", err)
+	}
+
+	// Build the data query
+	query := vr.db.WithContext(ctx).Model(&model.Venue{})
+
+	// Apply sorting (safe and validated)
+	col, raw, err := BuildOrderClause(EntityVenue, sort, order)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error building sort clause: %w", err)
+	}
+
+	if raw != "" {
+		query = query.Order(raw)
+	} else {
+		query = query.Order(col)
+	}
+
+	// Apply pagination
+	offset := page * pageSize
+	query = query.Offset(offset).Limit(pageSize)
+
+	// Execute the query
+	if err := query.Find(&venues).Error; err != nil {
+		return nil, 0, fmt.Errorf("error fetching venues: %w", err)
+	}
+
+	return vr.mapper.ModelListToDomain(venues), total, nil
+}
+
+func (vr *VenueRepositoryImpl) UpdateVenue(ctx context.Context, id uint64, venue *domain.Venue) error {
+	modelVenue := vr.mapper.DomainToModel(venue)
+	modelVenue.Version = venue.Version + 1
+	result := vr.db.WithContext(ctx).
+		Model(&model.Venue{}).
+		Where(constants.QueryIDEquals, id).
+		Where(constants.QueryVersionEquals, venue.Version).
+		Select("name", "address", "capacity", "surface", "latitude", "longitude", "version").
+		Updates(modelVenue)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// Another request changed the record since it was read
+		return constants.ErrVersionMismatch
+	}
+	venue.Version = modelVenue.Version
+	return nil
+}
+
+func (vr *VenueRepositoryImpl) DeleteVenue(ctx context.Context, id uint64) error {
+	result := vr.db.WithContext(ctx).Delete(&model.Venue{}, id)
+	if isForeignKeyViolation(result.Error) {
+		// A match was linked to the venue after it was counted
+		return constants.ErrVenueInUse
+	}
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete venue: %w", result.Error)
+	}
+	return nil
+}
+
+// CountMatchesByVenueID returns how many matches reference the venue, including the matches
+// in the trash, since restoring one must find its venue again
+func (vr *VenueRepositoryImpl) CountMatchesByVenueID(ctx context.Context, id uint64) (int64, error) {
+	var total int64
+	if err := vr.db.WithContext(ctx).Unscoped().Model(&model.Match{}).Where("venue_id = ?", id).Count(&total).Error; err != nil {
+		return 0, fmt.Errorf("error counting matches for venue: %w", err)
+	}
+	return total, nil
+}
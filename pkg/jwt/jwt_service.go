@@ -4,35 +4,57 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 // AppClaims defines JWT token claims structure
 type AppClaims struct {
-	Username string `json:"username"`
-	Role     string `json:"role"`
+	Username    string   `json:"username"`
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions,omitempty"`
+	SessionID   string   `json:"sid,omitempty"`
 	jwt.RegisteredClaims
 }
 
 // JWTService handles JWT token generation and validation
 type JWTService struct {
-	SecretKey []byte
+	Keys           *KeySet
+	AccessTokenTTL time.Duration
 }
 
 // NewJWTService creates a new instance of JWTService
-func NewJWTService(secretKey string) *JWTService {
-	return &JWTService{SecretKey: []byte(secretKey)}
+func NewJWTService(keys *KeySet, accessTokenTTL time.Duration) *JWTService {
+	return &JWTService{Keys: keys, AccessTokenTTL: accessTokenTTL}
 }
 
-// GenerateToken creates a new JWT token for a given user
-func (s *JWTService) GenerateToken(username, role string) (string, error) {
+// GenerateToken creates a new JWT token for a given user.
+// The user ID is stored in the standard subject claim and the permissions
+// granted by the user's role travel with the token. Every token gets a unique
+// jti claim and the sid claim of the session it belongs to, so it can be revoked
+// on its own or together with its session before it expires.
+func (s *JWTService) GenerateToken(userID, username, role, sessionID string, permissions []string) (string, error) {
+	now := time.Now()
 	claims := &AppClaims{
-		Username: username,
-		Role:     role,
+		Username:    username,
+		Role:        role,
+		Permissions: permissions,
+		SessionID:   sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * 1)), // Token expires in 1 hour
+			ID:        uuid.New().String(),
+			Subject:   userID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.AccessTokenTTL)),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(s.SecretKey)
+	// The kid header tells verifiers which key of the set signed the token
+	key := s.Keys.SigningKey()
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.PrivateKey)
+}
+
+// JWKS returns the public keys that verify the tokens issued by this service.
+func (s *JWTService) JWKS() JSONWebKeySet {
+	return s.Keys.JWKS()
 }
@@ -15,12 +15,12 @@ var (
 )
 
 type TokenValidator struct {
-	jwtSecret []byte
+	keys *KeySet
 }
 
-func NewTokenValidator(secret []byte) *TokenValidator {
+func NewTokenValidator(keys *KeySet) *TokenValidator {
 	return &TokenValidator{
-		jwtSecret: secret,
+		keys: keys,
 	}
 }
 
@@ -29,12 +29,20 @@ func (v *TokenValidator) ValidateToken(tokenString string) (*AppClaims, error) {
 		tokenString,
 		&AppClaims{},
 		func(token *jwt.Token) (interface{}, error) {
-			// Validate the alg is what we expect
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			// Select the verification key from the kid header
+			keyID, _ := token.Header["kid"].(string)
+			key, ok := v.keys.Lookup(keyID)
+			if !ok {
+				return nil, ErrUnknownKeyID
+			}
+
+			// Validate the alg is the one of the key, never the one chosen by the token
+			if token.Method.Alg() != key.Method.Alg() {
 				return nil, ErrUnsupportedAlg
 			}
-			return v.jwtSecret, nil
+			return key.PublicKey, nil
 		},
+		jwt.WithValidMethods(v.keys.ValidMethods()),
 	)
 
 	if err != nil {
@@ -0,0 +1,248 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSAKeyBits is the smallest RSA modulus accepted for signing keys.
+const minRSAKeyBits = 2048
+
+var (
+	ErrNoSigningKey   = errors.New("no signing key available")
+	ErrUnknownKeyID   = errors.New("unknown key ID")
+	ErrUnsupportedKey = errors.New("unsupported key type")
+)
+
+// Key is a verification key of the key set. Keys whose private part is present can also sign.
+type Key struct {
+	ID         string
+	Method     jwt.SigningMethod
+	PublicKey  crypto.PublicKey
+	PrivateKey crypto.Signer
+}
+
+// CanSign reports whether the key holds its private part.
+func (k *Key) CanSign() bool {
+	return k.PrivateKey != nil
+}
+
+// KeySet holds the keys used to sign and verify tokens.
+// Tokens are signed with a single active key while every key of the set is accepted
+// for verification, which lets old tokens stay valid while keys are rotated.
+type KeySet struct {
+	signingKey *Key
+	keys       map[string]*Key
+}
+
+// JSONWebKey is the public part of a key in the JWK format (RFC 7517).
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+}
+
+// JSONWebKeySet is the document published at /.well-known/jwks.json.
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// LoadKeySet reads every PEM file (*.pem) in dir. The file name without its extension is
+// the key ID. Files holding a private key (PKCS#8, or PKCS#1 for RSA) can sign; files
+// holding only a public key (PKIX) are accepted for verification, which is how retired
+// keys are kept during rotation. The signing key is activeKeyID, or the last private
+// key in name order when activeKeyID is empty.
+func LoadKeySet(dir, activeKeyID string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("error listing JWT keys: %w", err)
+	}
+	sort.Strings(paths)
+
+	keys := make([]*Key, 0, len(paths))
+	for _, path := range paths {
+		key, err := loadKey(path)
+		if err != nil {
+			return nil, fmt.Errorf("error loading JWT key %s: %w", filepath.Base(path), err)
+		}
+		keys = append(keys, key)
+	}
+
+	return NewKeySet(keys, activeKeyID)
+}
+
+// NewKeySet builds a key set from already parsed keys.
+func NewKeySet(keys []*Key, activeKeyID string) (*KeySet, error) {
+	set := &KeySet{keys: make(map[string]*Key, len(keys))}
+	for _, key := range keys {
+		if _, exists := set.keys[key.ID]; exists {
+			return nil, fmt.Errorf("duplicate JWT key ID %q", key.ID)
+		}
+		set.keys[key.ID] = key
+		if key.CanSign() && activeKeyID == "" {
+			set.signingKey = key
+		}
+	}
+
+	if activeKeyID != "" {
+		key, ok := set.keys[activeKeyID]
+		if !ok || !key.CanSign() {
+			return nil, fmt.Errorf("%w: %q has no private key", ErrNoSigningKey, activeKeyID)
+		}
+		set.signingKey = key
+	}
+	if set.signingKey == nil {
+		return nil, ErrNoSigningKey
+	}
+
+	return set, nil
+}
+
+// GenerateEphemeralKeySet creates a key set with a single in-memory Ed25519 key.
+// Tokens signed with it do not survive a restart, so it is only meant for development.
+func GenerateEphemeralKeySet() (*KeySet, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeySet([]*Key{{
+		ID:         "ephemeral",
+		Method:     jwt.SigningMethodEdDSA,
+		PublicKey:  publicKey,
+		PrivateKey: privateKey,
+	}}, "")
+}
+
+// SigningKey returns the key new tokens are signed with.
+func (s *KeySet) SigningKey() *Key {
+	return s.signingKey
+}
+
+// Lookup returns the verification key with the given ID.
+func (s *KeySet) Lookup(keyID string) (*Key, bool) {
+	key, ok := s.keys[keyID]
+	return key, ok
+}
+
+// ValidMethods returns the algorithms of the keys in the set.
+func (s *KeySet) ValidMethods() []string {
+	seen := make(map[string]bool)
+	methods := make([]string, 0, 2)
+	for _, key := range s.keys {
+		alg := key.Method.Alg()
+		if !seen[alg] {
+			seen[alg] = true
+			methods = append(methods, alg)
+		}
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+// JWKS returns the public keys of the set, sorted by key ID.
+func (s *KeySet) JWKS() JSONWebKeySet {
+	ids := make([]string, 0, len(s.keys))
+	for id := range s.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	set := JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(ids))}
+	for _, id := range ids {
+		set.Keys = append(set.Keys, s.keys[id].jwk())
+	}
+	return set
+}
+
+// jwk encodes the public key in the JWK format.
+func (k *Key) jwk() JSONWebKey {
+	jwk := JSONWebKey{KeyID: k.ID, Use: "sig", Algorithm: k.Method.Alg()}
+	switch publicKey := k.PublicKey.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+	}
+	return jwk
+}
+
+// loadKey parses the first PEM block of the file into a key.
+func loadKey(path string) (*Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	keyID := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := parsed.(crypto.Signer)
+		if !ok {
+			return nil, ErrUnsupportedKey
+		}
+		return newKey(keyID, signer.Public(), signer)
+	case "RSA PRIVATE KEY":
+		parsed, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return newKey(keyID, parsed.Public(), parsed)
+	case "PUBLIC KEY":
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return newKey(keyID, parsed, nil)
+	}
+	return nil, fmt.Errorf("%w: PEM block %q", ErrUnsupportedKey, block.Type)
+}
+
+// newKey picks the signing method matching the key type.
+func newKey(keyID string, publicKey crypto.PublicKey, privateKey crypto.Signer) (*Key, error) {
+	key := &Key{ID: keyID, PublicKey: publicKey, PrivateKey: privateKey}
+	switch publicKey := publicKey.(type) {
+	case *rsa.PublicKey:
+		if publicKey.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("RSA key must be at least %d bits", minRSAKeyBits)
+		}
+		key.Method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		key.Method = jwt.SigningMethodEdDSA
+	default:
+		return nil, ErrUnsupportedKey
+	}
+	return key, nil
+}
@@ -0,0 +1,188 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func newEd25519Key(t *testing.T, id string) *Key {
+	t.Helper()
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Key{ID: id, Method: jwt.SigningMethodEdDSA, PublicKey: publicKey, PrivateKey: privateKey}
+}
+
+// publicOnly returns the key as it is kept after rotation, without its private part.
+func publicOnly(key *Key) *Key {
+	return &Key{ID: key.ID, Method: key.Method, PublicKey: key.PublicKey}
+}
+
+func TestNewKeySet(t *testing.T) {
+	first := newEd25519Key(t, "2024-01")
+	second := newEd25519Key(t, "2024-06")
+
+	tests := []struct {
+		name        string
+		keys        []*Key
+		activeKeyID string
+		wantSigning string
+		wantErr     bool
+		wantErrIs   error
+	}{
+		{name: "last private key by default", keys: []*Key{first, second}, wantSigning: "2024-06"},
+		{name: "active key", keys: []*Key{first, second}, activeKeyID: "2024-01", wantSigning: "2024-01"},
+		{name: "retired keys are skipped", keys: []*Key{first, publicOnly(second)}, wantSigning: "2024-01"},
+		{name: "active key without private part", keys: []*Key{first, publicOnly(second)}, activeKeyID: "2024-06", wantErr: true, wantErrIs: ErrNoSigningKey},
+		{name: "unknown active key", keys: []*Key{first}, activeKeyID: "missing", wantErr: true, wantErrIs: ErrNoSigningKey},
+		{name: "only public keys", keys: []*Key{publicOnly(first)}, wantErr: true, wantErrIs: ErrNoSigningKey},
+		{name: "duplicate key ID", keys: []*Key{first, first}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, err := NewKeySet(tt.keys, tt.activeKeyID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("NewKeySet() signs with %q, want an error", set.SigningKey().ID)
+				}
+				if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+					t.Fatalf("NewKeySet() error = %v, want %v", err, tt.wantErrIs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewKeySet() error = %v", err)
+			}
+			if got := set.SigningKey().ID; got != tt.wantSigning {
+				t.Errorf("SigningKey().ID = %q, want %q", got, tt.wantSigning)
+			}
+		})
+	}
+}
+
+func TestValidateTokenSelectsKeyByKid(t *testing.T) {
+	retired := newEd25519Key(t, "2024-01")
+	active := newEd25519Key(t, "2024-06")
+	rsaPrivateKey, err := rsa.GenerateKey(rand.Reader, minRSAKeyBits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey := &Key{ID: "rsa", Method: jwt.SigningMethodRS256, PublicKey: rsaPrivateKey.Public(), PrivateKey: rsaPrivateKey}
+
+	set, err := NewKeySet([]*Key{publicOnly(retired), active, publicOnly(rsaKey)}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator := NewTokenValidator(set)
+
+	sign := func(key *Key, method jwt.SigningMethod, kid any) string {
+		t.Helper()
+		claims := &AppClaims{RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		}}
+		token := jwt.NewWithClaims(method, claims)
+		if kid != nil {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(key.PrivateKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	issued, err := NewJWTService(set, time.Minute).GenerateToken("user", "user@example.com", "fan", "session", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "issued with the active key", token: issued},
+		{name: "signed with a retired key", token: sign(retired, jwt.SigningMethodEdDSA, "2024-01")},
+		{name: "signed with the RSA key", token: sign(rsaKey, jwt.SigningMethodRS256, "rsa")},
+		{name: "kid of another key", token: sign(retired, jwt.SigningMethodEdDSA, "2024-06"), wantErr: true},
+		{name: "algorithm differs from the key", token: sign(active, jwt.SigningMethodEdDSA, "rsa"), wantErr: true},
+		{name: "unknown kid", token: sign(active, jwt.SigningMethodEdDSA, "2023-01"), wantErr: true},
+		{name: "missing kid", token: sign(active, jwt.SigningMethodEdDSA, nil), wantErr: true},
+		{name: "kid is not a string", token: sign(active, jwt.SigningMethodEdDSA, 1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := validator.ValidateToken(tt.token)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidToken) {
+					t.Fatalf("ValidateToken() error = %v, want %v", err, ErrInvalidToken)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ValidateToken() error = %v", err)
+			}
+			if claims.Subject != "user" {
+				t.Errorf("Subject = %q, want %q", claims.Subject, "user")
+			}
+		})
+	}
+}
+
+func TestLoadKeySet(t *testing.T) {
+	dir := t.TempDir()
+	writePEM := func(name, blockType string, der []byte) {
+		t.Helper()
+		data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	retired := newEd25519Key(t, "2024-01")
+	publicDER, err := x509.MarshalPKIXPublicKey(retired.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writePEM("2024-01.pem", "PUBLIC KEY", publicDER)
+
+	active := newEd25519Key(t, "2024-06")
+	privateDER, err := x509.MarshalPKCS8PrivateKey(active.PrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writePEM("2024-06.pem", "PRIVATE KEY", privateDER)
+	writePEM("notes.txt", "PRIVATE KEY", []byte("ignored"))
+
+	set, err := LoadKeySet(dir, "")
+	if err != nil {
+		t.Fatalf("LoadKeySet() error = %v", err)
+	}
+	if got := set.SigningKey().ID; got != "2024-06" {
+		t.Errorf("SigningKey().ID = %q, want %q", got, "2024-06")
+	}
+	if key, ok := set.Lookup("2024-01"); !ok || key.CanSign() {
+		t.Errorf("Lookup(2024-01) = %v, %v, want a verification-only key", key, ok)
+	}
+	if got := len(set.JWKS().Keys); got != 2 {
+		t.Errorf("JWKS() has %d keys, want 2", got)
+	}
+
+	if _, err := LoadKeySet(dir, "2024-01"); !errors.Is(err, ErrNoSigningKey) {
+		t.Errorf("LoadKeySet() with a public active key error = %v, want %v", err, ErrNoSigningKey)
+	}
+}
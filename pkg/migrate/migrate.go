@@ -0,0 +1,298 @@
+// Package migrate applies the versioned SQL migrations embedded in the binary.
+//
+// Each migration is a pair of files in the migrations directory named
+// <version>_<name>.up.sql and <version>_<name>.down.sql. Applied versions are recorded in the
+// schema_migrations table, and a PostgreSQL advisory lock keeps API replicas that start at
+// the same time from applying a migration twice.
+package migrate
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"time"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// lockKey identifies the advisory lock held while migrations run.
+const lockKey int64 = 0x62726f7765727366 // "browersf"
+
+// allowSchemaDrop confirms to the baseline down migration that its tables may be dropped.
+// The setting only lasts until the end of the transaction.
+const allowSchemaDrop = "SELECT set_config('browersfc.allow_schema_drop', 'on', true);\n"
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// ErrInvalidSteps is returned by Down when asked to roll back fewer than one migration.
+var ErrInvalidSteps = errors.New("the number of migrations to roll back must be at least 1")
+
+// Migration is a schema change and the statements that undo it.
+type Migration struct {
+	Version uint64
+	Name    string
+	up      string
+	down    string
+}
+
+// Status reports whether a migration has been applied.
+type Status struct {
+	Version   uint64
+	Name      string
+	AppliedAt *time.Time // Nil while the migration is pending
+}
+
+// Migrator applies the embedded migrations to a database.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+// New creates a migrator for the database with the embedded migrations.
+func New(db *sql.DB) (*Migrator, error) {
+	migrations, err := loadMigrations(migrationFiles)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Up applies every pending migration in version order and returns how many were applied.
+// Each migration runs in its own transaction, so a failure leaves the earlier ones applied.
+func (m *Migrator) Up(ctx context.Context) (int, error) {
+	applied := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		versions, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := versions[migration.Version]; ok {
+				continue
+			}
+			err := inTransaction(ctx, conn, migration.up,
+				"INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)",
+				migration.Version, migration.Name, time.Now().UTC())
+			if err != nil {
+				return fmt.Errorf("migration %d_%s failed: %w", migration.Version, migration.Name, err)
+			}
+			slog.Info("migration applied", "version", migration.Version, "name", migration.Name)
+			applied++
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down rolls back the given number of applied migrations, newest first, and returns how
+// many were rolled back. The baseline schema is only dropped when dropSchema is set, since
+// its tables usually held data before they were adopted.
+func (m *Migrator) Down(ctx context.Context, steps int, dropSchema bool) (int, error) {
+	if steps < 1 {
+		return 0, ErrInvalidSteps
+	}
+
+	rolledBack := 0
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		versions, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		applied := make([]uint64, 0, len(versions))
+		for version := range versions {
+			applied = append(applied, version)
+		}
+		slices.Sort(applied)
+		slices.Reverse(applied)
+
+		for _, version := range applied[:min(steps, len(applied))] {
+			migration, ok := m.find(version)
+			if !ok {
+				return fmt.Errorf("migration %d is applied but not known to this build", version)
+			}
+			statements := migration.down
+			if dropSchema {
+				statements = allowSchemaDrop + statements
+			}
+			err := inTransaction(ctx, conn, statements,
+				"DELETE FROM schema_migrations WHERE version = $1", migration.Version)
+			if err != nil {
+				return fmt.Errorf("rollback of migration %d_%s failed: %w", migration.Version, migration.Name, err)
+			}
+			slog.Info("migration rolled back", "version", migration.Version, "name", migration.Name)
+			rolledBack++
+		}
+		return nil
+	})
+	return rolledBack, err
+}
+
+// Status lists every known migration in version order, followed by the applied versions
+// that this build does not know about.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		versions, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			status := Status{Version: migration.Version, Name: migration.Name}
+			if appliedAt, ok := versions[migration.Version]; ok {
+				status.AppliedAt = &appliedAt
+				delete(versions, migration.Version)
+			}
+			statuses = append(statuses, status)
+		}
+
+		unknown := make([]Status, 0, len(versions))
+		for version, appliedAt := range versions {
+			unknown = append(unknown, Status{Version: version, Name: "(unknown)", AppliedAt: &appliedAt})
+		}
+		slices.SortFunc(unknown, func(a, b Status) int { return cmp.Compare(a.Version, b.Version) })
+		statuses = append(statuses, unknown...)
+		return nil
+	})
+	return statuses, err
+}
+
+// find returns the migration with the given version.
+func (m *Migrator) find(version uint64) (Migration, bool) {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+	return Migration{}, false
+}
+
+// withLock runs fn on a single connection holding the migration advisory lock, creating
+// the schema_migrations table first. Other replicas wait until the lock is released.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting database connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return fmt.Errorf("error acquiring migration lock: %w", err)
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", lockKey); err != nil {
+			slog.Error("error releasing migration lock", "error", err)
+		}
+	}()
+
+	createTable := `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    bigint PRIMARY KEY,
+			name       varchar(255) NOT NULL,
+			applied_at timestamp NOT NULL
+		)`
+	if _, err := conn.ExecContext(ctx, createTable); err != nil {
+		return fmt.Errorf("error creating schema_migrations table: %w", err)
+	}
+
+	return fn(conn)
+}
+
+// appliedVersions returns the applied migration versions with the time they were applied.
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[uint64]time.Time, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, fmt.Errorf("error reading schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	versions := make(map[uint64]time.Time)
+	for rows.Next() {
+		var version uint64
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("error reading schema_migrations: %w", err)
+		}
+		versions[version] = appliedAt
+	}
+	return versions, rows.Err()
+}
+
+// inTransaction runs the statements of a migration and the query that records it atomically.
+func inTransaction(ctx context.Context, conn *sql.Conn, statements, record string, args ...any) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }() // No-op once committed
+
+	// Without arguments the statements are sent in a single simple query, so a file may hold several
+	if _, err := tx.ExecContext(ctx, statements); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// loadMigrations reads the migrations in version order, checking that each one can be rolled back.
+func loadMigrations(files fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(files, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("error reading migrations: %w", err)
+	}
+
+	byVersion := make(map[uint64]*Migration)
+	for _, entry := range entries {
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q", entry.Name())
+		}
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %q: %w", entry.Name(), err)
+		}
+
+		contents, err := fs.ReadFile(files, path.Join("migrations", entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error reading migration %q: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has files with different names", version)
+		}
+		if match[3] == "up" {
+			migration.up = string(contents)
+		} else {
+			migration.down = string(contents)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.up == "" || migration.down == "" {
+			return nil, fmt.Errorf("migration %d_%s needs both an up and a down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	slices.SortFunc(migrations, func(a, b Migration) int { return cmp.Compare(a.Version, b.Version) })
+	return migrations, nil
+}
@@ -0,0 +1,367 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// fakeDatabase is an in-memory stand-in for PostgreSQL that records the migration
+// statements it runs and keeps the schema_migrations table.
+type fakeDatabase struct {
+	applied  map[uint64]time.Time
+	executed []string // Migration statements of the committed transactions, in order
+	failOn   string   // Statements containing it fail
+}
+
+func newFakeDatabase(applied ...uint64) *fakeDatabase {
+	db := &fakeDatabase{applied: make(map[uint64]time.Time)}
+	for _, version := range applied {
+		db.applied[version] = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return db
+}
+
+func (db *fakeDatabase) Connect(context.Context) (driver.Conn, error) { return &fakeConn{db: db}, nil }
+func (db *fakeDatabase) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct {
+	db *fakeDatabase
+	tx *fakeTx
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	c.tx = &fakeTx{conn: c}
+	return c.tx, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	switch {
+	case strings.Contains(query, "pg_advisory"), strings.Contains(query, "CREATE TABLE IF NOT EXISTS schema_migrations"):
+		return driver.RowsAffected(0), nil
+	case c.tx == nil:
+		return nil, errors.New("migration statements must run in a transaction")
+	case strings.HasPrefix(query, "INSERT INTO schema_migrations"):
+		c.tx.inserts = append(c.tx.inserts, uint64(args[0].Value.(int64)))
+	case strings.HasPrefix(query, "DELETE FROM schema_migrations"):
+		c.tx.deletes = append(c.tx.deletes, uint64(args[0].Value.(int64)))
+	case c.db.failOn != "" && strings.Contains(query, c.db.failOn):
+		return nil, errors.New("syntax error")
+	default:
+		c.tx.statements = append(c.tx.statements, query)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	if query != "SELECT version, applied_at FROM schema_migrations" {
+		return nil, errors.New("unexpected query")
+	}
+	rows := &fakeRows{}
+	for version, appliedAt := range c.db.applied {
+		rows.values = append(rows.values, []driver.Value{int64(version), appliedAt})
+	}
+	return rows, nil
+}
+
+type fakeTx struct {
+	conn       *fakeConn
+	statements []string
+	inserts    []uint64
+	deletes    []uint64
+}
+
+func (tx *fakeTx) Commit() error {
+	db := tx.conn.db
+	db.executed = append(db.executed, tx.statements...)
+	for _, version := range tx.inserts {
+		db.applied[version] = time.Now()
+	}
+	for _, version := range tx.deletes {
+		delete(db.applied, version)
+	}
+	tx.conn.tx = nil
+	return nil
+}
+
+func (tx *fakeTx) Rollback() error {
+	tx.conn.tx = nil
+	return nil
+}
+
+type fakeRows struct {
+	values [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return []string{"version", "applied_at"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+// testMigrations are three migrations whose statements name their version.
+var testMigrations = []Migration{
+	{Version: 1, Name: "baseline", up: "up 1", down: "down 1"},
+	{Version: 2, Name: "second", up: "up 2", down: "down 2"},
+	{Version: 3, Name: "third", up: "up 3", down: "down 3"},
+}
+
+func newTestMigrator(t *testing.T, db *fakeDatabase) *Migrator {
+	t.Helper()
+	sqlDB := sql.OpenDB(db)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	return &Migrator{db: sqlDB, migrations: testMigrations}
+}
+
+func appliedList(db *fakeDatabase) []uint64 {
+	versions := make([]uint64, 0, len(db.applied))
+	for version := range db.applied {
+		versions = append(versions, version)
+	}
+	slices.Sort(versions)
+	return versions
+}
+
+func TestUp(t *testing.T) {
+	tests := []struct {
+		name         string
+		applied      []uint64
+		failOn       string
+		wantCount    int
+		wantExecuted []string
+		wantApplied  []uint64
+		wantErr      bool
+	}{
+		{
+			name:         "empty database",
+			wantCount:    3,
+			wantExecuted: []string{"up 1", "up 2", "up 3"},
+			wantApplied:  []uint64{1, 2, 3},
+		},
+		{
+			name:         "only pending migrations",
+			applied:      []uint64{1},
+			wantCount:    2,
+			wantExecuted: []string{"up 2", "up 3"},
+			wantApplied:  []uint64{1, 2, 3},
+		},
+		{
+			name:        "up to date",
+			applied:     []uint64{1, 2, 3},
+			wantApplied: []uint64{1, 2, 3},
+		},
+		{
+			name:         "failure keeps earlier migrations",
+			failOn:       "up 2",
+			wantCount:    1,
+			wantExecuted: []string{"up 1"},
+			wantApplied:  []uint64{1},
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newFakeDatabase(tt.applied...)
+			db.failOn = tt.failOn
+
+			count, err := newTestMigrator(t, db).Up(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Up() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if count != tt.wantCount {
+				t.Errorf("Up() = %d, want %d", count, tt.wantCount)
+			}
+			if !slices.Equal(db.executed, tt.wantExecuted) {
+				t.Errorf("executed %q, want %q", db.executed, tt.wantExecuted)
+			}
+			if got := appliedList(db); !slices.Equal(got, tt.wantApplied) {
+				t.Errorf("applied %v, want %v", got, tt.wantApplied)
+			}
+		})
+	}
+}
+
+func TestDown(t *testing.T) {
+	tests := []struct {
+		name         string
+		applied      []uint64
+		steps        int
+		dropSchema   bool
+		wantCount    int
+		wantExecuted []string
+		wantApplied  []uint64
+		wantErr      bool
+	}{
+		{
+			name:         "newest first",
+			applied:      []uint64{1, 2, 3},
+			steps:        2,
+			wantCount:    2,
+			wantExecuted: []string{"down 3", "down 2"},
+			wantApplied:  []uint64{1},
+		},
+		{
+			name:         "more steps than applied",
+			applied:      []uint64{1},
+			steps:        5,
+			dropSchema:   true,
+			wantCount:    1,
+			wantExecuted: []string{allowSchemaDrop + "down 1"},
+			wantApplied:  []uint64{},
+		},
+		{
+			name:        "zero steps",
+			applied:     []uint64{1},
+			steps:       0,
+			wantApplied: []uint64{1},
+			wantErr:     true,
+		},
+		{
+			name:        "unknown applied version",
+			applied:     []uint64{1, 4},
+			steps:       1,
+			wantApplied: []uint64{1, 4},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newFakeDatabase(tt.applied...)
+
+			count, err := newTestMigrator(t, db).Down(context.Background(), tt.steps, tt.dropSchema)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Down() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if count != tt.wantCount {
+				t.Errorf("Down() = %d, want %d", count, tt.wantCount)
+			}
+			if !slices.Equal(db.executed, tt.wantExecuted) {
+				t.Errorf("executed %q, want %q", db.executed, tt.wantExecuted)
+			}
+			if got := appliedList(db); !slices.Equal(got, tt.wantApplied) {
+				t.Errorf("applied %v, want %v", got, tt.wantApplied)
+			}
+		})
+	}
+}
+
+func TestStatus(t *testing.T) {
+	db := newFakeDatabase(1, 2, 7)
+
+	statuses, err := newTestMigrator(t, db).Status(context.Background())
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+
+	want := []struct {
+		version uint64
+		name    string
+		applied bool
+	}{
+		{1, "baseline", true},
+		{2, "second", true},
+		{3, "third", false},
+		{7, "(unknown)", true},
+	}
+	if len(statuses) != len(want) {
+		t.Fatalf("Status() returned %d migrations, want %d", len(statuses), len(want))
+	}
+	for i, w := range want {
+		got := statuses[i]
+		if got.Version != w.version || got.Name != w.name || (got.AppliedAt != nil) != w.applied {
+			t.Errorf("status %d = {%d %s applied=%v}, want {%d %s applied=%v}",
+				i, got.Version, got.Name, got.AppliedAt != nil, w.version, w.name, w.applied)
+		}
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	tests := []struct {
+		name         string
+		files        fstest.MapFS
+		wantVersions []uint64
+		wantErr      bool
+	}{
+		{
+			name: "sorted by version",
+			files: fstest.MapFS{
+				"migrations/0010_later.up.sql":    {Data: []byte("up 10")},
+				"migrations/0010_later.down.sql":  {Data: []byte("down 10")},
+				"migrations/0002_second.up.sql":   {Data: []byte("up 2")},
+				"migrations/0002_second.down.sql": {Data: []byte("down 2")},
+			},
+			wantVersions: []uint64{2, 10},
+		},
+		{
+			name: "missing down file",
+			files: fstest.MapFS{
+				"migrations/0001_baseline.up.sql": {Data: []byte("up 1")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid file name",
+			files: fstest.MapFS{
+				"migrations/baseline.sql": {Data: []byte("up 1")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "names differ between up and down",
+			files: fstest.MapFS{
+				"migrations/0001_baseline.up.sql": {Data: []byte("up 1")},
+				"migrations/0001_other.down.sql":  {Data: []byte("down 1")},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := loadMigrations(tt.files)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("loadMigrations() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			versions := make([]uint64, 0, len(migrations))
+			for _, migration := range migrations {
+				versions = append(versions, migration.Version)
+			}
+			if !tt.wantErr && !slices.Equal(versions, tt.wantVersions) {
+				t.Errorf("versions %v, want %v", versions, tt.wantVersions)
+			}
+		})
+	}
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrations, err := loadMigrations(migrationFiles)
+	if err != nil {
+		t.Fatalf("loadMigrations() error = %v", err)
+	}
+	for i, migration := range migrations {
+		if migration.Version != uint64(i+1) {
+			t.Errorf("migration %d_%s breaks the version sequence at position %d", migration.Version, migration.Name, i+1)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+-- The baseline tables usually hold data that was there before this migration adopted them,
+-- so they are only dropped when the rollback is confirmed with "migrate down -drop-schema"
+DO $$
+BEGIN
+    IF current_setting('browersfc.allow_schema_drop', true) IS DISTINCT FROM 'on' THEN
+        RAISE EXCEPTION 'rolling back the baseline schema drops every table and its data; run "migrate down -drop-schema" to confirm';
+    END IF;
+END $$;
+
+DROP TABLE IF EXISTS player_stats;
+DROP TABLE IF EXISTS lineups;
+DROP TABLE IF EXISTS team_stats;
+DROP TABLE IF EXISTS player_teams;
+DROP TABLE IF EXISTS matches;
+DROP TABLE IF EXISTS players;
+DROP TABLE IF EXISTS articles;
+DROP TABLE IF EXISTS users;
+DROP TABLE IF EXISTS teams;
+DROP TABLE IF EXISTS seasons;
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,195 @@
+-- Schema created by GORM AutoMigrate before the migrations were versioned. Every statement
+-- only creates what is missing, so databases created by AutoMigrate are adopted as they are.
+
+CREATE TABLE IF NOT EXISTS roles (
+    id          bigserial,
+    name        varchar(20) NOT NULL,
+    description varchar(100),
+    created_at  timestamp,
+    updated_at  timestamp,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_roles_name ON roles (name);
+
+CREATE TABLE IF NOT EXISTS seasons (
+    id         bigserial,
+    year       integer NOT NULL,
+    start_date timestamp NOT NULL,
+    end_date   timestamp NOT NULL,
+    is_current boolean DEFAULT false,
+    created_at timestamp,
+    updated_at timestamp,
+    PRIMARY KEY (id),
+    CONSTRAINT chk_seasons_year CHECK (year >= 1999 AND year <= 2100)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_seasons_year ON seasons (year);
+
+CREATE TABLE IF NOT EXISTS teams (
+    id              bigserial,
+    full_name       varchar(35) NOT NULL,
+    short_name      varchar(5) NOT NULL,
+    primary_color   varchar(10) NOT NULL,
+    secondary_color varchar(10) NOT NULL,
+    shield          varchar(200) NOT NULL,
+    next_match_id   bigint,
+    created_at      timestamp,
+    updated_at      timestamp,
+    PRIMARY KEY (id),
+    CONSTRAINT uni_teams_short_name UNIQUE (short_name)
+);
+CREATE INDEX IF NOT EXISTS idx_teams_next_match_id ON teams (next_match_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_teams_full_name ON teams (full_name);
+
+CREATE TABLE IF NOT EXISTS users (
+    id          char(36),
+    name        varchar(35) NOT NULL,
+    last_name   varchar(35) NOT NULL,
+    username    varchar(50) NOT NULL,
+    birthdate   date,
+    img_profile varchar(255),
+    img_banner  varchar(255),
+    role_id     bigint,
+    created_at  timestamp,
+    updated_at  timestamp,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_username ON users (username);
+
+CREATE TABLE IF NOT EXISTS articles (
+    id         bigserial,
+    title      varchar(100) NOT NULL,
+    content    text NOT NULL,
+    img_banner varchar(255) DEFAULT null,
+    date       date NOT NULL,
+    season_id  bigint NOT NULL,
+    created_at timestamp,
+    updated_at timestamp,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_articles_season_id ON articles (season_id);
+CREATE INDEX IF NOT EXISTS idx_articles_date ON articles (date);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_articles_title ON articles (title);
+
+CREATE TABLE IF NOT EXISTS players (
+    id                bigserial,
+    nick_name         varchar(20),
+    height            smallint NOT NULL,
+    country           varchar(2) NOT NULL,
+    secondary_country varchar(2),
+    foot              varchar(1) NOT NULL,
+    age               smallint NOT NULL,
+    squad_number      smallint NOT NULL,
+    rating            smallint NOT NULL DEFAULT 0,
+    matches           smallint NOT NULL DEFAULT 0,
+    y_cards           smallint NOT NULL DEFAULT 0,
+    r_cards           smallint NOT NULL DEFAULT 0,
+    goals             smallint NOT NULL DEFAULT 0,
+    assists           smallint NOT NULL DEFAULT 0,
+    saves             smallint NOT NULL DEFAULT 0,
+    position          varchar(5) NOT NULL,
+    injured           boolean DEFAULT false,
+    career_summary    varchar(1000) NOT NULL,
+    mvp_count         smallint NOT NULL DEFAULT 0,
+    user_id           char(36),
+    created_at        timestamp,
+    updated_at        timestamp,
+    PRIMARY KEY (id),
+    CONSTRAINT chk_players_age CHECK (age >= 16 AND age <= 50),
+    CONSTRAINT chk_players_height CHECK (height >= 100 AND height <= 250),
+    CONSTRAINT chk_players_rating CHECK (rating <= 100),
+    CONSTRAINT chk_players_squad_number CHECK (squad_number >= 1 AND squad_number <= 99)
+);
+CREATE INDEX IF NOT EXISTS idx_players_user_id ON players (user_id);
+
+CREATE TABLE IF NOT EXISTS matches (
+    id            bigserial,
+    status        varchar(11) NOT NULL,
+    kickoff       timestamp NOT NULL,
+    location      varchar(35) NOT NULL,
+    home_goals    smallint NOT NULL DEFAULT 0,
+    away_goals    smallint NOT NULL DEFAULT 0,
+    home_team_id  bigint NOT NULL,
+    away_team_id  bigint NOT NULL,
+    season_id     bigint NOT NULL,
+    mvp_player_id bigint,
+    created_at    timestamp,
+    updated_at    timestamp,
+    PRIMARY KEY (id),
+    CONSTRAINT chk_matches_status CHECK (status IN ('scheduled','in_progress','completed','postponed','cancelled'))
+);
+CREATE INDEX IF NOT EXISTS idx_matches_mvp_player_id ON matches (mvp_player_id);
+CREATE INDEX IF NOT EXISTS idx_matches_season_id ON matches (season_id);
+CREATE INDEX IF NOT EXISTS idx_matches_away_team_id ON matches (away_team_id);
+CREATE INDEX IF NOT EXISTS idx_matches_home_team_id ON matches (home_team_id);
+
+CREATE TABLE IF NOT EXISTS player_teams (
+    id         bigserial,
+    player_id  bigint NOT NULL,
+    team_id    bigint NOT NULL,
+    season_id  bigint NOT NULL,
+    start_date timestamp NOT NULL,
+    end_date   timestamp,
+    created_at timestamp,
+    updated_at timestamp,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS fk_players_player_teams ON player_teams (player_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_player_team_unique ON player_teams (player_id,team_id,season_id,start_date);
+
+CREATE TABLE IF NOT EXISTS team_stats (
+    id            bigserial,
+    wins          integer NOT NULL DEFAULT 0,
+    draws         integer NOT NULL DEFAULT 0,
+    losses        integer NOT NULL DEFAULT 0,
+    goals_for     integer NOT NULL DEFAULT 0,
+    goals_against integer NOT NULL DEFAULT 0,
+    points        smallint NOT NULL DEFAULT 0,
+    rank          integer NOT NULL DEFAULT 0,
+    season_id     bigint NOT NULL,
+    team_id       bigint NOT NULL,
+    created_at    timestamp,
+    updated_at    timestamp,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_season_team ON team_stats (season_id,team_id);
+
+CREATE TABLE IF NOT EXISTS lineups (
+    id         bigserial,
+    position   varchar(5) NOT NULL,
+    player_id  bigint NOT NULL,
+    match_id   bigint NOT NULL,
+    starting   boolean DEFAULT false,
+    created_at timestamp,
+    updated_at timestamp,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_lineups_match_id ON lineups (match_id);
+CREATE INDEX IF NOT EXISTS idx_lineups_player_id ON lineups (player_id);
+
+CREATE TABLE IF NOT EXISTS player_stats (
+    id             bigserial,
+    player_id      bigint NOT NULL,
+    match_id       bigint NOT NULL,
+    season_id      bigint NOT NULL,
+    team_id        bigint,
+    goals          smallint NOT NULL DEFAULT 0,
+    assists        smallint NOT NULL DEFAULT 0,
+    saves          smallint NOT NULL DEFAULT 0,
+    yellow_cards   smallint NOT NULL DEFAULT 0,
+    red_cards      smallint NOT NULL DEFAULT 0,
+    rating         smallint NOT NULL DEFAULT 0,
+    is_starting    boolean DEFAULT false,
+    minutes_played smallint NOT NULL DEFAULT 0,
+    is_mvp         boolean DEFAULT false,
+    position       varchar(5),
+    created_at     timestamp,
+    updated_at     timestamp,
+    PRIMARY KEY (id),
+    CONSTRAINT chk_player_stats_rating CHECK (rating <= 100)
+);
+CREATE INDEX IF NOT EXISTS idx_player_stats_team_id ON player_stats (team_id);
+CREATE INDEX IF NOT EXISTS idx_player_season ON player_stats (season_id);
+CREATE INDEX IF NOT EXISTS idx_player_stats_season_id ON player_stats (season_id);
+CREATE INDEX IF NOT EXISTS idx_player_stats_match_id ON player_stats (match_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_player_match ON player_stats (player_id,match_id);
+CREATE INDEX IF NOT EXISTS idx_player_stats_player_id ON player_stats (player_id);
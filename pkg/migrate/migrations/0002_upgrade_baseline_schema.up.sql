@@ -0,0 +1,405 @@
+-- Brings a database created by the baseline schema up to date: adds the venues, the
+-- version and soft delete columns, and the tables of the account, report and admin features.
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role_id    bigint,
+    permission varchar(50),
+    created_at timestamp,
+    PRIMARY KEY (role_id,permission)
+);
+
+CREATE TABLE IF NOT EXISTS venues (
+    id         bigserial,
+    name       varchar(100) NOT NULL,
+    address    varchar(200),
+    capacity   bigint NOT NULL DEFAULT 0,
+    surface    varchar(15),
+    latitude   numeric(9,6),
+    longitude  numeric(9,6),
+    version    bigint NOT NULL DEFAULT 1,
+    created_at timestamp,
+    updated_at timestamp,
+    PRIMARY KEY (id),
+    CONSTRAINT chk_venues_surface CHECK (surface IN ('','natural_grass','artificial_turf','hybrid','indoor'))
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_venues_name ON venues (name);
+
+-- Team names and article titles are only unique among the records that are not in the trash
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS uni_teams_short_name;
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS teams_short_name_key;
+DROP INDEX IF EXISTS idx_teams_full_name;
+DROP INDEX IF EXISTS idx_articles_title;
+
+ALTER TABLE roles ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE seasons ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS team_id bigint,
+    ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE players
+    ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS deleted_at timestamp;
+ALTER TABLE matches
+    ADD COLUMN IF NOT EXISTS venue_id bigint,
+    ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS deleted_at timestamp;
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS home_venue_id bigint,
+    ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS deleted_at timestamp;
+ALTER TABLE articles
+    ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS deleted_at timestamp;
+ALTER TABLE player_teams ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE team_stats ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE lineups ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+ALTER TABLE player_stats ADD COLUMN IF NOT EXISTS version bigint NOT NULL DEFAULT 1;
+
+-- Matches reference a venue instead of the free-text location. One venue is created per
+-- location, compared ignoring case and surrounding whitespace, and named after the spelling
+-- used by most matches.
+INSERT INTO venues (name, capacity, created_at, updated_at)
+SELECT DISTINCT ON (LOWER(TRIM(m.location))) TRIM(m.location), 0, NOW(), NOW()
+FROM matches m
+WHERE TRIM(COALESCE(m.location, '')) <> ''
+  AND NOT EXISTS (
+    SELECT 1 FROM venues v WHERE LOWER(TRIM(v.name)) = LOWER(TRIM(m.location))
+  )
+GROUP BY TRIM(m.location), LOWER(TRIM(m.location))
+ORDER BY LOWER(TRIM(m.location)), COUNT(*) DESC, TRIM(m.location);
+
+UPDATE matches m
+SET venue_id = v.id
+FROM venues v
+WHERE m.venue_id IS NULL
+  AND LOWER(TRIM(v.name)) = LOWER(TRIM(m.location));
+
+ALTER TABLE matches DROP COLUMN location;
+
+CREATE INDEX IF NOT EXISTS idx_users_team_id ON users (team_id);
+CREATE INDEX IF NOT EXISTS idx_players_deleted_at ON players (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_matches_deleted_at ON matches (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_matches_venue_id ON matches (venue_id);
+CREATE INDEX IF NOT EXISTS idx_teams_deleted_at ON teams (deleted_at);
+CREATE INDEX IF NOT EXISTS idx_teams_home_venue_id ON teams (home_venue_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_teams_short_name_active ON teams (short_name) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_teams_full_name_active ON teams (full_name) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_articles_deleted_at ON articles (deleted_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_articles_title_active ON articles (title) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS match_reports (
+    id              bigserial,
+    match_id        bigint NOT NULL,
+    submitted_by_id char(36) NOT NULL,
+    status          varchar(8) NOT NULL DEFAULT 'pending',
+    home_goals      smallint NOT NULL DEFAULT 0,
+    away_goals      smallint NOT NULL DEFAULT 0,
+    attendance      bigint NOT NULL DEFAULT 0,
+    incidents       text,
+    review_comments text,
+    reviewed_by_id  char(36),
+    reviewed_at     timestamp,
+    created_at      timestamp,
+    updated_at      timestamp,
+    PRIMARY KEY (id),
+    CONSTRAINT chk_match_reports_status CHECK (status IN ('pending','approved','rejected'))
+);
+CREATE INDEX IF NOT EXISTS idx_match_reports_status ON match_reports (status);
+CREATE INDEX IF NOT EXISTS idx_match_reports_submitted_by_id ON match_reports (submitted_by_id);
+CREATE INDEX IF NOT EXISTS idx_match_reports_match_id ON match_reports (match_id);
+
+CREATE TABLE IF NOT EXISTS match_report_events (
+    id               bigserial,
+    report_id        bigint NOT NULL,
+    type             varchar(11) NOT NULL,
+    player_id        bigint NOT NULL,
+    team_id          bigint NOT NULL,
+    assist_player_id bigint,
+    minute           smallint NOT NULL DEFAULT 0,
+    PRIMARY KEY (id),
+    CONSTRAINT chk_match_report_events_type CHECK (type IN ('goal','own_goal','yellow_card','red_card'))
+);
+CREATE INDEX IF NOT EXISTS idx_match_report_events_player_id ON match_report_events (player_id);
+CREATE INDEX IF NOT EXISTS idx_match_report_events_report_id ON match_report_events (report_id);
+
+CREATE TABLE IF NOT EXISTS predictions (
+    id         bigserial,
+    user_id    char(36) NOT NULL,
+    match_id   bigint NOT NULL,
+    home_goals smallint NOT NULL DEFAULT 0,
+    away_goals smallint NOT NULL DEFAULT 0,
+    points     smallint,
+    exact      boolean NOT NULL DEFAULT false,
+    scored_at  timestamp,
+    created_at timestamp,
+    updated_at timestamp,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_predictions_match_id ON predictions (match_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_prediction_user_match ON predictions (user_id,match_id);
+
+CREATE TABLE IF NOT EXISTS mvp_polls (
+    id               bigserial,
+    match_id         bigint NOT NULL,
+    opens_at         timestamp NOT NULL,
+    closes_at        timestamp NOT NULL,
+    closed_at        timestamp,
+    winner_player_id bigint,
+    created_at       timestamp,
+    updated_at       timestamp,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_mvp_polls_closes_at ON mvp_polls (closes_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_mvp_polls_match_id ON mvp_polls (match_id);
+
+CREATE TABLE IF NOT EXISTS mvp_votes (
+    id         bigserial,
+    match_id   bigint NOT NULL,
+    user_id    char(36) NOT NULL,
+    player_id  bigint NOT NULL,
+    created_at timestamp,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_mvp_votes_player_id ON mvp_votes (player_id);
+CREATE INDEX IF NOT EXISTS idx_mvp_votes_user_id ON mvp_votes (user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_mvp_vote_match_user ON mvp_votes (match_id,user_id);
+
+CREATE TABLE IF NOT EXISTS sessions (
+    id           char(36),
+    user_id      char(36) NOT NULL,
+    user_agent   varchar(255),
+    ip_address   varchar(45),
+    last_seen_at timestamp NOT NULL,
+    expires_at   timestamp NOT NULL,
+    revoked_at   timestamp,
+    created_at   timestamp,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_sessions_expires_at ON sessions (expires_at);
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions (user_id);
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id         bigserial,
+    user_id    char(36) NOT NULL,
+    family_id  char(36) NOT NULL,
+    token_hash char(64) NOT NULL,
+    expires_at timestamp NOT NULL,
+    used_at    timestamp,
+    revoked_at timestamp,
+    created_at timestamp,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_expires_at ON refresh_tokens (expires_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_refresh_tokens_token_hash ON refresh_tokens (token_hash);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    token_id   char(36),
+    user_id    char(36) NOT NULL,
+    expires_at timestamp NOT NULL,
+    revoked_at timestamp NOT NULL,
+    PRIMARY KEY (token_id)
+);
+CREATE INDEX IF NOT EXISTS idx_revoked_tokens_expires_at ON revoked_tokens (expires_at);
+CREATE INDEX IF NOT EXISTS idx_revoked_tokens_user_id ON revoked_tokens (user_id);
+
+CREATE TABLE IF NOT EXISTS user_token_revocations (
+    user_id        char(36),
+    revoked_before timestamp NOT NULL,
+    PRIMARY KEY (user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_user_token_revocations_revoked_before ON user_token_revocations (revoked_before);
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    id           char(36),
+    user_id      char(36) NOT NULL,
+    name         varchar(100) NOT NULL,
+    prefix       varchar(16) NOT NULL,
+    key_hash     char(64) NOT NULL,
+    expires_at   timestamp,
+    last_used_at timestamp,
+    revoked_at   timestamp,
+    created_at   timestamp,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_prefix ON api_keys (prefix);
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys (user_id);
+
+CREATE TABLE IF NOT EXISTS api_key_scopes (
+    api_key_id char(36),
+    permission varchar(50),
+    PRIMARY KEY (api_key_id,permission)
+);
+
+CREATE TABLE IF NOT EXISTS user_identities (
+    id            bigserial,
+    user_id       char(36) NOT NULL,
+    provider      varchar(30) NOT NULL,
+    subject       varchar(255) NOT NULL,
+    email         varchar(100),
+    last_login_at timestamp NOT NULL,
+    created_at    timestamp,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_identities_provider_subject ON user_identities (provider,subject);
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities (user_id);
+
+CREATE TABLE IF NOT EXISTS user_profile_changes (
+    id         bigserial,
+    user_id    char(36) NOT NULL,
+    changed_by char(36) NOT NULL,
+    field      varchar(30) NOT NULL,
+    old_value  varchar(255),
+    new_value  varchar(255),
+    ip_address varchar(45),
+    created_at timestamp NOT NULL,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_user_profile_changes_user_created ON user_profile_changes (user_id,created_at);
+
+CREATE TABLE IF NOT EXISTS account_deletions (
+    user_id      char(36),
+    requested_at timestamp NOT NULL,
+    scheduled_at timestamp NOT NULL,
+    PRIMARY KEY (user_id)
+);
+CREATE INDEX IF NOT EXISTS idx_account_deletions_scheduled_at ON account_deletions (scheduled_at);
+
+CREATE TABLE IF NOT EXISTS player_claims (
+    id              bigserial,
+    user_id         char(36) NOT NULL,
+    player_id       bigint NOT NULL,
+    status          varchar(8) NOT NULL DEFAULT 'pending',
+    message         varchar(500),
+    review_comments text,
+    reviewed_by_id  char(36),
+    reviewed_at     timestamp,
+    created_at      timestamp,
+    updated_at      timestamp,
+    PRIMARY KEY (id),
+    CONSTRAINT chk_player_claims_status CHECK (status IN ('pending','approved','rejected'))
+);
+CREATE INDEX IF NOT EXISTS idx_player_claims_status ON player_claims (status);
+CREATE INDEX IF NOT EXISTS idx_player_claims_player_id ON player_claims (player_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_player_claims_pending_user ON player_claims (user_id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_player_claims_user_id ON player_claims (user_id);
+
+CREATE TABLE IF NOT EXISTS invitations (
+    id             bigserial,
+    email          varchar(100) NOT NULL,
+    role_id        bigint NOT NULL,
+    team_id        bigint,
+    token_hash     char(64) NOT NULL,
+    invited_by_id  char(36) NOT NULL,
+    expires_at     timestamp NOT NULL,
+    accepted_at    timestamp,
+    accepted_by_id char(36),
+    revoked_at     timestamp,
+    created_at     timestamp,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_invitations_expires_at ON invitations (expires_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_invitations_token_hash ON invitations (token_hash);
+CREATE INDEX IF NOT EXISTS idx_invitations_email ON invitations (email);
+
+CREATE TABLE IF NOT EXISTS password_credentials (
+    user_id       char(36),
+    password_hash varchar(255) NOT NULL,
+    created_at    timestamp,
+    updated_at    timestamp,
+    PRIMARY KEY (user_id)
+);
+
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+    id         bigserial,
+    user_id    char(36) NOT NULL,
+    token_hash char(64) NOT NULL,
+    expires_at timestamp NOT NULL,
+    used_at    timestamp,
+    created_at timestamp,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_expires_at ON password_reset_tokens (expires_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_password_reset_tokens_token_hash ON password_reset_tokens (token_hash);
+CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_user_id ON password_reset_tokens (user_id);
+
+CREATE TABLE IF NOT EXISTS login_attempts (
+    id         bigserial,
+    ip_address varchar(45) NOT NULL,
+    username   varchar(100),
+    succeeded  boolean NOT NULL,
+    created_at timestamp NOT NULL,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_login_attempts_ip_created ON login_attempts (ip_address,created_at);
+
+CREATE TABLE IF NOT EXISTS o_auth_states (
+    state         varchar(64),
+    verifier      varchar(128) NOT NULL,
+    provider      varchar(50) NOT NULL,
+    nonce         varchar(64) NOT NULL,
+    invitation_id bigint NOT NULL DEFAULT 0,
+    expires_at    timestamp NOT NULL,
+    created_at    timestamp,
+    PRIMARY KEY (state)
+);
+CREATE INDEX IF NOT EXISTS idx_o_auth_states_expires_at ON o_auth_states (expires_at);
+
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    bucket_key  varchar(191),
+    tokens      double precision NOT NULL,
+    refilled_at timestamp NOT NULL,
+    PRIMARY KEY (bucket_key)
+);
+CREATE INDEX IF NOT EXISTS idx_rate_limit_buckets_refilled_at ON rate_limit_buckets (refilled_at);
+
+CREATE TABLE IF NOT EXISTS idempotent_requests (
+    request_key  varchar(64),
+    fingerprint  varchar(64) NOT NULL,
+    status_code  bigint NOT NULL DEFAULT 0,
+    content_type varchar(100) NOT NULL DEFAULT '',
+    body         bytea,
+    expires_at   timestamp NOT NULL,
+    created_at   timestamp,
+    PRIMARY KEY (request_key)
+);
+CREATE INDEX IF NOT EXISTS idx_idempotent_requests_expires_at ON idempotent_requests (expires_at);
+
+CREATE TABLE IF NOT EXISTS audit_entries (
+    id          bigserial,
+    actor_id    char(36),
+    api_key_id  char(36),
+    action      varchar(7) NOT NULL,
+    entity_type varchar(20) NOT NULL,
+    entity_id   varchar(64) NOT NULL,
+    before      jsonb,
+    after       jsonb,
+    changes     jsonb NOT NULL,
+    request_id  varchar(64),
+    ip_address  varchar(45),
+    created_at  timestamp,
+    PRIMARY KEY (id),
+    CONSTRAINT chk_audit_entries_action CHECK (action IN ('create','update','delete','restore'))
+);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_created_at ON audit_entries (created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_entity ON audit_entries (entity_type,entity_id);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_actor_id ON audit_entries (actor_id);
+
+-- The allowlist starts with the email domains that were accepted before it could be edited
+CREATE TABLE IF NOT EXISTS email_domain_rules (
+    id            bigserial,
+    domain        varchar(253) NOT NULL,
+    kind          varchar(5) NOT NULL,
+    created_by_id char(36),
+    created_at    timestamp,
+    PRIMARY KEY (id),
+    CONSTRAINT chk_email_domain_rules_kind CHECK (kind IN ('allow','block'))
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_email_domain_rules_domain ON email_domain_rules (domain);
+
+INSERT INTO email_domain_rules (domain, kind, created_at)
+VALUES ('gmail.com', 'allow', NOW()),
+       ('hotmail.com', 'allow', NOW()),
+       ('outlook.com', 'allow', NOW()),
+       ('yahoo.com', 'allow', NOW())
+ON CONFLICT (domain) DO NOTHING;
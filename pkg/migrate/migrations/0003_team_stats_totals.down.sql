@@ -0,0 +1,3 @@
+ALTER TABLE team_stats
+    DROP COLUMN IF EXISTS goal_difference,
+    DROP COLUMN IF EXISTS matches_played;
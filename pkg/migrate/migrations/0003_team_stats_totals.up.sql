@@ -0,0 +1,4 @@
+-- Sortable totals of the standings, kept up to date by the database
+ALTER TABLE team_stats
+    ADD COLUMN IF NOT EXISTS matches_played integer GENERATED ALWAYS AS (wins + draws + losses) STORED,
+    ADD COLUMN IF NOT EXISTS goal_difference integer GENERATED ALWAYS AS (goals_for - goals_against) STORED;
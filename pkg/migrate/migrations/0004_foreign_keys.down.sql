@@ -0,0 +1,85 @@
+ALTER TABLE audit_entries
+    DROP CONSTRAINT IF EXISTS fk_audit_entries_actor;
+ALTER TABLE invitations
+    DROP CONSTRAINT IF EXISTS fk_invitations_role,
+    DROP CONSTRAINT IF EXISTS fk_invitations_team,
+    DROP CONSTRAINT IF EXISTS fk_invitations_invited_by,
+    DROP CONSTRAINT IF EXISTS fk_invitations_accepted_by;
+ALTER TABLE player_claims
+    DROP CONSTRAINT IF EXISTS fk_player_claims_user,
+    DROP CONSTRAINT IF EXISTS fk_player_claims_player,
+    DROP CONSTRAINT IF EXISTS fk_player_claims_reviewed_by;
+ALTER TABLE password_reset_tokens
+    DROP CONSTRAINT IF EXISTS fk_password_reset_tokens_user;
+ALTER TABLE password_credentials
+    DROP CONSTRAINT IF EXISTS fk_password_credentials_user;
+ALTER TABLE account_deletions
+    DROP CONSTRAINT IF EXISTS fk_account_deletions_user;
+ALTER TABLE user_profile_changes
+    DROP CONSTRAINT IF EXISTS fk_user_profile_changes_user;
+ALTER TABLE user_identities
+    DROP CONSTRAINT IF EXISTS fk_user_identities_user;
+ALTER TABLE api_key_scopes
+    DROP CONSTRAINT IF EXISTS fk_api_key_scopes_api_key;
+ALTER TABLE api_keys
+    DROP CONSTRAINT IF EXISTS fk_api_keys_user;
+ALTER TABLE user_token_revocations
+    DROP CONSTRAINT IF EXISTS fk_user_token_revocations_user;
+ALTER TABLE revoked_tokens
+    DROP CONSTRAINT IF EXISTS fk_revoked_tokens_user;
+ALTER TABLE refresh_tokens
+    DROP CONSTRAINT IF EXISTS fk_refresh_tokens_user;
+ALTER TABLE sessions
+    DROP CONSTRAINT IF EXISTS fk_sessions_user;
+ALTER TABLE mvp_votes
+    DROP CONSTRAINT IF EXISTS fk_mvp_votes_match,
+    DROP CONSTRAINT IF EXISTS fk_mvp_votes_user,
+    DROP CONSTRAINT IF EXISTS fk_mvp_votes_player;
+ALTER TABLE mvp_polls
+    DROP CONSTRAINT IF EXISTS fk_mvp_polls_match,
+    DROP CONSTRAINT IF EXISTS fk_mvp_polls_winner_player;
+ALTER TABLE predictions
+    DROP CONSTRAINT IF EXISTS fk_predictions_user,
+    DROP CONSTRAINT IF EXISTS fk_predictions_match;
+ALTER TABLE match_report_events
+    DROP CONSTRAINT IF EXISTS fk_match_report_events_report,
+    DROP CONSTRAINT IF EXISTS fk_match_report_events_player,
+    DROP CONSTRAINT IF EXISTS fk_match_report_events_assist_player,
+    DROP CONSTRAINT IF EXISTS fk_match_report_events_team;
+ALTER TABLE match_reports
+    DROP CONSTRAINT IF EXISTS fk_match_reports_match,
+    DROP CONSTRAINT IF EXISTS fk_match_reports_submitted_by,
+    DROP CONSTRAINT IF EXISTS fk_match_reports_reviewed_by;
+ALTER TABLE player_stats
+    DROP CONSTRAINT IF EXISTS fk_player_stats_player,
+    DROP CONSTRAINT IF EXISTS fk_player_stats_match,
+    DROP CONSTRAINT IF EXISTS fk_player_stats_season,
+    DROP CONSTRAINT IF EXISTS fk_player_stats_team;
+ALTER TABLE lineups
+    DROP CONSTRAINT IF EXISTS fk_lineups_player,
+    DROP CONSTRAINT IF EXISTS fk_lineups_match;
+ALTER TABLE team_stats
+    DROP CONSTRAINT IF EXISTS fk_team_stats_team,
+    DROP CONSTRAINT IF EXISTS fk_team_stats_season;
+ALTER TABLE player_teams
+    DROP CONSTRAINT IF EXISTS fk_player_teams_player,
+    DROP CONSTRAINT IF EXISTS fk_player_teams_team,
+    DROP CONSTRAINT IF EXISTS fk_player_teams_season;
+ALTER TABLE articles
+    DROP CONSTRAINT IF EXISTS fk_articles_season;
+ALTER TABLE matches
+    DROP CONSTRAINT IF EXISTS fk_matches_venue,
+    DROP CONSTRAINT IF EXISTS fk_matches_home_team,
+    DROP CONSTRAINT IF EXISTS fk_matches_away_team,
+    DROP CONSTRAINT IF EXISTS fk_matches_season,
+    DROP CONSTRAINT IF EXISTS fk_matches_mvp_player;
+ALTER TABLE teams
+    DROP CONSTRAINT IF EXISTS fk_teams_next_match,
+    DROP CONSTRAINT IF EXISTS fk_teams_home_venue;
+ALTER TABLE players
+    DROP CONSTRAINT IF EXISTS fk_players_user;
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS fk_users_role,
+    DROP CONSTRAINT IF EXISTS fk_users_team;
+ALTER TABLE role_permissions
+    DROP CONSTRAINT IF EXISTS fk_role_permissions_role;
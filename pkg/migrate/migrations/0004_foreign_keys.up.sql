@@ -0,0 +1,190 @@
+-- Enforces the relationships between the tables with foreign keys, using the ON DELETE rules
+-- declared by the persistence models. The migration fails, listing the references to missing
+-- records, if any row already points to one. Those rows are not changed here: fix them, or
+-- review and run scripts/cleanup_orphaned_rows.sql, then apply the migration again.
+
+DO $$
+DECLARE
+    ref record;
+    orphans bigint;
+    report text := '';
+BEGIN
+    FOR ref IN SELECT * FROM (VALUES
+        ('role_permissions', 'role_id', 'roles'),
+        ('users', 'role_id', 'roles'),
+        ('users', 'team_id', 'teams'),
+        ('players', 'user_id', 'users'),
+        ('teams', 'next_match_id', 'matches'),
+        ('teams', 'home_venue_id', 'venues'),
+        ('matches', 'venue_id', 'venues'),
+        ('matches', 'home_team_id', 'teams'),
+        ('matches', 'away_team_id', 'teams'),
+        ('matches', 'season_id', 'seasons'),
+        ('matches', 'mvp_player_id', 'players'),
+        ('articles', 'season_id', 'seasons'),
+        ('player_teams', 'player_id', 'players'),
+        ('player_teams', 'team_id', 'teams'),
+        ('player_teams', 'season_id', 'seasons'),
+        ('team_stats', 'team_id', 'teams'),
+        ('team_stats', 'season_id', 'seasons'),
+        ('lineups', 'player_id', 'players'),
+        ('lineups', 'match_id', 'matches'),
+        ('player_stats', 'player_id', 'players'),
+        ('player_stats', 'match_id', 'matches'),
+        ('player_stats', 'season_id', 'seasons'),
+        ('player_stats', 'team_id', 'teams'),
+        ('match_reports', 'match_id', 'matches'),
+        ('match_reports', 'submitted_by_id', 'users'),
+        ('match_reports', 'reviewed_by_id', 'users'),
+        ('match_report_events', 'report_id', 'match_reports'),
+        ('match_report_events', 'player_id', 'players'),
+        ('match_report_events', 'assist_player_id', 'players'),
+        ('match_report_events', 'team_id', 'teams'),
+        ('predictions', 'user_id', 'users'),
+        ('predictions', 'match_id', 'matches'),
+        ('mvp_polls', 'match_id', 'matches'),
+        ('mvp_polls', 'winner_player_id', 'players'),
+        ('mvp_votes', 'match_id', 'matches'),
+        ('mvp_votes', 'user_id', 'users'),
+        ('mvp_votes', 'player_id', 'players'),
+        ('sessions', 'user_id', 'users'),
+        ('refresh_tokens', 'user_id', 'users'),
+        ('revoked_tokens', 'user_id', 'users'),
+        ('user_token_revocations', 'user_id', 'users'),
+        ('api_keys', 'user_id', 'users'),
+        ('api_key_scopes', 'api_key_id', 'api_keys'),
+        ('user_identities', 'user_id', 'users'),
+        ('user_profile_changes', 'user_id', 'users'),
+        ('account_deletions', 'user_id', 'users'),
+        ('password_credentials', 'user_id', 'users'),
+        ('password_reset_tokens', 'user_id', 'users'),
+        ('player_claims', 'user_id', 'users'),
+        ('player_claims', 'player_id', 'players'),
+        ('player_claims', 'reviewed_by_id', 'users'),
+        ('invitations', 'role_id', 'roles'),
+        ('invitations', 'team_id', 'teams'),
+        ('invitations', 'invited_by_id', 'users'),
+        ('invitations', 'accepted_by_id', 'users'),
+        ('audit_entries', 'actor_id', 'users')
+    ) AS refs (child_table, child_column, parent_table)
+    LOOP
+        EXECUTE format(
+            'SELECT count(*) FROM %I WHERE %I IS NOT NULL AND NOT EXISTS (SELECT 1 FROM %I WHERE %I.id = %I.%I)',
+            ref.child_table, ref.child_column, ref.parent_table, ref.parent_table, ref.child_table, ref.child_column
+        ) INTO orphans;
+        IF orphans > 0 THEN
+            report := report || format(E'\n  %s.%s: %s rows refer to a missing %s row', ref.child_table, ref.child_column, orphans, ref.parent_table);
+        END IF;
+    END LOOP;
+
+    IF report <> '' THEN
+        RAISE EXCEPTION 'cannot add foreign keys, rows refer to missing records:%', report
+            USING HINT = 'Fix these rows, or review and run scripts/cleanup_orphaned_rows.sql, then migrate again.';
+    END IF;
+END
+$$;
+
+ALTER TABLE role_permissions
+    ADD CONSTRAINT fk_role_permissions_role FOREIGN KEY (role_id) REFERENCES roles (id) ON DELETE CASCADE;
+
+ALTER TABLE users
+    ADD CONSTRAINT fk_users_role FOREIGN KEY (role_id) REFERENCES roles (id) ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_users_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE SET NULL;
+
+ALTER TABLE players
+    ADD CONSTRAINT fk_players_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET NULL;
+
+ALTER TABLE teams
+    ADD CONSTRAINT fk_teams_next_match FOREIGN KEY (next_match_id) REFERENCES matches (id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_teams_home_venue FOREIGN KEY (home_venue_id) REFERENCES venues (id) ON DELETE SET NULL;
+
+ALTER TABLE matches
+    ADD CONSTRAINT fk_matches_venue FOREIGN KEY (venue_id) REFERENCES venues (id) ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_matches_home_team FOREIGN KEY (home_team_id) REFERENCES teams (id) ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_matches_away_team FOREIGN KEY (away_team_id) REFERENCES teams (id) ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_matches_season FOREIGN KEY (season_id) REFERENCES seasons (id) ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_matches_mvp_player FOREIGN KEY (mvp_player_id) REFERENCES players (id) ON DELETE SET NULL;
+
+ALTER TABLE articles
+    ADD CONSTRAINT fk_articles_season FOREIGN KEY (season_id) REFERENCES seasons (id) ON DELETE RESTRICT;
+
+ALTER TABLE player_teams
+    ADD CONSTRAINT fk_player_teams_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_player_teams_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_player_teams_season FOREIGN KEY (season_id) REFERENCES seasons (id) ON DELETE RESTRICT;
+
+ALTER TABLE team_stats
+    ADD CONSTRAINT fk_team_stats_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_team_stats_season FOREIGN KEY (season_id) REFERENCES seasons (id) ON DELETE RESTRICT;
+
+-- Lineups and statistics belong to their match and are deleted with it
+ALTER TABLE lineups
+    ADD CONSTRAINT fk_lineups_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_lineups_match FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE;
+
+ALTER TABLE player_stats
+    ADD CONSTRAINT fk_player_stats_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_player_stats_match FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_player_stats_season FOREIGN KEY (season_id) REFERENCES seasons (id) ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_player_stats_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE SET NULL;
+
+ALTER TABLE match_reports
+    ADD CONSTRAINT fk_match_reports_match FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_match_reports_submitted_by FOREIGN KEY (submitted_by_id) REFERENCES users (id) ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_match_reports_reviewed_by FOREIGN KEY (reviewed_by_id) REFERENCES users (id) ON DELETE SET NULL;
+
+ALTER TABLE match_report_events
+    ADD CONSTRAINT fk_match_report_events_report FOREIGN KEY (report_id) REFERENCES match_reports (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_match_report_events_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_match_report_events_assist_player FOREIGN KEY (assist_player_id) REFERENCES players (id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_match_report_events_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE RESTRICT;
+
+ALTER TABLE predictions
+    ADD CONSTRAINT fk_predictions_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_predictions_match FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE;
+
+ALTER TABLE mvp_polls
+    ADD CONSTRAINT fk_mvp_polls_match FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_mvp_polls_winner_player FOREIGN KEY (winner_player_id) REFERENCES players (id) ON DELETE SET NULL;
+
+ALTER TABLE mvp_votes
+    ADD CONSTRAINT fk_mvp_votes_match FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_mvp_votes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_mvp_votes_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE CASCADE;
+
+ALTER TABLE sessions
+    ADD CONSTRAINT fk_sessions_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE refresh_tokens
+    ADD CONSTRAINT fk_refresh_tokens_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE revoked_tokens
+    ADD CONSTRAINT fk_revoked_tokens_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE user_token_revocations
+    ADD CONSTRAINT fk_user_token_revocations_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE api_keys
+    ADD CONSTRAINT fk_api_keys_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE api_key_scopes
+    ADD CONSTRAINT fk_api_key_scopes_api_key FOREIGN KEY (api_key_id) REFERENCES api_keys (id) ON DELETE CASCADE;
+ALTER TABLE user_identities
+    ADD CONSTRAINT fk_user_identities_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE user_profile_changes
+    ADD CONSTRAINT fk_user_profile_changes_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE account_deletions
+    ADD CONSTRAINT fk_account_deletions_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE password_credentials
+    ADD CONSTRAINT fk_password_credentials_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE password_reset_tokens
+    ADD CONSTRAINT fk_password_reset_tokens_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+
+ALTER TABLE player_claims
+    ADD CONSTRAINT fk_player_claims_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_player_claims_player FOREIGN KEY (player_id) REFERENCES players (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_player_claims_reviewed_by FOREIGN KEY (reviewed_by_id) REFERENCES users (id) ON DELETE SET NULL;
+
+ALTER TABLE invitations
+    ADD CONSTRAINT fk_invitations_role FOREIGN KEY (role_id) REFERENCES roles (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_invitations_team FOREIGN KEY (team_id) REFERENCES teams (id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_invitations_invited_by FOREIGN KEY (invited_by_id) REFERENCES users (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_invitations_accepted_by FOREIGN KEY (accepted_by_id) REFERENCES users (id) ON DELETE SET NULL;
+
+ALTER TABLE audit_entries
+    ADD CONSTRAINT fk_audit_entries_actor FOREIGN KEY (actor_id) REFERENCES users (id) ON DELETE SET NULL;
@@ -0,0 +1,4 @@
+ALTER TABLE idempotent_requests
+    DROP COLUMN IF EXISTS body_withheld,
+    DROP COLUMN IF EXISTS etag,
+    DROP COLUMN IF EXISTS location;
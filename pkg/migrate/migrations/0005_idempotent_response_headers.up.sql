@@ -0,0 +1,9 @@
+ALTER TABLE idempotent_requests
+    ADD COLUMN IF NOT EXISTS location varchar(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS etag varchar(100) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS body_withheld boolean NOT NULL DEFAULT false;
+
+-- Responses stored so far may hold API keys or password reset tokens
+UPDATE idempotent_requests
+SET body = NULL, body_withheld = true
+WHERE status_code <> 0;
@@ -0,0 +1 @@
+-- The granted permissions are kept, since admins may have relied on or changed them since.
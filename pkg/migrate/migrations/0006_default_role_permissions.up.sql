@@ -0,0 +1,16 @@
+-- Grants the default permissions to the built-in roles that have none yet. This runs once, so
+-- a role whose permissions an admin removes afterwards keeps having none.
+
+INSERT INTO role_permissions (role_id, permission, created_at)
+SELECT roles.id, defaults.permission, now()
+FROM roles
+JOIN (VALUES
+    ('coach', 'lineup:write'),
+    ('coach', 'player_stats:write'),
+    ('coach', 'player:availability'),
+    ('coach', 'match_report:submit'),
+    ('referee', 'match_report:submit'),
+    ('fan', 'prediction:write'),
+    ('fan', 'prediction:read')
+) AS defaults (role_name, permission) ON defaults.role_name = roles.name
+WHERE NOT EXISTS (SELECT 1 FROM role_permissions WHERE role_permissions.role_id = roles.id);
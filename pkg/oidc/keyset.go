@@ -0,0 +1,157 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// minKeyRefreshInterval limits how often the keys are fetched again when a token names an unknown key,
+// so tokens with made-up key IDs cannot be used to flood the provider.
+const minKeyRefreshInterval = time.Minute
+
+var errUnknownKey = errors.New("unknown signing key")
+
+// remoteKeySet caches the public keys published at the provider's jwks_uri.
+// Keys are fetched again when a token is signed with a key that is not cached,
+// which is how provider key rotation is picked up.
+type remoteKeySet struct {
+	uri       string
+	client    *http.Client
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// jsonWebKey is a public key in the JWK format (RFC 7517).
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	Curve   string `json:"crv"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+	X       string `json:"x"`
+	Y       string `json:"y"`
+}
+
+func newRemoteKeySet(client *http.Client, uri string) *remoteKeySet {
+	return &remoteKeySet{uri: uri, client: client}
+}
+
+// lookup returns the key with the given ID. Tokens without a key ID are accepted when the
+// provider publishes a single key.
+func (s *remoteKeySet) lookup(ctx context.Context, keyID string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key, ok := s.find(keyID); ok {
+		return key, nil
+	}
+
+	if s.keys != nil && time.Since(s.fetchedAt) < minKeyRefreshInterval {
+		return nil, errUnknownKey
+	}
+	if err := s.refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	if key, ok := s.find(keyID); ok {
+		return key, nil
+	}
+	return nil, errUnknownKey
+}
+
+// find looks the key up in the cache. The caller must hold the lock.
+func (s *remoteKeySet) find(keyID string) (crypto.PublicKey, bool) {
+	if keyID == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key, true
+		}
+	}
+	key, ok := s.keys[keyID]
+	return key, ok
+}
+
+// refresh replaces the cached keys with the ones currently published. The caller must hold the lock.
+func (s *remoteKeySet) refresh(ctx context.Context) error {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := getJSON(ctx, s.client, s.uri, &set); err != nil {
+		return fmt.Errorf("error fetching provider keys: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		// Keys of unsupported types are skipped rather than failing the whole set
+		if key, err := jwk.publicKey(); err == nil {
+			keys[jwk.KeyID] = key
+		}
+	}
+
+	s.keys = keys
+	s.fetchedAt = time.Now()
+	return nil
+}
+
+// publicKey decodes the key material of the JWK.
+func (k *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if k.Curve != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Curve)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+	case "OKP":
+		if k.Curve != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid Ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.KeyType)
+}
+
+// decodeBigInt decodes a base64url encoded unsigned big-endian integer.
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return nil, errors.New("invalid key parameter")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,220 @@
+package oidc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+// wellKnownPath is appended to an issuer URL to find its discovery document.
+const wellKnownPath = "/.well-known/openid-configuration"
+
+// googleIssuer is the issuer of Google, which also signs tokens with the scheme-less issuer.
+const googleIssuer = "https://accounts.google.com"
+
+// clockSkew is the leeway allowed when validating the time claims of an ID token.
+const clockSkew = time.Minute
+
+// supportedAlgorithms are the ID token signing algorithms accepted from a provider.
+var supportedAlgorithms = []string{"RS256", "ES256", "EdDSA"}
+
+var (
+	ErrUnknownProvider = errors.New("unknown OAuth provider")
+	ErrMissingIDToken  = errors.New("token response does not contain an ID token")
+	ErrInvalidIDToken  = errors.New("invalid ID token")
+)
+
+// Config describes an OpenID Connect provider users can sign in with.
+type Config struct {
+	Name         string
+	DiscoveryURL string // Issuer URL or the full URL of its discovery document
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// Provider is an OpenID Connect provider whose endpoints and keys were discovered.
+type Provider struct {
+	Name       string
+	oauth2     *oauth2.Config
+	issuers    []string
+	algorithms []string
+	keys       *remoteKeySet
+	client     *http.Client
+}
+
+// IDTokenClaims are the claims of an ID token used to identify the user.
+type IDTokenClaims struct {
+	jwt.RegisteredClaims
+	AuthorizedParty string       `json:"azp,omitempty"`
+	Nonce           string       `json:"nonce,omitempty"`
+	Email           string       `json:"email,omitempty"`
+	EmailVerified   flexibleBool `json:"email_verified,omitempty"`
+	Name            string       `json:"name,omitempty"`
+	GivenName       string       `json:"given_name,omitempty"`
+	FamilyName      string       `json:"family_name,omitempty"`
+	Picture         string       `json:"picture,omitempty"`
+}
+
+// discoveryDocument holds the fields of the provider metadata (OpenID Connect Discovery 1.0) in use.
+type discoveryDocument struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	JWKSURI               string   `json:"jwks_uri"`
+	SigningAlgorithms     []string `json:"id_token_signing_alg_values_supported"`
+}
+
+// Discover fetches the discovery document of the provider and builds the provider from it.
+// The issuer announced by the document must match the configured URL.
+func Discover(ctx context.Context, client *http.Client, cfg Config) (*Provider, error) {
+	issuerURL := strings.TrimSuffix(strings.TrimSuffix(cfg.DiscoveryURL, wellKnownPath), "/")
+
+	var doc discoveryDocument
+	if err := getJSON(ctx, client, issuerURL+wellKnownPath, &doc); err != nil {
+		return nil, fmt.Errorf("error fetching discovery document of %s: %w", cfg.Name, err)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, fmt.Errorf("discovery document of %s is missing required endpoints", cfg.Name)
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != issuerURL {
+		return nil, fmt.Errorf("issuer %q of %s does not match %q", doc.Issuer, cfg.Name, issuerURL)
+	}
+
+	issuers := []string{doc.Issuer}
+	if doc.Issuer == googleIssuer {
+		issuers = append(issuers, strings.TrimPrefix(googleIssuer, "https://"))
+	}
+
+	// Providers that do not announce their algorithms sign with RS256 as required by the spec
+	algorithms := []string{"RS256"}
+	if len(doc.SigningAlgorithms) > 0 {
+		algorithms = slices.DeleteFunc(slices.Clone(doc.SigningAlgorithms), func(alg string) bool {
+			return !slices.Contains(supportedAlgorithms, alg)
+		})
+		if len(algorithms) == 0 {
+			return nil, fmt.Errorf("%s does not support any of the algorithms %v", cfg.Name, supportedAlgorithms)
+		}
+	}
+
+	return &Provider{
+		Name: cfg.Name,
+		oauth2: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			RedirectURL:  cfg.RedirectURL,
+			Scopes:       cfg.Scopes,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:  doc.AuthorizationEndpoint,
+				TokenURL: doc.TokenEndpoint,
+			},
+		},
+		issuers:    issuers,
+		algorithms: algorithms,
+		keys:       newRemoteKeySet(client, doc.JWKSURI),
+		client:     client,
+	}, nil
+}
+
+// AuthCodeURL returns the URL of the provider's consent page for the authorization code flow with PKCE.
+func (p *Provider) AuthCodeURL(state, nonce, codeChallenge string) string {
+	return p.oauth2.AuthCodeURL(state,
+		oauth2.SetAuthURLParam("nonce", nonce),
+		oauth2.SetAuthURLParam("code_challenge", codeChallenge),
+		oauth2.SetAuthURLParam("code_challenge_method", "S256"),
+	)
+}
+
+// Exchange redeems the authorization code and returns the raw ID token of the response.
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier string) (string, error) {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.client)
+
+	token, err := p.oauth2.Exchange(ctx, code, oauth2.SetAuthURLParam("code_verifier", codeVerifier))
+	if err != nil {
+		return "", err
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return "", ErrMissingIDToken
+	}
+	return rawIDToken, nil
+}
+
+// VerifyIDToken checks the signature, issuer, audience, expiry and nonce of an ID token and returns its claims.
+func (p *Provider) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (*IDTokenClaims, error) {
+	claims := &IDTokenClaims{}
+	_, err := jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (any, error) {
+		keyID, _ := token.Header["kid"].(string)
+		return p.keys.lookup(ctx, keyID)
+	},
+		jwt.WithValidMethods(p.algorithms),
+		jwt.WithAudience(p.oauth2.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(clockSkew),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+
+	if !slices.Contains(p.issuers, claims.Issuer) {
+		return nil, fmt.Errorf("%w: unexpected issuer %q", ErrInvalidIDToken, claims.Issuer)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing subject", ErrInvalidIDToken)
+	}
+	// A token issued to several audiences must name this client as the authorized party
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.oauth2.ClientID {
+		return nil, fmt.Errorf("%w: unexpected authorized party", ErrInvalidIDToken)
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+
+	return claims, nil
+}
+
+// getJSON decodes the JSON document served at url.
+func getJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New("unexpected status " + resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// flexibleBool decodes booleans that some providers send as strings, such as email_verified.
+type flexibleBool bool
+
+func (b *flexibleBool) UnmarshalJSON(data []byte) error {
+	switch strings.Trim(string(data), `"`) {
+	case "true":
+		*b = true
+	case "false", "null", "":
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean %s", data)
+	}
+	return nil
+}
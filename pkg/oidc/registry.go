@@ -0,0 +1,75 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Registry holds the configured providers. Each provider is discovered the first time it is
+// used and then cached, so an unreachable provider does not prevent the API from starting.
+type Registry struct {
+	client    *http.Client
+	configs   map[string]Config
+	mu        sync.Mutex
+	providers map[string]*Provider
+}
+
+// NewRegistry creates a registry for the given provider configurations.
+func NewRegistry(configs []Config) *Registry {
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+			MaxIdleConnsPerHost:   runtime.GOMAXPROCS(0) + 1,
+		},
+	}
+
+	registry := &Registry{
+		client:    client,
+		configs:   make(map[string]Config, len(configs)),
+		providers: make(map[string]*Provider, len(configs)),
+	}
+	for _, cfg := range configs {
+		registry.configs[cfg.Name] = cfg
+	}
+	return registry
+}
+
+// Provider returns the provider with the given name, discovering it if needed.
+func (r *Registry) Provider(ctx context.Context, name string) (*Provider, error) {
+	cfg, ok := r.configs[name]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if provider, ok := r.providers[name]; ok {
+		return provider, nil
+	}
+
+	provider, err := Discover(ctx, r.client, cfg)
+	if err != nil {
+		return nil, err
+	}
+	r.providers[name] = provider
+	return provider, nil
+}
+
+// Names returns the names of the configured providers.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.configs))
+	for name := range r.configs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
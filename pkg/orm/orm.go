@@ -1,13 +1,15 @@
 package orm
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"log/slog"
 	"sync"
 	"time"
 
 	"github.com/EdwinRincon/browersfc-api/config"
-	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
+	"github.com/EdwinRincon/browersfc-api/pkg/migrate"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -28,12 +30,7 @@ func GetDBInstance() (*gorm.DB, error) {
 }
 
 func initializeDatabase() error {
-	dsn, err := config.GetDBURL()
-	if err != nil {
-		return fmt.Errorf("error getting database URL: %w", err)
-	}
-
-	sqlDB, err := openSQLConnection(dsn)
+	sqlDB, err := OpenSQLDB()
 	if err != nil {
 		return err
 	}
@@ -46,13 +43,22 @@ func initializeDatabase() error {
 
 	configureConnectionPool(sqlDB)
 
-	if err := runMigrations(gormDB); err != nil {
+	if err := runMigrations(sqlDB); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// OpenSQLDB opens a connection to the configured database without running the migrations.
+func OpenSQLDB() (*sql.DB, error) {
+	dsn, err := config.GetDBURL()
+	if err != nil {
+		return nil, fmt.Errorf("error getting database URL: %w", err)
+	}
+	return openSQLConnection(dsn)
+}
+
 func openSQLConnection(dsn string) (*sql.DB, error) {
 	sqlDB, err := sql.Open("pgx", dsn)
 	if err != nil {
@@ -63,8 +69,7 @@ func openSQLConnection(dsn string) (*sql.DB, error) {
 
 func openGormConnection(sqlDB *sql.DB) (*gorm.DB, error) {
 	gormConfig := &gorm.Config{
-		Logger:                                   NewContextAwareGormLogger(),
-		DisableForeignKeyConstraintWhenMigrating: true, // Disable FK constraints during migration
+		Logger: NewContextAwareGormLogger(),
 	}
 
 	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), gormConfig)
@@ -80,52 +85,20 @@ func configureConnectionPool(sqlDB *sql.DB) {
 	sqlDB.SetConnMaxLifetime(time.Hour)
 }
 
-func runMigrations(db *gorm.DB) error {
-	// Migrate tables in dependency order to avoid foreign key constraint errors
-	// Migrate each table individually to avoid GORM processing relationships prematurely
-
-	// Step 1: Base tables with no foreign key dependencies
-	if err := db.AutoMigrate(&model.Role{}); err != nil {
-		return fmt.Errorf("error migrating role table: %w", err)
-	}
-	if err := db.AutoMigrate(&model.Season{}); err != nil {
-		return fmt.Errorf("error migrating season table: %w", err)
-	}
-	if err := db.AutoMigrate(&model.Team{}); err != nil {
-		return fmt.Errorf("error migrating team table: %w", err)
-	}
-
-	// Step 2: Tables that depend on base tables
-	if err := db.AutoMigrate(&model.User{}); err != nil {
-		return fmt.Errorf("error migrating user table: %w", err)
-	}
-	if err := db.AutoMigrate(&model.Article{}); err != nil {
-		return fmt.Errorf("error migrating article table: %w", err)
-	}
-
-	// Step 3: Player (depends on User, but UserID is nullable)
-	if err := db.AutoMigrate(&model.Player{}); err != nil {
-		return fmt.Errorf("error migrating player table: %w", err)
-	}
-
-	// Step 4: Tables that depend on Team, Season, and Player
-	if err := db.AutoMigrate(&model.Match{}); err != nil {
-		return fmt.Errorf("error migrating match table: %w", err)
-	}
-	if err := db.AutoMigrate(&model.PlayerTeam{}); err != nil {
-		return fmt.Errorf("error migrating player_team table: %w", err)
-	}
-	if err := db.AutoMigrate(&model.TeamStat{}); err != nil {
-		return fmt.Errorf("error migrating team_stat table: %w", err)
+// runMigrations applies the pending SQL migrations. Replicas that start together wait for
+// each other, so every migration is applied once.
+func runMigrations(sqlDB *sql.DB) error {
+	migrator, err := migrate.New(sqlDB)
+	if err != nil {
+		return fmt.Errorf("error loading migrations: %w", err)
 	}
 
-	// Step 5: Tables that depend on Match
-	if err := db.AutoMigrate(&model.Lineup{}); err != nil {
-		return fmt.Errorf("error migrating lineup table: %w", err)
+	applied, err := migrator.Up(context.Background())
+	if err != nil {
+		return fmt.Errorf("error running migrations: %w", err)
 	}
-	if err := db.AutoMigrate(&model.PlayerStat{}); err != nil {
-		return fmt.Errorf("error migrating player_stat table: %w", err)
+	if applied > 0 {
+		slog.Info("Database migrations applied", "count", applied)
 	}
-
 	return nil
 }
@@ -3,20 +3,17 @@ package security
 // OAuth2 Security Constants
 const (
 	// Security limits and durations
-	MaxLoginAttemptsPerIP  = 5  // Maximum login attempts per IP address
-	LoginLockoutDuration   = 15 // Lockout duration in minutes
-	MinPasswordLength      = 8  // Minimum password length
-	TokenExpiryHours       = 24 // JWT token expiry in hours
-	MaxSessionsPerUser     = 5  // Maximum concurrent sessions per user
-	OAuthStateDuration     = 10 // OAuth state validity duration in minutes
-	MaxNewAccountsPerIP    = 2  // Maximum new accounts per IP per day
-	MaxNewAccountsPerEmail = 1  // Maximum accounts per email domain
+	MaxLoginAttemptsPerIP  = 5   // Maximum login attempts per IP address
+	LoginLockoutDuration   = 15  // Lockout duration in minutes
+	MinPasswordLength      = 8   // Minimum password length
+	MaxPasswordLength      = 128 // Maximum password length, bounds the hashing cost
+	PasswordResetDuration  = 60  // Password reset token validity duration in minutes
+	TokenExpiryHours       = 24  // JWT token expiry in hours
+	MaxSessionsPerUser     = 5   // Maximum concurrent sessions per user
+	OAuthStateDuration     = 10  // OAuth state validity duration in minutes
+	MaxNewAccountsPerIP    = 2   // Maximum new accounts per IP per day
+	MaxNewAccountsPerEmail = 1   // Maximum accounts per email domain
+	AccountDeletionGrace   = 30  // Days before a requested account deletion is carried out
+	InvitationDuration     = 7   // Invitation validity duration in days
+	EmailDomainCacheTTL    = 60  // Seconds the email domain policy is cached by each replica
 )
-
-// AllowedEmailDomains defines the email domains allowed for account creation
-var AllowedEmailDomains = []string{
-	"gmail.com",
-	"outlook.com",
-	"hotmail.com",
-	"yahoo.com",
-}
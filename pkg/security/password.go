@@ -0,0 +1,93 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Argon2id parameters for new password hashes (RFC 9106 second recommended option).
+const (
+	argon2Memory     = 64 * 1024 // KiB
+	argon2Iterations = 3
+	argon2Threads    = 2
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+)
+
+var ErrUnsupportedPasswordHash = errors.New("unsupported password hash")
+
+// dummyPasswordHash is verified against when a user has no password so that
+// unknown accounts take as long to reject as wrong passwords.
+var dummyPasswordHash = sync.OnceValue(func() string {
+	hash, _ := HashPassword("dummy password used for timing")
+	return hash
+})
+
+// HashPassword hashes the password with argon2id and encodes it in the PHC string format.
+func HashPassword(password string) (string, error) {
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, argon2Iterations, argon2Memory, argon2Threads, argon2KeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, argon2Memory, argon2Iterations, argon2Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// VerifyPassword checks the password against an argon2id or bcrypt hash. needsRehash is
+// true when the hash does not use the current algorithm or parameters and should be replaced.
+func VerifyPassword(encodedHash, password string) (match bool, needsRehash bool, err error) {
+	if strings.HasPrefix(encodedHash, "$2") {
+		err := bcrypt.CompareHashAndPassword([]byte(encodedHash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, false, nil
+		}
+		return err == nil, true, err
+	}
+
+	var version int
+	var memory uint32
+	var iterations uint32
+	var threads uint8
+	parts := strings.Split(encodedHash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return false, false, ErrUnsupportedPasswordHash
+	}
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, false, ErrUnsupportedPasswordHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &iterations, &threads); err != nil {
+		return false, false, ErrUnsupportedPasswordHash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, false, ErrUnsupportedPasswordHash
+	}
+	expected, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return false, false, ErrUnsupportedPasswordHash
+	}
+
+	key := argon2.IDKey([]byte(password), salt, iterations, memory, threads, uint32(len(expected)))
+	match = subtle.ConstantTimeCompare(key, expected) == 1
+	needsRehash = memory != argon2Memory || iterations != argon2Iterations || threads != argon2Threads
+	return match, needsRehash, nil
+}
+
+// VerifyDummyPassword spends the time of a password verification without checking anything.
+func VerifyDummyPassword(password string) {
+	_, _, _ = VerifyPassword(dummyPasswordHash(), password)
+}
@@ -0,0 +1,66 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+)
+
+// opaqueTokenBytes is the amount of random data in an opaque token (256 bits).
+const opaqueTokenBytes = 32
+
+// API keys look like bfc_<prefix>_<secret>. The prefix is stored in clear to find the
+// key, while the whole key is only stored hashed.
+const (
+	APIKeyScheme      = "bfc_"
+	apiKeyPrefixBytes = 6
+	apiKeyPrefixLen   = apiKeyPrefixBytes * 2
+)
+
+// GenerateOpaqueToken returns a random URL-safe token that carries no data.
+func GenerateOpaqueToken() (string, error) {
+	b := make([]byte, opaqueTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashToken returns the hex encoded SHA-256 digest of a token.
+// Only the digest is stored so a database leak does not expose usable tokens.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateAPIKey returns a new API key together with its lookup prefix.
+func GenerateAPIKey() (key string, prefix string, err error) {
+	b := make([]byte, apiKeyPrefixBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	prefix = hex.EncodeToString(b)
+
+	secret, err := GenerateOpaqueToken()
+	if err != nil {
+		return "", "", err
+	}
+	return APIKeyScheme + prefix + "_" + secret, prefix, nil
+}
+
+// ParseAPIKeyPrefix returns the lookup prefix of an API key.
+// It returns false when the key is not in the bfc_<prefix>_<secret> format.
+func ParseAPIKeyPrefix(key string) (string, bool) {
+	rest, ok := strings.CutPrefix(key, APIKeyScheme)
+	if !ok || len(rest) <= apiKeyPrefixLen+1 || rest[apiKeyPrefixLen] != '_' {
+		return "", false
+	}
+
+	prefix := rest[:apiKeyPrefixLen]
+	if _, err := hex.DecodeString(prefix); err != nil {
+		return "", false
+	}
+	return prefix, true
+}
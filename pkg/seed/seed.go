@@ -5,6 +5,7 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/EdwinRincon/browersfc-api/domain"
 	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence/model"
 	"github.com/EdwinRincon/browersfc-api/pkg/orm"
 	"github.com/brianvoe/gofakeit/v7"
@@ -33,7 +34,12 @@ func SeedDatabase() error {
 		return fmt.Errorf("failed to seed users: %w", err)
 	}
 
-	teams, err := seedTeams(db)
+	venues, err := seedVenues(db)
+	if err != nil {
+		return fmt.Errorf("failed to seed venues: %w", err)
+	}
+
+	teams, err := seedTeams(db, venues)
 	if err != nil {
 		return fmt.Errorf("failed to seed teams: %w", err)
 	}
@@ -58,7 +64,7 @@ func SeedDatabase() error {
 		return fmt.Errorf("failed to seed team stats: %w", err)
 	}
 
-	matches, err := seedMatches(db, teams, seasons, players)
+	matches, err := seedMatches(db, teams, seasons, players, venues)
 	if err != nil {
 		return fmt.Errorf("failed to seed matches: %w", err)
 	}
@@ -86,7 +92,7 @@ func SeedDatabase() error {
 	return nil
 }
 
-// seedRoles creates 5 roles in the database
+// seedRoles creates 5 roles with their default permissions in the database
 func seedRoles(db *gorm.DB) ([]model.Role, error) {
 	roles := []model.Role{
 		{Name: "admin", Description: "Administrator with full access"},
@@ -96,6 +102,13 @@ func seedRoles(db *gorm.DB) ([]model.Role, error) {
 		{Name: "fan", Description: "Regular user with limited access"},
 	}
 
+	// Grant the default permissions of the built-in roles
+	for i := range roles {
+		for _, permission := range domain.DefaultPermissionsForRole(roles[i].Name) {
+			roles[i].Permissions = append(roles[i].Permissions, model.RolePermission{Permission: permission})
+		}
+	}
+
 	if err := db.Create(&roles).Error; err != nil {
 		return nil, err
 	}
@@ -129,8 +142,35 @@ func seedUsers(db *gorm.DB, roles []model.Role) ([]model.User, error) {
 	return users, nil
 }
 
+// seedVenues creates 5 venues in the database
+func seedVenues(db *gorm.DB) ([]model.Venue, error) {
+	names := []string{"Home Stadium", "Away Field", "Central Arena", "Main Stadium", "City Field"}
+	surfaces := []string{"natural_grass", "artificial_turf", "hybrid", "natural_grass", "artificial_turf"}
+	venues := make([]model.Venue, len(names))
+
+	for i := range venues {
+		latitude := gofakeit.Latitude()
+		longitude := gofakeit.Longitude()
+
+		venues[i] = model.Venue{
+			Name:      names[i],
+			Address:   gofakeit.Street() + ", " + gofakeit.City(),
+			Capacity:  uint32(gofakeit.Number(200, 5000)),
+			Surface:   surfaces[i],
+			Latitude:  &latitude,
+			Longitude: &longitude,
+		}
+	}
+
+	if err := db.Create(&venues).Error; err != nil {
+		return nil, err
+	}
+
+	return venues, nil
+}
+
 // seedTeams creates 5 teams in the database
-func seedTeams(db *gorm.DB) ([]model.Team, error) {
+func seedTeams(db *gorm.DB, venues []model.Venue) ([]model.Team, error) {
 	teams := make([]model.Team, 5)
 
 	teamColors := []string{"#FF0000", "#0000FF", "#00FF00", "#FFFF00", "#FF00FF"}
@@ -143,6 +183,7 @@ func seedTeams(db *gorm.DB) ([]model.Team, error) {
 			PrimaryColor:   teamColors[i],
 			SecondaryColor: teamSecondColors[i],
 			Shield:         gofakeit.URL(),
+			HomeVenueID:    &venues[i%len(venues)].ID,
 			// NextMatchID will be set after matches are created
 		}
 	}
@@ -290,10 +331,9 @@ func seedTeamStats(db *gorm.DB, teams []model.Team, seasons []model.Season) erro
 }
 
 // seedMatches creates 5 matches in the database
-func seedMatches(db *gorm.DB, teams []model.Team, seasons []model.Season, players []model.Player) ([]model.Match, error) {
+func seedMatches(db *gorm.DB, teams []model.Team, seasons []model.Season, players []model.Player, venues []model.Venue) ([]model.Match, error) {
 	matches := make([]model.Match, 5)
 	statuses := []string{"scheduled", "in_progress", "completed", "postponed", "cancelled"}
-	locations := []string{"Home Stadium", "Away Field", "Central Arena", "Main Stadium", "City Field"}
 
 	currentSeason := seasons[len(seasons)-1]
 
@@ -337,7 +377,7 @@ func seedMatches(db *gorm.DB, teams []model.Team, seasons []model.Season, player
 		matches[i] = model.Match{
 			Status:      statuses[i%len(statuses)],
 			Kickoff:     kickoff,
-			Location:    locations[i%len(locations)],
+			VenueID:     &venues[i%len(venues)].ID,
 			HomeGoals:   homeGoals,
 			AwayGoals:   awayGoals,
 			HomeTeamID:  teams[homeTeamIndex].ID,
@@ -8,7 +8,6 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/EdwinRincon/browersfc-api/pkg/security"
 	"github.com/go-playground/validator/v10"
 )
 
@@ -28,9 +27,6 @@ func InitValidator() error {
 	if err := validate.RegisterValidation("safe_email", validateSafeEmail); err != nil {
 		return err
 	}
-	if err := validate.RegisterValidation("allowed_domain", validateAllowedDomain); err != nil {
-		return err
-	}
 	if err := validate.RegisterValidation("safe_url", validateSafeURL); err != nil {
 		return err
 	}
@@ -92,27 +88,6 @@ func validateSafeEmail(fl validator.FieldLevel) bool {
 	return true
 }
 
-// validateAllowedDomain checks if email domain is in allowed list
-func validateAllowedDomain(fl validator.FieldLevel) bool {
-	email := fl.Field().String()
-	parts := strings.Split(email, "@")
-	if len(parts) != 2 {
-		return false
-	}
-
-	domain := strings.ToLower(parts[1])
-	if domain == "" {
-		return false
-	}
-	for _, allowed := range security.AllowedEmailDomains {
-		if domain == allowed {
-			return true
-		}
-	}
-
-	return false
-}
-
 // validateSafeURL validates URLs
 func validateSafeURL(fl validator.FieldLevel) bool {
 	url := fl.Field().String()
@@ -204,9 +179,6 @@ var messageRegistry = map[string]messageFunc{
 	"safe_url": func(_ validator.FieldError) string {
 		return "Invalid or unsafe URL"
 	},
-	"allowed_domain": func(_ validator.FieldError) string {
-		return "Email domain is not allowed"
-	},
 	"safe_email": func(_ validator.FieldError) string {
 		return "Invalid or unsafe email format"
 	},
@@ -0,0 +1,92 @@
+-- Cleans up the rows that refer to missing records, which make the 0004_foreign_keys migration
+-- fail. It is not applied by the migrations and deletes data, so review it and back up the
+-- database before running it by hand:
+--
+--     psql "$(cat secrets/db_url.txt)" -v ON_ERROR_STOP=1 -1 -f scripts/cleanup_orphaned_rows.sql
+--
+-- Optional references are cleared, and rows that cannot exist without the record they belong
+-- to are deleted. Parents are cleaned before their children, so that the rows left without a
+-- parent by one statement are removed by a later one.
+
+UPDATE users SET role_id = NULL WHERE role_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM roles WHERE roles.id = users.role_id);
+UPDATE users SET team_id = NULL WHERE team_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM teams WHERE teams.id = users.team_id);
+DELETE FROM role_permissions WHERE NOT EXISTS (SELECT 1 FROM roles WHERE roles.id = role_permissions.role_id);
+UPDATE players SET user_id = NULL WHERE user_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM users WHERE users.id = players.user_id);
+UPDATE teams SET home_venue_id = NULL WHERE home_venue_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM venues WHERE venues.id = teams.home_venue_id);
+
+DELETE FROM matches
+WHERE NOT EXISTS (SELECT 1 FROM teams WHERE teams.id = matches.home_team_id)
+   OR NOT EXISTS (SELECT 1 FROM teams WHERE teams.id = matches.away_team_id)
+   OR NOT EXISTS (SELECT 1 FROM seasons WHERE seasons.id = matches.season_id);
+UPDATE matches SET venue_id = NULL WHERE venue_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM venues WHERE venues.id = matches.venue_id);
+UPDATE matches SET mvp_player_id = NULL WHERE mvp_player_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM players WHERE players.id = matches.mvp_player_id);
+UPDATE teams SET next_match_id = NULL WHERE next_match_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM matches WHERE matches.id = teams.next_match_id);
+
+DELETE FROM articles WHERE NOT EXISTS (SELECT 1 FROM seasons WHERE seasons.id = articles.season_id);
+
+DELETE FROM player_teams
+WHERE NOT EXISTS (SELECT 1 FROM players WHERE players.id = player_teams.player_id)
+   OR NOT EXISTS (SELECT 1 FROM teams WHERE teams.id = player_teams.team_id)
+   OR NOT EXISTS (SELECT 1 FROM seasons WHERE seasons.id = player_teams.season_id);
+
+DELETE FROM team_stats
+WHERE NOT EXISTS (SELECT 1 FROM teams WHERE teams.id = team_stats.team_id)
+   OR NOT EXISTS (SELECT 1 FROM seasons WHERE seasons.id = team_stats.season_id);
+
+DELETE FROM lineups
+WHERE NOT EXISTS (SELECT 1 FROM players WHERE players.id = lineups.player_id)
+   OR NOT EXISTS (SELECT 1 FROM matches WHERE matches.id = lineups.match_id);
+
+DELETE FROM player_stats
+WHERE NOT EXISTS (SELECT 1 FROM players WHERE players.id = player_stats.player_id)
+   OR NOT EXISTS (SELECT 1 FROM matches WHERE matches.id = player_stats.match_id)
+   OR NOT EXISTS (SELECT 1 FROM seasons WHERE seasons.id = player_stats.season_id);
+UPDATE player_stats SET team_id = NULL WHERE team_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM teams WHERE teams.id = player_stats.team_id);
+
+DELETE FROM match_reports
+WHERE NOT EXISTS (SELECT 1 FROM matches WHERE matches.id = match_reports.match_id)
+   OR NOT EXISTS (SELECT 1 FROM users WHERE users.id = match_reports.submitted_by_id);
+UPDATE match_reports SET reviewed_by_id = NULL WHERE reviewed_by_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM users WHERE users.id = match_reports.reviewed_by_id);
+
+DELETE FROM match_report_events
+WHERE NOT EXISTS (SELECT 1 FROM match_reports WHERE match_reports.id = match_report_events.report_id)
+   OR NOT EXISTS (SELECT 1 FROM players WHERE players.id = match_report_events.player_id)
+   OR NOT EXISTS (SELECT 1 FROM teams WHERE teams.id = match_report_events.team_id);
+UPDATE match_report_events SET assist_player_id = NULL WHERE assist_player_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM players WHERE players.id = match_report_events.assist_player_id);
+
+DELETE FROM predictions
+WHERE NOT EXISTS (SELECT 1 FROM users WHERE users.id = predictions.user_id)
+   OR NOT EXISTS (SELECT 1 FROM matches WHERE matches.id = predictions.match_id);
+
+DELETE FROM mvp_polls WHERE NOT EXISTS (SELECT 1 FROM matches WHERE matches.id = mvp_polls.match_id);
+UPDATE mvp_polls SET winner_player_id = NULL WHERE winner_player_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM players WHERE players.id = mvp_polls.winner_player_id);
+
+DELETE FROM mvp_votes
+WHERE NOT EXISTS (SELECT 1 FROM matches WHERE matches.id = mvp_votes.match_id)
+   OR NOT EXISTS (SELECT 1 FROM users WHERE users.id = mvp_votes.user_id)
+   OR NOT EXISTS (SELECT 1 FROM players WHERE players.id = mvp_votes.player_id);
+
+DELETE FROM sessions WHERE NOT EXISTS (SELECT 1 FROM users WHERE users.id = sessions.user_id);
+DELETE FROM refresh_tokens WHERE NOT EXISTS (SELECT 1 FROM users WHERE users.id = refresh_tokens.user_id);
+DELETE FROM revoked_tokens WHERE NOT EXISTS (SELECT 1 FROM users WHERE users.id = revoked_tokens.user_id);
+DELETE FROM user_token_revocations WHERE NOT EXISTS (SELECT 1 FROM users WHERE users.id = user_token_revocations.user_id);
+DELETE FROM api_keys WHERE NOT EXISTS (SELECT 1 FROM users WHERE users.id = api_keys.user_id);
+DELETE FROM api_key_scopes WHERE NOT EXISTS (SELECT 1 FROM api_keys WHERE api_keys.id = api_key_scopes.api_key_id);
+DELETE FROM user_identities WHERE NOT EXISTS (SELECT 1 FROM users WHERE users.id = user_identities.user_id);
+DELETE FROM user_profile_changes WHERE NOT EXISTS (SELECT 1 FROM users WHERE users.id = user_profile_changes.user_id);
+DELETE FROM account_deletions WHERE NOT EXISTS (SELECT 1 FROM users WHERE users.id = account_deletions.user_id);
+DELETE FROM password_credentials WHERE NOT EXISTS (SELECT 1 FROM users WHERE users.id = password_credentials.user_id);
+DELETE FROM password_reset_tokens WHERE NOT EXISTS (SELECT 1 FROM users WHERE users.id = password_reset_tokens.user_id);
+
+DELETE FROM player_claims
+WHERE NOT EXISTS (SELECT 1 FROM users WHERE users.id = player_claims.user_id)
+   OR NOT EXISTS (SELECT 1 FROM players WHERE players.id = player_claims.player_id);
+UPDATE player_claims SET reviewed_by_id = NULL WHERE reviewed_by_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM users WHERE users.id = player_claims.reviewed_by_id);
+
+DELETE FROM invitations
+WHERE NOT EXISTS (SELECT 1 FROM roles WHERE roles.id = invitations.role_id)
+   OR NOT EXISTS (SELECT 1 FROM users WHERE users.id = invitations.invited_by_id);
+UPDATE invitations SET team_id = NULL WHERE team_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM teams WHERE teams.id = invitations.team_id);
+UPDATE invitations SET accepted_by_id = NULL WHERE accepted_by_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM users WHERE users.id = invitations.accepted_by_id);
+
+UPDATE audit_entries SET actor_id = NULL WHERE actor_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM users WHERE users.id = audit_entries.actor_id);
@@ -1,49 +1,133 @@
 package server
 
 import (
+	"time"
+
+	"github.com/EdwinRincon/browersfc-api/config"
 	"github.com/EdwinRincon/browersfc-api/domain"
 	domainservice "github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/EdwinRincon/browersfc-api/pkg/security"
 )
 
 // CreateAuthenticationDomainService creates an authentication domain service with repository implementing domain interface
-func CreateAuthenticationDomainService(authRepo domain.AuthenticationRepository) *domainservice.AuthenticationDomainService {
-	return domainservice.NewAuthenticationDomainService(authRepo)
+func CreateAuthenticationDomainService(authRepo domain.AuthenticationRepository, refreshTokenRepo domain.RefreshTokenRepository, sessionRepo domain.SessionRepository, tokenRevocationRepo domain.TokenRevocationRepository, userRepo domain.UserRepository, apiKeyRepo domain.APIKeyRepository, roleRepo domain.RoleRepository) *domainservice.AuthenticationDomainService {
+	return domainservice.NewAuthenticationDomainService(authRepo, refreshTokenRepo, sessionRepo, tokenRevocationRepo, userRepo, apiKeyRepo, roleRepo, domain.SessionPolicy{
+		AccessTokenTTL:  config.GetAccessTokenTTL(),
+		RefreshTokenTTL: config.GetRefreshTokenTTL(),
+		MaxSessions:     security.MaxSessionsPerUser,
+	})
+}
+
+// CreateAPIKeyDomainService creates an API key domain service with repositories implementing domain interfaces
+func CreateAPIKeyDomainService(authRepo domain.AuthenticationRepository, apiKeyRepo domain.APIKeyRepository, userRepo domain.UserRepository, roleRepo domain.RoleRepository, auditor domain.Auditor) *domainservice.APIKeyDomainService {
+	return domainservice.NewAPIKeyDomainService(authRepo, apiKeyRepo, userRepo, roleRepo, auditor)
+}
+
+// CreateCredentialDomainService creates a credential domain service enforcing the password and login lockout policy
+func CreateCredentialDomainService(credentialRepo domain.CredentialRepository, loginAttemptRepo domain.LoginAttemptRepository, authRepo domain.AuthenticationRepository, userRepo domain.UserRepository, roleRepo domain.RoleRepository, settingsService *domainservice.SettingsDomainService) *domainservice.CredentialDomainService {
+	return domainservice.NewCredentialDomainService(credentialRepo, loginAttemptRepo, authRepo, userRepo, roleRepo, settingsService.IsEmailDomainAllowed, domain.PasswordPolicy{
+		MinLength:        security.MinPasswordLength,
+		MaxLength:        security.MaxPasswordLength,
+		MaxLoginAttempts: security.MaxLoginAttemptsPerIP,
+		LockoutDuration:  security.LoginLockoutDuration * time.Minute,
+		ResetTokenTTL:    security.PasswordResetDuration * time.Minute,
+	})
+}
+
+// CreateAccountDomainService creates an account domain service that erases accounts after the deletion grace period
+func CreateAccountDomainService(accountRepo domain.AccountRepository, userRepo domain.UserRepository, roleRepo domain.RoleRepository, tokenRevocationRepo domain.TokenRevocationRepository) *domainservice.AccountDomainService {
+	return domainservice.NewAccountDomainService(accountRepo, userRepo, roleRepo, tokenRevocationRepo, security.AccountDeletionGrace*24*time.Hour)
+}
+
+// CreatePlayerClaimDomainService creates a player claim domain service with repositories implementing domain interfaces
+func CreatePlayerClaimDomainService(playerClaimRepo domain.PlayerClaimRepository, playerRepo domain.PlayerRepository, userRepo domain.UserRepository, roleRepo domain.RoleRepository, playerStatsRepo domain.PlayerStatsRepository, seasonRepo domain.SeasonRepository, auditor domain.Auditor) *domainservice.PlayerClaimDomainService {
+	return domainservice.NewPlayerClaimDomainService(playerClaimRepo, playerRepo, userRepo, roleRepo, playerStatsRepo, seasonRepo, auditor)
+}
+
+// CreateInvitationDomainService creates an invitation domain service whose links expire after the invitation duration
+func CreateInvitationDomainService(invitationRepo domain.InvitationRepository, userRepo domain.UserRepository, roleRepo domain.RoleRepository, teamRepo domain.TeamRepository, identityRepo domain.UserIdentityRepository, authRepo domain.AuthenticationRepository, mailSender domain.MailSender, settingsService *domainservice.SettingsDomainService, auditor domain.Auditor) *domainservice.InvitationDomainService {
+	return domainservice.NewInvitationDomainService(invitationRepo, userRepo, roleRepo, teamRepo, identityRepo, authRepo, mailSender, settingsService.IsEmailDomainAllowed, security.InvitationDuration*24*time.Hour, config.GetAppURL()+"/invitations/accept", auditor)
 }
 
 // CreateArticleDomainService creates an article domain service with repository implementing domain interface
-func CreateArticleDomainService(articleRepo domain.ArticleRepository, seasonRepo domain.SeasonRepository) *domainservice.ArticleDomainService {
-	return domainservice.NewArticleDomainService(articleRepo, seasonRepo)
+func CreateArticleDomainService(articleRepo domain.ArticleRepository, seasonRepo domain.SeasonRepository, auditor domain.Auditor) *domainservice.ArticleDomainService {
+	return domainservice.NewArticleDomainService(articleRepo, seasonRepo, auditor)
+}
+
+// CreateIdentityDomainService creates an identity domain service that only creates accounts for allowed email domains
+func CreateIdentityDomainService(identityRepo domain.UserIdentityRepository, userRepo domain.UserRepository, roleRepo domain.RoleRepository, credentialRepo domain.CredentialRepository, settingsService *domainservice.SettingsDomainService) *domainservice.IdentityDomainService {
+	return domainservice.NewIdentityDomainService(identityRepo, userRepo, roleRepo, credentialRepo, settingsService.IsEmailDomainAllowed)
 }
 
 // CreateMatchDomainService creates a match domain service with repository implementing domain interface
-func CreateMatchDomainService(matchRepo domain.MatchRepository) *domainservice.MatchDomainService {
+func CreateMatchDomainService(matchRepo domain.MatchRepository, venueRepo domain.VenueRepository, resultPublisher *domainservice.MatchResultPublisher, auditor domain.Auditor) *domainservice.MatchDomainService {
 	// Repository already implements domain.MatchRepository interface
-	return domainservice.NewMatchDomainService(matchRepo)
+	return domainservice.NewMatchDomainService(matchRepo, venueRepo, resultPublisher, auditor)
 }
 
 // CreateRoleDomainService creates a role domain service with repository implementing domain interface
-func CreateRoleDomainService(roleRepo domain.RoleRepository) *domainservice.RoleDomainService {
-	return domainservice.NewRoleDomainService(roleRepo)
+func CreateRoleDomainService(roleRepo domain.RoleRepository, auditor domain.Auditor) *domainservice.RoleDomainService {
+	return domainservice.NewRoleDomainService(roleRepo, auditor)
 }
 
 // CreateSeasonDomainService creates a season domain service with repository implementing domain interface
-func CreateSeasonDomainService(seasonRepo domain.SeasonRepository) *domainservice.SeasonDomainService {
-	return domainservice.NewSeasonDomainService(seasonRepo)
+func CreateSeasonDomainService(seasonRepo domain.SeasonRepository, auditor domain.Auditor) *domainservice.SeasonDomainService {
+	return domainservice.NewSeasonDomainService(seasonRepo, auditor)
+}
+
+// CreateUserDomainService creates a user domain service that only creates accounts for allowed email domains
+func CreateUserDomainService(userRepo domain.UserRepository, teamRepo domain.TeamRepository, settingsService *domainservice.SettingsDomainService, auditor domain.Auditor) *domainservice.UserDomainService {
+	return domainservice.NewUserDomainService(userRepo, teamRepo, settingsService.IsEmailDomainAllowed, auditor)
+}
+
+// CreateSettingsDomainService creates a settings domain service that caches the email domain policy
+func CreateSettingsDomainService(emailDomainRepo domain.EmailDomainRuleRepository, auditor domain.Auditor) *domainservice.SettingsDomainService {
+	return domainservice.NewSettingsDomainService(emailDomainRepo, security.EmailDomainCacheTTL*time.Second, auditor)
 }
 
-// CreateUserDomainService creates a user domain service with repository implementing domain interface
-func CreateUserDomainService(userRepo domain.UserRepository) *domainservice.UserDomainService {
-	return domainservice.NewUserDomainService(userRepo)
+// CreateAuditDomainService creates an audit domain service with repository implementing domain interface
+func CreateAuditDomainService(auditRepo domain.AuditRepository) *domainservice.AuditDomainService {
+	return domainservice.NewAuditDomainService(auditRepo)
+}
+
+// CreateTrashDomainService creates a trash domain service that keeps deleted records for the configured retention period
+func CreateTrashDomainService(trashRepo domain.TrashRepository, articleRepo domain.ArticleRepository, matchRepo domain.MatchRepository, playerRepo domain.PlayerRepository, teamRepo domain.TeamRepository, auditor domain.Auditor) *domainservice.TrashDomainService {
+	return domainservice.NewTrashDomainService(trashRepo, articleRepo, matchRepo, playerRepo, teamRepo, auditor, config.GetTrashRetention())
 }
 
 // CreateTeamDomainService creates a team domain service with repository implementing domain interface
-func CreateTeamDomainService(teamRepo domain.TeamRepository) *domainservice.TeamDomainService {
-	return domainservice.NewTeamDomainService(teamRepo)
+func CreateTeamDomainService(teamRepo domain.TeamRepository, venueRepo domain.VenueRepository, auditor domain.Auditor) *domainservice.TeamDomainService {
+	return domainservice.NewTeamDomainService(teamRepo, venueRepo, auditor)
+}
+
+// CreateVenueDomainService creates a venue domain service with repository implementing domain interface
+func CreateVenueDomainService(venueRepo domain.VenueRepository, auditor domain.Auditor) *domainservice.VenueDomainService {
+	return domainservice.NewVenueDomainService(venueRepo, auditor)
+}
+
+// CreateMatchReportDomainService creates a match report domain service with repositories implementing domain interfaces
+func CreateMatchReportDomainService(matchReportRepo domain.MatchReportRepository, matchRepo domain.MatchRepository, playerRepo domain.PlayerRepository, resultPublisher *domainservice.MatchResultPublisher, auditor domain.Auditor) *domainservice.MatchReportDomainService {
+	return domainservice.NewMatchReportDomainService(matchReportRepo, matchRepo, playerRepo, resultPublisher, auditor)
+}
+
+// CreateMVPVoteDomainService creates an MVP vote domain service using the configured voting window
+func CreateMVPVoteDomainService(mvpVoteRepo domain.MVPVoteRepository, matchRepo domain.MatchRepository, lineupRepo domain.LineupRepository) *domainservice.MVPVoteDomainService {
+	return domainservice.NewMVPVoteDomainService(mvpVoteRepo, matchRepo, lineupRepo, config.GetMVPVotingWindow())
+}
+
+// CreatePredictionDomainService creates a prediction domain service using the configured scoring rules
+func CreatePredictionDomainService(predictionRepo domain.PredictionRepository, matchRepo domain.MatchRepository, seasonRepo domain.SeasonRepository) *domainservice.PredictionDomainService {
+	scoring := config.GetPredictionScoring()
+	return domainservice.NewPredictionDomainService(predictionRepo, matchRepo, seasonRepo, domain.PredictionScoring{
+		ExactScore:     scoring.ExactScore,
+		CorrectOutcome: scoring.CorrectOutcome,
+		GoalDifference: scoring.GoalDifference,
+	})
 }
 
 // CreatePlayerDomainService creates a player domain service with repository implementing domain interface
-func CreatePlayerDomainService(playerRepo domain.PlayerRepository) *domainservice.PlayerDomainService {
-	return domainservice.NewPlayerDomainService(playerRepo)
+func CreatePlayerDomainService(playerRepo domain.PlayerRepository, auditor domain.Auditor) *domainservice.PlayerDomainService {
+	return domainservice.NewPlayerDomainService(playerRepo, auditor)
 }
 
 // CreatePlayerTeamDomainService creates a player team domain service with repository implementing domain interface
@@ -52,8 +136,9 @@ func CreatePlayerTeamDomainService(
 	playerRepo domain.PlayerRepository,
 	teamRepo domain.TeamRepository,
 	seasonRepo domain.SeasonRepository,
+	auditor domain.Auditor,
 ) *domainservice.PlayerTeamDomainService {
-	return domainservice.NewPlayerTeamDomainService(playerTeamRepo, playerRepo, teamRepo, seasonRepo)
+	return domainservice.NewPlayerTeamDomainService(playerTeamRepo, playerRepo, teamRepo, seasonRepo, auditor)
 }
 
 // CreateLineupDomainService creates a lineup domain service with repository implementing domain interface
@@ -61,8 +146,20 @@ func CreateLineupDomainService(
 	lineupRepo domain.LineupRepository,
 	matchRepo domain.MatchRepository,
 	playerRepo domain.PlayerRepository,
+	auditor domain.Auditor,
 ) *domainservice.LineupDomainService {
-	return domainservice.NewLineupDomainService(lineupRepo, matchRepo, playerRepo)
+	return domainservice.NewLineupDomainService(lineupRepo, matchRepo, playerRepo, auditor)
+}
+
+// CreateTeamAccessDomainService creates the team-scoped authorization service used by coaches and admins
+func CreateTeamAccessDomainService(
+	userRepo domain.UserRepository,
+	matchRepo domain.MatchRepository,
+	lineupRepo domain.LineupRepository,
+	playerStatsRepo domain.PlayerStatsRepository,
+	playerTeamRepo domain.PlayerTeamRepository,
+) *domainservice.TeamAccessDomainService {
+	return domainservice.NewTeamAccessDomainService(userRepo, matchRepo, lineupRepo, playerStatsRepo, playerTeamRepo)
 }
 
 // CreateTeamStatsDomainService creates a team stats domain service with repository implementing domain interface
@@ -70,8 +167,9 @@ func CreateTeamStatsDomainService(
 	teamStatsRepo domain.TeamStatsRepository,
 	teamRepo domain.TeamRepository,
 	seasonRepo domain.SeasonRepository,
+	auditor domain.Auditor,
 ) *domainservice.TeamStatsDomainService {
-	return domainservice.NewTeamStatsDomainService(teamStatsRepo, teamRepo, seasonRepo)
+	return domainservice.NewTeamStatsDomainService(teamStatsRepo, teamRepo, seasonRepo, auditor)
 }
 
 // CreatePlayerStatsDomainService creates a player stats domain service with repository implementing domain interface
@@ -81,6 +179,7 @@ func CreatePlayerStatsDomainService(
 	matchRepo domain.MatchRepository,
 	seasonRepo domain.SeasonRepository,
 	teamRepo domain.TeamRepository,
+	auditor domain.Auditor,
 ) *domainservice.PlayerStatsDomainService {
-	return domainservice.NewPlayerStatsDomainService(playerStatsRepo, playerRepo, matchRepo, seasonRepo, teamRepo)
+	return domainservice.NewPlayerStatsDomainService(playerStatsRepo, playerRepo, matchRepo, seasonRepo, teamRepo, auditor)
 }
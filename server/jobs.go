@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// backgroundJob is a task that runs periodically while the server is up.
+type backgroundJob struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+}
+
+// initializeJobs creates the periodic maintenance jobs backed by the domain services.
+func initializeJobs(services *Services) []backgroundJob {
+	return []backgroundJob{
+		{
+			name:     "close_mvp_polls",
+			interval: time.Minute,
+			run: func(ctx context.Context) error {
+				closed, err := services.MVPVoteDomain.CloseExpiredPolls(ctx)
+				if closed > 0 {
+					slog.Info("MVP polls closed", "count", closed)
+				}
+				return err
+			},
+		},
+		{
+			name:     "purge_expired_oauth_states",
+			interval: time.Minute,
+			run: func(ctx context.Context) error {
+				purged, err := services.PKCE.DeleteExpiredPKCE(ctx, time.Now())
+				if purged > 0 {
+					slog.Debug("expired OAuth states purged", "count", purged)
+				}
+				return err
+			},
+		},
+		{
+			name:     "purge_idle_rate_limits",
+			interval: time.Hour,
+			run: func(ctx context.Context) error {
+				purged, err := services.RateLimiter.PurgeIdleBuckets(ctx)
+				if purged > 0 {
+					slog.Debug("idle rate limit buckets purged", "count", purged)
+				}
+				return err
+			},
+		},
+		{
+			name:     "purge_expired_idempotency_keys",
+			interval: time.Hour,
+			run: func(ctx context.Context) error {
+				purged, err := services.IdempotencyKeys.PurgeExpired(ctx)
+				if purged > 0 {
+					slog.Debug("expired idempotency keys purged", "count", purged)
+				}
+				return err
+			},
+		},
+		{
+			name:     "purge_expired_tokens",
+			interval: time.Hour,
+			run: func(ctx context.Context) error {
+				purged, err := services.AuthenticationDomain.PurgeExpiredTokens(ctx)
+				if purged > 0 {
+					slog.Info("expired tokens purged", "count", purged)
+				}
+				return err
+			},
+		},
+		{
+			name:     "purge_expired_credentials",
+			interval: time.Hour,
+			run: func(ctx context.Context) error {
+				purged, err := services.CredentialDomain.PurgeExpired(ctx)
+				if purged > 0 {
+					slog.Info("expired password reset tokens and login attempts purged", "count", purged)
+				}
+				return err
+			},
+		},
+		{
+			name:     "anonymize_deleted_accounts",
+			interval: time.Hour,
+			run: func(ctx context.Context) error {
+				anonymized, err := services.AccountDomain.AnonymizeDueAccounts(ctx)
+				if anonymized > 0 {
+					slog.Info("deleted accounts anonymized", "count", anonymized)
+				}
+				return err
+			},
+		},
+		{
+			name:     "purge_trash",
+			interval: time.Hour,
+			run: func(ctx context.Context) error {
+				purged, err := services.TrashDomain.PurgeTrash(ctx)
+				if purged > 0 {
+					slog.Info("deleted records purged from the trash", "count", purged)
+				}
+				return err
+			},
+		},
+	}
+}
+
+// startBackgroundJobs runs every job on its own ticker until ctx is cancelled.
+func startBackgroundJobs(ctx context.Context, jobs []backgroundJob) {
+	for _, job := range jobs {
+		go runJob(ctx, job)
+	}
+}
+
+// runJob executes the job once per interval, logging failures without stopping.
+func runJob(ctx context.Context, job backgroundJob) {
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			runCtx, cancel := context.WithTimeout(ctx, job.interval)
+			if err := job.run(runCtx); err != nil {
+				slog.Error("background job failed", "job", job.name, "error", err)
+			}
+			cancel()
+		}
+	}
+}
@@ -21,39 +21,70 @@ import (
 	docs "github.com/EdwinRincon/browersfc-api/docs"
 	"github.com/EdwinRincon/browersfc-api/domain"
 	domainservice "github.com/EdwinRincon/browersfc-api/internal/domain/service"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/audit"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/mail"
+	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/memory"
 	"github.com/EdwinRincon/browersfc-api/internal/infrastructure/persistence"
 	"github.com/EdwinRincon/browersfc-api/pkg/jwt"
+	"github.com/EdwinRincon/browersfc-api/pkg/oidc"
 	"github.com/EdwinRincon/browersfc-api/pkg/orm"
+	"github.com/EdwinRincon/browersfc-api/pkg/security"
 )
 
 type Server struct {
-	Router    *gin.Engine
-	Port      string
-	JWTSecret []byte
+	Router  *gin.Engine
+	Port    string
+	JWTKeys *jwt.KeySet
+	jobs    []backgroundJob
 }
 
 // Repositories contains all data access layer dependencies (driven ports).
 // Following hexagonal architecture, these represent the infrastructure adapters.
 type Repositories struct {
-	User           domain.UserRepository
-	Role           domain.RoleRepository
-	Team           domain.TeamRepository
-	Player         domain.PlayerRepository
-	PlayerTeam     domain.PlayerTeamRepository
-	Season         domain.SeasonRepository
-	Lineup         domain.LineupRepository
-	Match          domain.MatchRepository
-	Article        domain.ArticleRepository
-	TeamStat       domain.TeamStatsRepository
-	PlayerStat     domain.PlayerStatsRepository
-	Authentication domain.AuthenticationRepository
+	User            domain.UserRepository
+	Role            domain.RoleRepository
+	Team            domain.TeamRepository
+	Player          domain.PlayerRepository
+	PlayerTeam      domain.PlayerTeamRepository
+	Season          domain.SeasonRepository
+	Lineup          domain.LineupRepository
+	Match           domain.MatchRepository
+	Article         domain.ArticleRepository
+	TeamStat        domain.TeamStatsRepository
+	PlayerStat      domain.PlayerStatsRepository
+	Venue           domain.VenueRepository
+	MatchReport     domain.MatchReportRepository
+	Prediction      domain.PredictionRepository
+	MVPVote         domain.MVPVoteRepository
+	RefreshToken    domain.RefreshTokenRepository
+	Session         domain.SessionRepository
+	TokenRevocation domain.TokenRevocationRepository
+	APIKey          domain.APIKeyRepository
+	UserIdentity    domain.UserIdentityRepository
+	Credential      domain.CredentialRepository
+	LoginAttempt    domain.LoginAttemptRepository
+	Account         domain.AccountRepository
+	PlayerClaim     domain.PlayerClaimRepository
+	Invitation      domain.InvitationRepository
+	EmailDomainRule domain.EmailDomainRuleRepository
+	Audit           domain.AuditRepository
+	Trash           domain.TrashRepository
+	PKCE            domain.PKCEStore
+	RateLimit       domain.RateLimitStore
+	Idempotency     domain.IdempotencyStore
+	Authentication  domain.AuthenticationRepository
 }
 
 // Services contains domain services (business rules) and auxiliary application services.
 // Domain services implement core business logic, while application services handle cross-cutting concerns.
 type Services struct {
 	// Application services (cross-cutting concerns)
-	JWT *jwt.JWTService
+	JWT             *jwt.JWTService
+	OAuthProviders  *oidc.Registry
+	PKCE            domain.PKCEStore
+	RateLimiter     *middleware.RateLimiter
+	IdempotencyKeys *middleware.IdempotencyKeys
+	Mail            domain.MailSender
 	// Domain services (core - business rules)
 	AuthenticationDomain *domainservice.AuthenticationDomainService
 	PlayerDomain         *domainservice.PlayerDomainService
@@ -67,22 +98,48 @@ type Services struct {
 	TeamStatDomain       *domainservice.TeamStatsDomainService
 	PlayerStatDomain     *domainservice.PlayerStatsDomainService
 	ArticleDomain        *domainservice.ArticleDomainService
+	VenueDomain          *domainservice.VenueDomainService
+	MatchReportDomain    *domainservice.MatchReportDomainService
+	PredictionDomain     *domainservice.PredictionDomainService
+	MVPVoteDomain        *domainservice.MVPVoteDomainService
+	TeamAccessDomain     *domainservice.TeamAccessDomainService
+	APIKeyDomain         *domainservice.APIKeyDomainService
+	IdentityDomain       *domainservice.IdentityDomainService
+	CredentialDomain     *domainservice.CredentialDomainService
+	AccountDomain        *domainservice.AccountDomainService
+	PlayerClaimDomain    *domainservice.PlayerClaimDomainService
+	InvitationDomain     *domainservice.InvitationDomainService
+	SettingsDomain       *domainservice.SettingsDomainService
+	AuditDomain          *domainservice.AuditDomainService
+	TrashDomain          *domainservice.TrashDomainService
 }
 
 // Handlers contains HTTP adapters (driving adapters).
 // these represent the HTTP layer adapters.
 type Handlers struct {
-	User       *handler.UserHandler
-	Role       *handler.RoleHandler
-	Team       *handler.TeamHandler
-	Player     *handler.PlayerHandler
-	PlayerTeam *handler.PlayerTeamHandler
-	Season     *handler.SeasonHandler
-	Lineup     *handler.LineupHandler
-	Match      *handler.MatchHandler
-	TeamStat   *handler.TeamStatsHandler
-	PlayerStat *handler.PlayerStatsHandler
-	Article    *handler.ArticleHandler
+	User        *handler.UserHandler
+	Role        *handler.RoleHandler
+	Team        *handler.TeamHandler
+	Player      *handler.PlayerHandler
+	PlayerTeam  *handler.PlayerTeamHandler
+	Season      *handler.SeasonHandler
+	Lineup      *handler.LineupHandler
+	Match       *handler.MatchHandler
+	TeamStat    *handler.TeamStatsHandler
+	PlayerStat  *handler.PlayerStatsHandler
+	Article     *handler.ArticleHandler
+	Venue       *handler.VenueHandler
+	MatchReport *handler.MatchReportHandler
+	Prediction  *handler.PredictionHandler
+	MVPVote     *handler.MVPVoteHandler
+	JWKS        *handler.JWKSHandler
+	APIKey      *handler.APIKeyHandler
+	Account     *handler.AccountHandler
+	PlayerClaim *handler.PlayerClaimHandler
+	Invitation  *handler.InvitationHandler
+	Settings    *handler.SettingsHandler
+	Audit       *handler.AuditHandler
+	Trash       *handler.TrashHandler
 }
 
 // NewServer creates and configures a new server instance with middleware and security settings.
@@ -90,6 +147,12 @@ func NewServer() *Server {
 	// Create a new Gin instance without any middleware
 	r := gin.New()
 
+	// Only trust the forwarded client address from the configured reverse proxies
+	if err := r.SetTrustedProxies(config.GetTrustedProxies()); err != nil {
+		slog.Error("Invalid TRUSTED_PROXIES", "error", err)
+		os.Exit(1)
+	}
+
 	// Add recovery middleware
 	r.Use(gin.Recovery())
 
@@ -106,16 +169,16 @@ func NewServer() *Server {
 	// Leer el puerto desde un archivo de configuración
 	port := config.GetPort()
 
-	// Leer el JWTSecret desde un archivo de configuración
-	jwtSecret := getJWTSecret()
+	// Cargar las claves de firma de los JWT desde el directorio configurado
+	jwtKeys := getJWTKeySet()
 
 	// Iniciar un servidor HTTP adicional para el profiling solo en desarrollo
 	setupPprofServer()
 
 	return &Server{
-		Router:    r,
-		Port:      port,
-		JWTSecret: jwtSecret,
+		Router:  r,
+		Port:    port,
+		JWTKeys: jwtKeys,
 	}
 }
 
@@ -125,13 +188,18 @@ func (s *Server) SetupRouter() {
 	db := getDBConnection()
 
 	// Inicializar componentes
-	repositories := initializeRepositories(db)
-	services := initializeServices(repositories, s.JWTSecret)
+	jwtService := jwt.NewJWTService(s.JWTKeys, config.GetAccessTokenTTL())
+	repositories := initializeRepositories(db, jwtService)
+	oauthProviders := oidc.NewRegistry(getOAuthProviderConfigs())
+	services := initializeServices(repositories, jwtService, oauthProviders)
 	handlers := initializeHandlers(services)
 
 	// Configurar las rutas
 	initializeRoutes(s.Router, handlers, services)
 
+	// Tareas periódicas de mantenimiento
+	s.jobs = initializeJobs(services)
+
 	// Configurar Swagger
 	s.Router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 }
@@ -147,8 +215,13 @@ func (s *Server) Start() {
 	// Iniciar el servidor en una goroutine
 	go startServer(server)
 
+	// Iniciar las tareas en segundo plano; se detienen al apagar el servidor
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	startBackgroundJobs(jobsCtx, s.jobs)
+
 	// Esperar señal de apagado y realizar shutdown graceful
 	gracefulShutdown(server)
+	stopJobs()
 }
 
 // =====================================================
@@ -157,48 +230,98 @@ func (s *Server) Start() {
 
 // initializeRepositories creates and configures all repository instances.
 // This represents the driven ports (infrastructure adapters)
-func initializeRepositories(db *gorm.DB) *Repositories {
+func initializeRepositories(db *gorm.DB, jwtService *jwt.JWTService) *Repositories {
 	// Create role repository first as it's needed by authentication repository
 	roleRepo := persistence.NewRoleRepository(db)
 
 	return &Repositories{
-		User:           persistence.NewUserRepository(db),
-		Role:           roleRepo,
-		Team:           persistence.NewTeamRepository(db),
-		Player:         persistence.NewPlayerRepository(db),
-		PlayerTeam:     persistence.NewPlayerTeamRepository(db),
-		Season:         persistence.NewSeasonRepository(db),
-		Article:        persistence.NewArticleRepository(db),
-		Lineup:         persistence.NewLineupRepository(db),
-		Match:          persistence.NewMatchRepository(db),
-		TeamStat:       persistence.NewTeamStatsRepository(db),
-		PlayerStat:     persistence.NewPlayerStatsRepository(db),
-		Authentication: persistence.NewAuthenticationRepository(roleRepo),
+		User:            persistence.NewUserRepository(db),
+		Role:            roleRepo,
+		Team:            persistence.NewTeamRepository(db),
+		Player:          persistence.NewPlayerRepository(db),
+		PlayerTeam:      persistence.NewPlayerTeamRepository(db),
+		Season:          persistence.NewSeasonRepository(db),
+		Article:         persistence.NewArticleRepository(db),
+		Lineup:          persistence.NewLineupRepository(db),
+		Match:           persistence.NewMatchRepository(db),
+		TeamStat:        persistence.NewTeamStatsRepository(db),
+		PlayerStat:      persistence.NewPlayerStatsRepository(db),
+		Venue:           persistence.NewVenueRepository(db),
+		MatchReport:     persistence.NewMatchReportRepository(db),
+		Prediction:      persistence.NewPredictionRepository(db),
+		MVPVote:         persistence.NewMVPVoteRepository(db),
+		RefreshToken:    persistence.NewRefreshTokenRepository(db),
+		Session:         persistence.NewSessionRepository(db),
+		TokenRevocation: persistence.NewTokenRevocationRepository(db),
+		APIKey:          persistence.NewAPIKeyRepository(db),
+		UserIdentity:    persistence.NewUserIdentityRepository(db),
+		Credential:      persistence.NewCredentialRepository(db),
+		LoginAttempt:    persistence.NewLoginAttemptRepository(db),
+		Account:         persistence.NewAccountRepository(db),
+		PlayerClaim:     persistence.NewPlayerClaimRepository(db),
+		Invitation:      persistence.NewInvitationRepository(db),
+		EmailDomainRule: persistence.NewEmailDomainRuleRepository(db),
+		Audit:           persistence.NewAuditRepository(db),
+		Trash:           persistence.NewTrashRepository(db),
+		PKCE:            newPKCEStore(db),
+		RateLimit:       newRateLimitStore(db),
+		Idempotency:     persistence.NewIdempotencyStore(db),
+		Authentication:  persistence.NewAuthenticationRepository(roleRepo, jwtService),
 	}
 }
 
 // initializeServices creates and configures domain services and supporting application services.
 // Domain services implement core business logic, while application services handle cross-cutting concerns.
-func initializeServices(repos *Repositories, jwtSecret []byte) *Services {
-	jwtService := jwt.NewJWTService(string(jwtSecret))
+func initializeServices(repos *Repositories, jwtService *jwt.JWTService, oauthProviders *oidc.Registry) *Services {
+
+	// Completed match results are fanned out to the services that depend on them
+	matchResultPublisher := domainservice.NewMatchResultPublisher()
+
+	// Emails are delivered by the sender selected by MAIL_SENDER
+	mailSender := newMailSender()
+
+	// Every write made through the domain services is recorded in the audit log
+	auditor := audit.NewRecorder(repos.Audit)
 
 	// Create domain services using domain factory (core business logic)
-	roleDomainService := CreateRoleDomainService(repos.Role)
-	seasonDomainService := CreateSeasonDomainService(repos.Season)
-	userDomainService := CreateUserDomainService(repos.User)
-	teamDomainService := CreateTeamDomainService(repos.Team)
-	playerDomainService := CreatePlayerDomainService(repos.Player)
-	playerTeamDomainService := CreatePlayerTeamDomainService(repos.PlayerTeam, repos.Player, repos.Team, repos.Season)
-	lineupDomainService := CreateLineupDomainService(repos.Lineup, repos.Match, repos.Player)
-	matchDomainService := CreateMatchDomainService(repos.Match)
-	teamStatsDomainService := CreateTeamStatsDomainService(repos.TeamStat, repos.Team, repos.Season)
-	playerStatsDomainService := CreatePlayerStatsDomainService(repos.PlayerStat, repos.Player, repos.Match, repos.Season, repos.Team)
-	articleDomainService := CreateArticleDomainService(repos.Article, repos.Season)
-	authenticationDomainService := CreateAuthenticationDomainService(repos.Authentication)
+	settingsDomainService := CreateSettingsDomainService(repos.EmailDomainRule, auditor)
+	roleDomainService := CreateRoleDomainService(repos.Role, auditor)
+	seasonDomainService := CreateSeasonDomainService(repos.Season, auditor)
+	userDomainService := CreateUserDomainService(repos.User, repos.Team, settingsDomainService, auditor)
+	teamDomainService := CreateTeamDomainService(repos.Team, repos.Venue, auditor)
+	playerDomainService := CreatePlayerDomainService(repos.Player, auditor)
+	playerTeamDomainService := CreatePlayerTeamDomainService(repos.PlayerTeam, repos.Player, repos.Team, repos.Season, auditor)
+	lineupDomainService := CreateLineupDomainService(repos.Lineup, repos.Match, repos.Player, auditor)
+	matchDomainService := CreateMatchDomainService(repos.Match, repos.Venue, matchResultPublisher, auditor)
+	teamStatsDomainService := CreateTeamStatsDomainService(repos.TeamStat, repos.Team, repos.Season, auditor)
+	playerStatsDomainService := CreatePlayerStatsDomainService(repos.PlayerStat, repos.Player, repos.Match, repos.Season, repos.Team, auditor)
+	articleDomainService := CreateArticleDomainService(repos.Article, repos.Season, auditor)
+	venueDomainService := CreateVenueDomainService(repos.Venue, auditor)
+	matchReportDomainService := CreateMatchReportDomainService(repos.MatchReport, repos.Match, repos.Player, matchResultPublisher, auditor)
+	predictionDomainService := CreatePredictionDomainService(repos.Prediction, repos.Match, repos.Season)
+	mvpVoteDomainService := CreateMVPVoteDomainService(repos.MVPVote, repos.Match, repos.Lineup)
+	teamAccessDomainService := CreateTeamAccessDomainService(repos.User, repos.Match, repos.Lineup, repos.PlayerStat, repos.PlayerTeam)
+	authenticationDomainService := CreateAuthenticationDomainService(repos.Authentication, repos.RefreshToken, repos.Session, repos.TokenRevocation, repos.User, repos.APIKey, repos.Role)
+	apiKeyDomainService := CreateAPIKeyDomainService(repos.Authentication, repos.APIKey, repos.User, repos.Role, auditor)
+	identityDomainService := CreateIdentityDomainService(repos.UserIdentity, repos.User, repos.Role, repos.Credential, settingsDomainService)
+	credentialDomainService := CreateCredentialDomainService(repos.Credential, repos.LoginAttempt, repos.Authentication, repos.User, repos.Role, settingsDomainService)
+	accountDomainService := CreateAccountDomainService(repos.Account, repos.User, repos.Role, repos.TokenRevocation)
+	playerClaimDomainService := CreatePlayerClaimDomainService(repos.PlayerClaim, repos.Player, repos.User, repos.Role, repos.PlayerStat, repos.Season, auditor)
+	invitationDomainService := CreateInvitationDomainService(repos.Invitation, repos.User, repos.Role, repos.Team, repos.UserIdentity, repos.Authentication, mailSender, settingsDomainService, auditor)
+	auditDomainService := CreateAuditDomainService(repos.Audit)
+	trashDomainService := CreateTrashDomainService(repos.Trash, repos.Article, repos.Match, repos.Player, repos.Team, auditor)
+
+	matchResultPublisher.Subscribe(predictionDomainService)
+	matchResultPublisher.Subscribe(mvpVoteDomainService)
 
 	return &Services{
 		// Application services (cross-cutting concerns)
-		JWT: jwtService,
+		JWT:             jwtService,
+		OAuthProviders:  oauthProviders,
+		PKCE:            repos.PKCE,
+		RateLimiter:     newRateLimiter(repos.RateLimit),
+		IdempotencyKeys: middleware.NewIdempotencyKeys(repos.Idempotency, config.GetIdempotencyKeyTTL()),
+		Mail:            mailSender,
 		// Domain services (core - business rules)
 		AuthenticationDomain: authenticationDomainService,
 		PlayerDomain:         playerDomainService,
@@ -212,6 +335,20 @@ func initializeServices(repos *Repositories, jwtSecret []byte) *Services {
 		TeamStatDomain:       teamStatsDomainService,
 		PlayerStatDomain:     playerStatsDomainService,
 		ArticleDomain:        articleDomainService,
+		VenueDomain:          venueDomainService,
+		MatchReportDomain:    matchReportDomainService,
+		PredictionDomain:     predictionDomainService,
+		MVPVoteDomain:        mvpVoteDomainService,
+		TeamAccessDomain:     teamAccessDomainService,
+		APIKeyDomain:         apiKeyDomainService,
+		IdentityDomain:       identityDomainService,
+		CredentialDomain:     credentialDomainService,
+		AccountDomain:        accountDomainService,
+		PlayerClaimDomain:    playerClaimDomainService,
+		InvitationDomain:     invitationDomainService,
+		SettingsDomain:       settingsDomainService,
+		AuditDomain:          auditDomainService,
+		TrashDomain:          trashDomainService,
 	}
 }
 
@@ -219,17 +356,29 @@ func initializeServices(repos *Repositories, jwtSecret []byte) *Services {
 // This represents the driving adapters (HTTP layer)
 func initializeHandlers(services *Services) *Handlers {
 	return &Handlers{
-		User:       handler.NewUserHandler(services.AuthenticationDomain, services.UserDomain, services.RoleDomain),
-		Role:       handler.NewRoleHandler(services.RoleDomain),
-		Team:       handler.NewTeamHandler(services.TeamDomain),
-		Player:     handler.NewPlayerHandler(services.PlayerDomain),
-		PlayerTeam: handler.NewPlayerTeamHandler(services.PlayerTeamDomain),
-		Season:     handler.NewSeasonHandler(services.SeasonDomain),
-		Lineup:     handler.NewLineupHandler(services.LineupDomain),
-		Article:    handler.NewArticleHandler(services.ArticleDomain),
-		Match:      handler.NewMatchHandler(services.MatchDomain),
-		TeamStat:   handler.NewTeamStatsHandler(services.TeamStatDomain),
-		PlayerStat: handler.NewPlayerStatsHandler(services.PlayerStatDomain),
+		User:        handler.NewUserHandler(services.AuthenticationDomain, services.UserDomain, services.RoleDomain, services.IdentityDomain, services.CredentialDomain, services.InvitationDomain, services.OAuthProviders, services.PKCE),
+		Role:        handler.NewRoleHandler(services.RoleDomain),
+		Team:        handler.NewTeamHandler(services.TeamDomain),
+		Player:      handler.NewPlayerHandler(services.PlayerDomain, services.TeamAccessDomain),
+		PlayerTeam:  handler.NewPlayerTeamHandler(services.PlayerTeamDomain),
+		Season:      handler.NewSeasonHandler(services.SeasonDomain),
+		Lineup:      handler.NewLineupHandler(services.LineupDomain, services.TeamAccessDomain),
+		Article:     handler.NewArticleHandler(services.ArticleDomain),
+		Match:       handler.NewMatchHandler(services.MatchDomain),
+		TeamStat:    handler.NewTeamStatsHandler(services.TeamStatDomain),
+		PlayerStat:  handler.NewPlayerStatsHandler(services.PlayerStatDomain, services.TeamAccessDomain),
+		Venue:       handler.NewVenueHandler(services.VenueDomain),
+		MatchReport: handler.NewMatchReportHandler(services.MatchReportDomain),
+		Prediction:  handler.NewPredictionHandler(services.PredictionDomain),
+		MVPVote:     handler.NewMVPVoteHandler(services.MVPVoteDomain),
+		JWKS:        handler.NewJWKSHandler(services.JWT),
+		APIKey:      handler.NewAPIKeyHandler(services.APIKeyDomain),
+		Account:     handler.NewAccountHandler(services.AccountDomain),
+		PlayerClaim: handler.NewPlayerClaimHandler(services.PlayerClaimDomain, services.AuthenticationDomain),
+		Invitation:  handler.NewInvitationHandler(services.InvitationDomain),
+		Settings:    handler.NewSettingsHandler(services.SettingsDomain),
+		Audit:       handler.NewAuditHandler(services.AuditDomain),
+		Trash:       handler.NewTrashHandler(services.TrashDomain),
 	}
 }
 
@@ -239,18 +388,33 @@ func initializeRoutes(r *gin.Engine, handlers *Handlers, services *Services) {
 	// Authentication middleware (infrastructure concern)
 	authService := services.AuthenticationDomain
 
+	// Every request is rate limited per IP address; route groups add stricter limits
+	r.Use(middleware.RateLimitAPI(services.RateLimiter))
+
 	// Route initialization (wiring handlers to endpoints)
-	router.InitializeUserRoutes(r, handlers.User, authService)
-	router.InitializeRoleRoutes(r, handlers.Role, authService)
-	router.InitializeTeamRoutes(r, handlers.Team, authService)
-	router.InitializePlayerRoutes(r, handlers.Player, authService)
-	router.InitializePlayerTeamRoutes(r, handlers.PlayerTeam, authService)
-	router.InitializeSeasonRoutes(r, handlers.Season, authService)
-	router.InitializeLineupRoutes(r, handlers.Lineup, authService)
-	router.InitializeArticleRoutes(r, handlers.Article, authService)
-	router.InitializeMatchRoutes(r, handlers.Match, authService)
-	router.InitializeTeamStatsRoutes(r, handlers.TeamStat, authService)
-	router.InitializePlayerStatsRoutes(r, handlers.PlayerStat, authService)
+	router.InitializeUserRoutes(r, handlers.User, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializeRoleRoutes(r, handlers.Role, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializeTeamRoutes(r, handlers.Team, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializePlayerRoutes(r, handlers.Player, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializePlayerTeamRoutes(r, handlers.PlayerTeam, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializeSeasonRoutes(r, handlers.Season, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializeLineupRoutes(r, handlers.Lineup, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializeArticleRoutes(r, handlers.Article, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializeMatchRoutes(r, handlers.Match, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializeTeamStatsRoutes(r, handlers.TeamStat, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializePlayerStatsRoutes(r, handlers.PlayerStat, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializeVenueRoutes(r, handlers.Venue, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializeMatchReportRoutes(r, handlers.MatchReport, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializePredictionRoutes(r, handlers.Prediction, authService)
+	router.InitializeMVPVoteRoutes(r, handlers.MVPVote, authService)
+	router.InitializeAPIKeyRoutes(r, handlers.APIKey, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializeAccountRoutes(r, handlers.Account, authService)
+	router.InitializePlayerClaimRoutes(r, handlers.PlayerClaim, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializeInvitationRoutes(r, handlers.Invitation, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializeSettingsRoutes(r, handlers.Settings, authService, services.RateLimiter)
+	router.InitializeAuditRoutes(r, handlers.Audit, authService)
+	router.InitializeTrashRoutes(r, handlers.Trash, authService, services.RateLimiter, services.IdempotencyKeys)
+	router.InitializeJWKSRoutes(r, handlers.JWKS)
 }
 
 // =====================================================
@@ -289,18 +453,97 @@ func getDBConnection() *gorm.DB {
 	return db
 }
 
-// getJWTSecret reads and validates the JWT secret from configuration.
-func getJWTSecret() []byte {
-	jwtSecret, err := config.GetJWTSecret()
+// getJWTKeySet loads the JWT signing and verification keys from configuration.
+// In development an ephemeral key is generated when no key directory is configured.
+func getJWTKeySet() *jwt.KeySet {
+	keysDir := config.GetJWTKeysDir()
+	if keysDir == "" {
+		if !config.Config.IsDevelopment {
+			slog.Error("JWT_KEYS_DIR environment variable is not set")
+			os.Exit(1)
+		}
+
+		slog.Warn("JWT_KEYS_DIR is not set, using an ephemeral signing key; tokens will not survive a restart")
+		keys, err := jwt.GenerateEphemeralKeySet()
+		if err != nil {
+			slog.Error("Failed to generate JWT signing key", "error", err)
+			os.Exit(1)
+		}
+		return keys
+	}
+
+	keys, err := jwt.LoadKeySet(keysDir, config.GetJWTSigningKeyID())
 	if err != nil {
-		slog.Error("Failed to read JWT secret", "error", err)
+		slog.Error("Failed to load JWT keys", "error", err)
 		os.Exit(1)
 	}
-	if jwtSecret == nil {
-		slog.Error("JWT secret is not defined")
+	slog.Info("JWT keys loaded", "signing_key_id", keys.SigningKey().ID)
+	return keys
+}
+
+// newPKCEStore creates the store for pending OAuth logins selected by OAUTH_STATE_STORE.
+func newPKCEStore(db *gorm.DB) domain.PKCEStore {
+	ttl := security.OAuthStateDuration * time.Minute
+	if config.OAuthStateStore == config.OAuthStateStorePostgres {
+		return persistence.NewPKCEStore(db, ttl)
+	}
+	return memory.NewPKCEStore(ttl)
+}
+
+// newRateLimitStore creates the store for the rate limiter buckets selected by RATE_LIMIT_STORE.
+func newRateLimitStore(db *gorm.DB) domain.RateLimitStore {
+	if config.GetRateLimitConfig().Store == config.RateLimitStorePostgres {
+		return persistence.NewRateLimitStore(db)
+	}
+	return memory.NewRateLimitStore()
+}
+
+// newMailSender creates the email sender selected by MAIL_SENDER.
+func newMailSender() domain.MailSender {
+	mailConfig, err := config.GetMailConfig()
+	if err != nil {
+		slog.Error("Invalid mail configuration", "error", err)
 		os.Exit(1)
 	}
-	return jwtSecret
+
+	if mailConfig.Sender == config.MailSenderSMTP {
+		return mail.NewSMTPSender(mailConfig.SMTPHost, mailConfig.SMTPPort, mailConfig.SMTPUsername, mailConfig.SMTPPassword, mailConfig.From)
+	}
+	if !config.Config.IsDevelopment {
+		slog.Warn("MAIL_SENDER is not smtp, emails will only be written to the log or MAIL_DIR")
+	}
+	return mail.NewLogSender(mailConfig.Dir, mailConfig.From)
+}
+
+// newRateLimiter creates the rate limiter with the configured policies.
+func newRateLimiter(store domain.RateLimitStore) *middleware.RateLimiter {
+	limits := config.GetRateLimitConfig()
+	return middleware.NewRateLimiter(store, middleware.RateLimitPolicies{
+		API:         limits.API,
+		Auth:        limits.Auth,
+		AdminWrites: limits.AdminWrites,
+		NewAccounts: domain.RateLimitPolicy{
+			Name:   "new_accounts",
+			Limit:  security.MaxNewAccountsPerIP,
+			Window: 24 * time.Hour,
+		},
+	})
+}
+
+// getOAuthProviderConfigs converts the providers loaded by config.InitOAuth for the provider registry.
+func getOAuthProviderConfigs() []oidc.Config {
+	configs := make([]oidc.Config, len(config.OAuthProviders))
+	for i, provider := range config.OAuthProviders {
+		configs[i] = oidc.Config{
+			Name:         provider.Name,
+			DiscoveryURL: provider.DiscoveryURL,
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			RedirectURL:  provider.RedirectURL,
+			Scopes:       provider.Scopes,
+		}
+	}
+	return configs
 }
 
 // =====================================================